@@ -1,15 +1,22 @@
 package api
 
 import (
+	"bytes"
+	"errors"
+	"mime"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	apic "github.com/imfact-labs/currency-model/api"
+	"github.com/imfact-labs/currency-model/common"
 	ctypes "github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
 	"github.com/imfact-labs/nft-model/digest"
+	"github.com/imfact-labs/nft-model/state"
 	"github.com/imfact-labs/nft-model/types"
 )
 
@@ -18,6 +25,16 @@ var (
 	HandlerPathNFTCollection  = `/nft/{contract:(?i)` + ctypes.REStringAddressString + `}`
 	HandlerPathNFT            = `/nft/{contract:(?i)` + ctypes.REStringAddressString + `}/nftidx/{nft_idx:[0-9]+}`
 	HandlerPathNFTs           = `/nft/{contract:(?i)` + ctypes.REStringAddressString + `}/nfts`
+	HandlerPathNFTDenylist    = `/nft/{contract:(?i)` + ctypes.REStringAddressString + `}/account/{address:(?i)` + ctypes.REStringAddressString + `}/denylist` // revive:disable-line:line-length-limit
+	HandlerPathNFTClawbacks   = `/nft/{contract:(?i)` + ctypes.REStringAddressString + `}/nftidx/{nft_idx:[0-9]+}/clawbacks`
+	HandlerPathNFTReveal      = `/nft/{contract:(?i)` + ctypes.REStringAddressString + `}/reveal`
+	HandlerPathNFTDynamic     = `/nft/{contract:(?i)` + ctypes.REStringAddressString + `}/nftidx/{nft_idx:[0-9]+}/dynamic`
+	HandlerPathNFTContent     = `/nft/{contract:(?i)` + ctypes.REStringAddressString + `}/content/{content_id:[0-9]+}`
+	HandlerPathNFTMember      = `/nft/{contract:(?i)` + ctypes.REStringAddressString + `}/nftidx/{nft_idx:[0-9]+}/member`
+	HandlerPathNFTSeries      = `/nft/{contract:(?i)` + ctypes.REStringAddressString + `}/series/{series_id:[0-9]+}`
+	HandlerPathNFTRoyalty     = `/nft/{contract:(?i)` + ctypes.REStringAddressString + `}/nftidx/{nft_idx:[0-9]+}/royalty`
+	HandlerPathNFTPending     = `/nft/{contract:(?i)` + ctypes.REStringAddressString + `}/pending-policy`
+	HandlerPathNFTOwnerships  = `/nft/{contract:(?i)` + ctypes.REStringAddressString + `}/ownerships`
 )
 
 func SetHandlers(hd *apic.Handlers) {
@@ -30,6 +47,26 @@ func SetHandlers(hd *apic.Handlers) {
 		Methods(http.MethodOptions, "GET")
 	_ = hd.SetHandler(HandlerPathNFT, HandleNFT, true, get, get).
 		Methods(http.MethodOptions, "GET")
+	_ = hd.SetHandler(HandlerPathNFTDenylist, HandleNFTDenylist, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.SetHandler(HandlerPathNFTClawbacks, HandleNFTClawbacks, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.SetHandler(HandlerPathNFTReveal, HandleNFTReveal, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.SetHandler(HandlerPathNFTDynamic, HandleNFTDynamic, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.SetHandler(HandlerPathNFTContent, HandleNFTContent, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.SetHandler(HandlerPathNFTMember, HandleNFTMember, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.SetHandler(HandlerPathNFTSeries, HandleNFTSeries, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.SetHandler(HandlerPathNFTRoyalty, HandleNFTRoyalty, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.SetHandler(HandlerPathNFTPending, HandleNFTPendingPolicy, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.SetHandler(HandlerPathNFTOwnerships, HandleNFTOwnerships, true, get, get).
+		Methods(http.MethodOptions, "GET")
 }
 
 func HandleNFT(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
@@ -67,7 +104,12 @@ func handleNFTInGroup(hd *apic.Handlers, contract, id string) (interface{}, erro
 	case err != nil:
 		return nil, err
 	default:
-		hal, err := buildNFTHal(hd, contract, *nft)
+		resolver, err := loadNFTURIResolver(hd, contract)
+		if err != nil {
+			return nil, err
+		}
+
+		hal, err := buildNFTHal(hd, contract, resolver.Resolve(*nft))
 		if err != nil {
 			return nil, err
 		}
@@ -141,10 +183,25 @@ func HandleNFTs(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
 	reverse := apic.ParseBoolQuery(r.URL.Query().Get("reverse"))
 	facthash := apic.ParseStringQuery(r.URL.Query().Get("facthash"))
 
-	cachekey := apic.CacheKey(
-		r.URL.Path, apic.StringOffsetQuery(offset),
-		apic.StringBoolQuery("reverse", reverse),
+	traits, err := parseNFTTraitsQuery(r.URL.Query()["trait"])
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	series, err := parseNFTSeriesQuery(r.URL.Query().Get("series"))
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	queries := append(
+		[]string{apic.StringOffsetQuery(offset), apic.StringBoolQuery("reverse", reverse), stringNFTSeriesQuery(series)},
+		stringNFTTraitsQuery(traits)...,
 	)
+	cachekey := apic.CacheKey(r.URL.Path, queries...)
 
 	contract, err, status := apic.ParseRequest(w, r, "contract")
 	if err != nil {
@@ -154,7 +211,7 @@ func HandleNFTs(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
 	}
 
 	v, err, shared := hd.RG().Do(cachekey, func() (interface{}, error) {
-		i, filled, err := handleNFTsInGroup(hd, contract, facthash, offset, reverse, limit)
+		i, filled, err := handleNFTsInGroup(hd, contract, facthash, offset, reverse, traits, series, limit)
 
 		return []interface{}{i, filled}, err
 	})
@@ -190,6 +247,8 @@ func handleNFTsInGroup(
 	hd *apic.Handlers,
 	contract, facthash, offset string,
 	reverse bool,
+	traits []digest.NFTTrait,
+	series uint64,
 	l int64,
 ) ([]byte, bool, error) {
 	var limit int64
@@ -199,11 +258,16 @@ func handleNFTsInGroup(
 		limit = l
 	}
 
+	resolver, err := loadNFTURIResolver(hd, contract)
+	if err != nil {
+		return nil, false, err
+	}
+
 	var vas []apic.Hal
 	if err := digest.NFTsByCollection(
-		hd.Database(), contract, facthash, offset, reverse, limit,
+		hd.Database(), contract, facthash, offset, reverse, traits, series, limit,
 		func(nft types.NFT, st base.State) (bool, error) {
-			hal, err := buildNFTHal(hd, contract, nft)
+			hal, err := buildNFTHal(hd, contract, resolver.Resolve(nft))
 			if err != nil {
 				return false, err
 			}
@@ -217,7 +281,7 @@ func handleNFTsInGroup(
 		return nil, false, util.ErrNotFound.Errorf("nft tokens by contract, %s", contract)
 	}
 
-	i, err := buildNFTsHal(hd, contract, vas, offset, reverse)
+	i, err := buildNFTsHal(hd, contract, vas, offset, reverse, traits, series)
 	if err != nil {
 		return nil, false, err
 	}
@@ -232,12 +296,22 @@ func buildNFTsHal(
 	vas []apic.Hal,
 	offset string,
 	reverse bool,
+	traits []digest.NFTTrait,
+	series uint64,
 ) (apic.Hal, error) {
 	baseSelf, err := hd.CombineURL(HandlerPathNFTs, "contract", contract)
 	if err != nil {
 		return nil, err
 	}
 
+	if series > 0 {
+		baseSelf = apic.AddQueryValue(baseSelf, stringNFTSeriesQuery(series))
+	}
+
+	for _, q := range stringNFTTraitsQuery(traits) {
+		baseSelf = apic.AddQueryValue(baseSelf, q)
+	}
+
 	self := baseSelf
 	if len(offset) > 0 {
 		self = apic.AddQueryValue(baseSelf, apic.StringOffsetQuery(offset))
@@ -339,3 +413,834 @@ func buildNFTOperatorsHal(hd *apic.Handlers, contract, account string, operators
 
 	return hal, nil
 }
+
+func HandleNFTDenylist(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
+	cachekey := apic.CacheKeyPath(r)
+	if err := apic.LoadFromCache(hd.Cache(), cachekey, w); err == nil {
+		return
+	}
+
+	contract, err, status := apic.ParseRequest(w, r, "contract")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	account, err, status := apic.ParseRequest(w, r, "address")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	if v, err, shared := hd.RG().Do(cachekey, func() (interface{}, error) {
+		return handleNFTDenylistInGroup(hd, contract, account)
+	}); err != nil {
+		apic.HTTP2HandleError(w, err)
+	} else {
+		apic.HTTP2WriteHalBytes(hd.Encoder(), w, v.([]byte), http.StatusOK)
+		if !shared {
+			apic.HTTP2WriteCache(w, cachekey, hd.ExpireShortLived())
+		}
+	}
+}
+
+func handleNFTDenylistInGroup(hd *apic.Handlers, contract, account string) (interface{}, error) {
+	switch denied, err := digest.NFTDenied(hd.Database(), contract, account); {
+	case err != nil:
+		return nil, err
+	default:
+		hal, err := buildNFTDenylistHal(hd, contract, account, denied)
+		if err != nil {
+			return nil, err
+		}
+		return hd.Encoder().Marshal(hal)
+	}
+}
+
+func buildNFTDenylistHal(hd *apic.Handlers, contract, account string, denied bool) (apic.Hal, error) {
+	h, err := hd.CombineURL(HandlerPathNFTDenylist, "contract", contract, "address", account)
+	if err != nil {
+		return nil, err
+	}
+
+	hal := apic.NewBaseHal(
+		map[string]interface{}{"contract": contract, "address": account, "denied": denied},
+		apic.NewHalLink(h, nil),
+	)
+
+	return hal, nil
+}
+
+func HandleNFTClawbacks(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
+	limit := apic.ParseLimitQuery(r.URL.Query().Get("limit"))
+
+	cachekey := apic.CacheKeyPath(r)
+	if err := apic.LoadFromCache(hd.Cache(), cachekey, w); err == nil {
+		return
+	}
+
+	contract, err, status := apic.ParseRequest(w, r, "contract")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	id, err, status := apic.ParseRequest(w, r, "nft_idx")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	if v, err, shared := hd.RG().Do(cachekey, func() (interface{}, error) {
+		return handleNFTClawbacksInGroup(hd, contract, id, limit)
+	}); err != nil {
+		apic.HTTP2HandleError(w, err)
+	} else {
+		apic.HTTP2WriteHalBytes(hd.Encoder(), w, v.([]byte), http.StatusOK)
+		if !shared {
+			apic.HTTP2WriteCache(w, cachekey, hd.ExpireShortLived())
+		}
+	}
+}
+
+func handleNFTClawbacksInGroup(hd *apic.Handlers, contract, id string, limit int64) (interface{}, error) {
+	var vas []apic.Hal
+	if err := digest.NFTClawbacks(
+		hd.Database(), contract, id, limit,
+		func(clawback state.ClawbackStateValue, st base.State) (bool, error) {
+			vas = append(vas, apic.NewBaseHal(
+				map[string]interface{}{
+					"from":       clawback.From(),
+					"to":         clawback.To(),
+					"reason":     clawback.Reason(),
+					"height":     st.Height(),
+					"operations": st.Operations(),
+				},
+				apic.NewHalLink("", nil),
+			))
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, util.ErrNotFound.WithMessage(err, "nft clawbacks by contract %s and nft idx %s", contract, id)
+	} else if len(vas) < 1 {
+		return nil, util.ErrNotFound.Errorf("nft clawbacks by contract %s and nft idx %s", contract, id)
+	}
+
+	h, err := hd.CombineURL(HandlerPathNFTClawbacks, "contract", contract, "nft_idx", id)
+	if err != nil {
+		return nil, err
+	}
+
+	var hal apic.Hal
+	hal = apic.NewBaseHal(vas, apic.NewHalLink(h, nil))
+
+	nh, err := hd.CombineURL(HandlerPathNFT, "contract", contract, "nft_idx", id)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("nft", apic.NewHalLink(nh, nil))
+
+	return hd.Encoder().Marshal(hal)
+}
+
+func HandleNFTOwnerships(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
+	limit := apic.ParseLimitQuery(r.URL.Query().Get("limit"))
+
+	cachekey := apic.CacheKeyPath(r)
+	if err := apic.LoadFromCache(hd.Cache(), cachekey, w); err == nil {
+		return
+	}
+
+	contract, err, status := apic.ParseRequest(w, r, "contract")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	if v, err, shared := hd.RG().Do(cachekey, func() (interface{}, error) {
+		return handleNFTOwnershipsInGroup(hd, contract, limit)
+	}); err != nil {
+		apic.HTTP2HandleError(w, err)
+	} else {
+		apic.HTTP2WriteHalBytes(hd.Encoder(), w, v.([]byte), http.StatusOK)
+		if !shared {
+			apic.HTTP2WriteCache(w, cachekey, hd.ExpireShortLived())
+		}
+	}
+}
+
+func handleNFTOwnershipsInGroup(hd *apic.Handlers, contract string, limit int64) (interface{}, error) {
+	var vas []apic.Hal
+	if err := digest.NFTOwnerships(
+		hd.Database(), contract, limit,
+		func(ownership state.OwnershipStateValue, st base.State) (bool, error) {
+			vas = append(vas, apic.NewBaseHal(
+				map[string]interface{}{
+					"from":     ownership.From(),
+					"to":       ownership.To(),
+					"accepted": ownership.Accepted(),
+					"height":   st.Height(),
+				},
+				apic.NewHalLink("", nil),
+			))
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, util.ErrNotFound.WithMessage(err, "nft ownership transfers by contract %s", contract)
+	} else if len(vas) < 1 {
+		return nil, util.ErrNotFound.Errorf("nft ownership transfers by contract %s", contract)
+	}
+
+	h, err := hd.CombineURL(HandlerPathNFTOwnerships, "contract", contract)
+	if err != nil {
+		return nil, err
+	}
+
+	var hal apic.Hal
+	hal = apic.NewBaseHal(vas, apic.NewHalLink(h, nil))
+
+	ch, err := hd.CombineURL(HandlerPathNFTCollection, "contract", contract)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("collection", apic.NewHalLink(ch, nil))
+
+	return hd.Encoder().Marshal(hal)
+}
+
+// nftURIResolver resolves the uri of nfts of a collection from its policy
+// base uri and its commit-reveal data. Expired memberships are resolved as
+// inactive at the last block height.
+type nftURIResolver struct {
+	policy *types.CollectionPolicy
+	reveal *types.Reveal
+	height base.Height
+}
+
+func loadNFTURIResolver(hd *apic.Handlers, contract string) (nftURIResolver, error) {
+	var resolver nftURIResolver
+
+	switch design, err := digest.NFTCollection(hd.Database(), contract); {
+	case err == nil:
+		if policy, ok := design.Policy().(types.CollectionPolicy); ok {
+			resolver.policy = &policy
+		}
+	case !errors.Is(err, util.ErrNotFound):
+		return resolver, err
+	}
+
+	reveal, err := loadNFTReveal(hd, contract)
+	if err != nil {
+		return resolver, err
+	}
+	resolver.reveal = reveal
+	resolver.height = hd.Database().LastBlock()
+
+	return resolver, nil
+}
+
+// Resolve replaces the stored uri of nft with the effective one; the revealed
+// uri takes precedence over the one built from the base uri.
+func (r nftURIResolver) Resolve(nft types.NFT) types.NFT {
+	if nft.Active() && !nft.IsValidMember(r.height) {
+		nft = nft.WithActive(false)
+	}
+
+	if r.reveal != nil {
+		if uri, ok := r.reveal.TokenURI(nft.ID()); ok {
+			return nft.WithURI(uri)
+		}
+	}
+
+	if r.policy != nil {
+		return nft.WithURI(r.policy.TokenURI(nft.ID(), nft.URI()))
+	}
+
+	return nft
+}
+
+// loadNFTReveal returns the reveal of the collection or nil when the
+// collection has no reveal commitment.
+func loadNFTReveal(hd *apic.Handlers, contract string) (*types.Reveal, error) {
+	reveal, err := digest.NFTReveal(hd.Database(), contract)
+	switch {
+	case err == nil:
+		return reveal, nil
+	case errors.Is(err, util.ErrNotFound):
+		return nil, nil
+	default:
+		return nil, err
+	}
+}
+
+func HandleNFTReveal(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
+	cachekey := apic.CacheKeyPath(r)
+	if err := apic.LoadFromCache(hd.Cache(), cachekey, w); err == nil {
+		return
+	}
+
+	contract, err, status := apic.ParseRequest(w, r, "contract")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	if v, err, shared := hd.RG().Do(cachekey, func() (interface{}, error) {
+		return handleNFTRevealInGroup(hd, contract)
+	}); err != nil {
+		apic.HTTP2HandleError(w, err)
+	} else {
+		apic.HTTP2WriteHalBytes(hd.Encoder(), w, v.([]byte), http.StatusOK)
+		if !shared {
+			apic.HTTP2WriteCache(w, cachekey, hd.ExpireShortLived())
+		}
+	}
+}
+
+func handleNFTRevealInGroup(hd *apic.Handlers, contract string) (interface{}, error) {
+	switch reveal, err := digest.NFTReveal(hd.Database(), contract); {
+	case err != nil:
+		return nil, err
+	default:
+		h, err := hd.CombineURL(HandlerPathNFTReveal, "contract", contract)
+		if err != nil {
+			return nil, err
+		}
+
+		hal := apic.NewBaseHal(*reveal, apic.NewHalLink(h, nil))
+
+		return hd.Encoder().Marshal(hal)
+	}
+}
+
+func HandleNFTPendingPolicy(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
+	cachekey := apic.CacheKeyPath(r)
+	if err := apic.LoadFromCache(hd.Cache(), cachekey, w); err == nil {
+		return
+	}
+
+	contract, err, status := apic.ParseRequest(w, r, "contract")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	if v, err, shared := hd.RG().Do(cachekey, func() (interface{}, error) {
+		return handleNFTPendingPolicyInGroup(hd, contract)
+	}); err != nil {
+		apic.HTTP2HandleError(w, err)
+	} else {
+		apic.HTTP2WriteHalBytes(hd.Encoder(), w, v.([]byte), http.StatusOK)
+		if !shared {
+			apic.HTTP2WriteCache(w, cachekey, hd.ExpireShortLived())
+		}
+	}
+}
+
+func handleNFTPendingPolicyInGroup(hd *apic.Handlers, contract string) (interface{}, error) {
+	switch pending, err := digest.NFTPendingPolicy(hd.Database(), contract); {
+	case err != nil:
+		return nil, err
+	case !pending.IsScheduled():
+		return nil, util.ErrNotFound.Errorf("scheduled policy for contract account %v", contract)
+	default:
+		h, err := hd.CombineURL(HandlerPathNFTPending, "contract", contract)
+		if err != nil {
+			return nil, err
+		}
+
+		var hal apic.Hal = apic.NewBaseHal(*pending, apic.NewHalLink(h, nil))
+
+		h, err = hd.CombineURL(HandlerPathNFTCollection, "contract", contract)
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink("collection", apic.NewHalLink(h, nil))
+
+		return hd.Encoder().Marshal(hal)
+	}
+}
+
+func HandleNFTDynamic(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
+	cachekey := apic.CacheKeyPath(r)
+	if err := apic.LoadFromCache(hd.Cache(), cachekey, w); err == nil {
+		return
+	}
+
+	contract, err, status := apic.ParseRequest(w, r, "contract")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	id, err, status := apic.ParseRequest(w, r, "nft_idx")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	if v, err, shared := hd.RG().Do(cachekey, func() (interface{}, error) {
+		return handleNFTDynamicInGroup(hd, contract, id)
+	}); err != nil {
+		apic.HTTP2HandleError(w, err)
+	} else {
+		apic.HTTP2WriteHalBytes(hd.Encoder(), w, v.([]byte), http.StatusOK)
+		if !shared {
+			apic.HTTP2WriteCache(w, cachekey, hd.ExpireShortLived())
+		}
+	}
+}
+
+func handleNFTDynamicInGroup(hd *apic.Handlers, contract, id string) (interface{}, error) {
+	switch dynamic, err := digest.NFTDynamic(hd.Database(), contract, id); {
+	case err != nil:
+		return nil, err
+	default:
+		h, err := hd.CombineURL(HandlerPathNFTDynamic, "contract", contract, "nft_idx", id)
+		if err != nil {
+			return nil, err
+		}
+
+		var hal apic.Hal
+		hal = apic.NewBaseHal(*dynamic, apic.NewHalLink(h, nil))
+
+		nh, err := hd.CombineURL(HandlerPathNFT, "contract", contract, "nft_idx", id)
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink("nft", apic.NewHalLink(nh, nil))
+
+		return hd.Encoder().Marshal(hal)
+	}
+}
+
+func HandleNFTSeries(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
+	cachekey := apic.CacheKeyPath(r)
+	if err := apic.LoadFromCache(hd.Cache(), cachekey, w); err == nil {
+		return
+	}
+
+	contract, err, status := apic.ParseRequest(w, r, "contract")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	id, err, status := apic.ParseRequest(w, r, "series_id")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	if v, err, shared := hd.RG().Do(cachekey, func() (interface{}, error) {
+		return handleNFTSeriesInGroup(hd, contract, id)
+	}); err != nil {
+		apic.HTTP2HandleError(w, err)
+	} else {
+		apic.HTTP2WriteHalBytes(hd.Encoder(), w, v.([]byte), http.StatusOK)
+		if !shared {
+			apic.HTTP2WriteCache(w, cachekey, hd.ExpireShortLived())
+		}
+	}
+}
+
+func handleNFTSeriesInGroup(hd *apic.Handlers, contract, id string) (interface{}, error) {
+	switch series, err := digest.NFTSeries(hd.Database(), contract, id); {
+	case err != nil:
+		return nil, err
+	default:
+		h, err := hd.CombineURL(HandlerPathNFTSeries, "contract", contract, "series_id", id)
+		if err != nil {
+			return nil, err
+		}
+
+		var hal apic.Hal
+		hal = apic.NewBaseHal(*series, apic.NewHalLink(h, nil))
+
+		ch, err := hd.CombineURL(HandlerPathNFTCollection, "contract", contract)
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink("collection", apic.NewHalLink(ch, nil))
+
+		nh, err := hd.CombineURL(HandlerPathNFTs, "contract", contract)
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink("nfts", apic.NewHalLink(apic.AddQueryValue(nh, stringNFTSeriesQuery(series.ID())), nil))
+
+		return hd.Encoder().Marshal(hal)
+	}
+}
+
+// nftMember is the membership status of a nft at the last block height.
+type nftMember struct {
+	NFTIdx    uint64       `json:"nft_idx"`
+	Owner     base.Address `json:"owner"`
+	ExpiresAt base.Height  `json:"expires_at"`
+	Height    base.Height  `json:"height"`
+	Valid     bool         `json:"valid"`
+}
+
+func HandleNFTMember(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
+	cachekey := apic.CacheKeyPath(r)
+	if err := apic.LoadFromCache(hd.Cache(), cachekey, w); err == nil {
+		return
+	}
+
+	contract, err, status := apic.ParseRequest(w, r, "contract")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	id, err, status := apic.ParseRequest(w, r, "nft_idx")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	if v, err, shared := hd.RG().Do(cachekey, func() (interface{}, error) {
+		return handleNFTMemberInGroup(hd, contract, id)
+	}); err != nil {
+		apic.HTTP2HandleError(w, err)
+	} else {
+		apic.HTTP2WriteHalBytes(hd.Encoder(), w, v.([]byte), http.StatusOK)
+		if !shared {
+			apic.HTTP2WriteCache(w, cachekey, hd.ExpireShortLived())
+		}
+	}
+}
+
+func handleNFTMemberInGroup(hd *apic.Handlers, contract, id string) (interface{}, error) {
+	switch nft, err := digest.NFT(hd.Database(), contract, id); {
+	case err != nil:
+		return nil, err
+	default:
+		height := hd.Database().LastBlock()
+		member := nftMember{
+			NFTIdx:    nft.ID(),
+			Owner:     nft.Owner(),
+			ExpiresAt: nft.ExpiresAt(),
+			Height:    height,
+			Valid:     nft.IsValidMember(height),
+		}
+
+		h, err := hd.CombineURL(HandlerPathNFTMember, "contract", contract, "nft_idx", id)
+		if err != nil {
+			return nil, err
+		}
+
+		var hal apic.Hal
+		hal = apic.NewBaseHal(member, apic.NewHalLink(h, nil))
+
+		nh, err := hd.CombineURL(HandlerPathNFT, "contract", contract, "nft_idx", id)
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink("nft", apic.NewHalLink(nh, nil))
+
+		return hd.Encoder().Marshal(hal)
+	}
+}
+
+// nftRoyalty is the royalty of a nft for a sale price, like the royaltyInfo
+// of EIP-2981 but with every receiver.
+type nftRoyalty struct {
+	NFTIdx    uint64               `json:"nft_idx"`
+	Price     string               `json:"price"`
+	Royalty   uint                 `json:"royalty"`
+	Receivers []nftRoyaltyReceiver `json:"receivers"`
+}
+
+type nftRoyaltyReceiver struct {
+	Receiver base.Address `json:"receiver"`
+	Amount   string       `json:"amount"`
+}
+
+func HandleNFTRoyalty(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
+	price, err := parseNFTPriceQuery(r.URL.Query().Get("price"))
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	cachekey := apic.CacheKey(r.URL.Path, "price="+price.String())
+	if err := apic.LoadFromCache(hd.Cache(), cachekey, w); err == nil {
+		return
+	}
+
+	contract, err, status := apic.ParseRequest(w, r, "contract")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	id, err, status := apic.ParseRequest(w, r, "nft_idx")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	if v, err, shared := hd.RG().Do(cachekey, func() (interface{}, error) {
+		return handleNFTRoyaltyInGroup(hd, contract, id, price)
+	}); err != nil {
+		apic.HTTP2HandleError(w, err)
+	} else {
+		apic.HTTP2WriteHalBytes(hd.Encoder(), w, v.([]byte), http.StatusOK)
+		if !shared {
+			apic.HTTP2WriteCache(w, cachekey, hd.ExpireShortLived())
+		}
+	}
+}
+
+func handleNFTRoyaltyInGroup(hd *apic.Handlers, contract, id string, price common.Big) (interface{}, error) {
+	design, err := digest.NFTCollection(hd.Database(), contract)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, ok := design.Policy().(types.CollectionPolicy)
+	if !ok {
+		return nil, util.ErrInvalid.Errorf("expected %T, not %T", types.CollectionPolicy{}, design.Policy())
+	}
+
+	nft, err := digest.NFT(hd.Database(), contract, id)
+	if err != nil {
+		return nil, err
+	}
+
+	royalty := policy.Royalty()
+	if nft.Series() > 0 {
+		series, err := digest.NFTSeries(hd.Database(), contract, strconv.FormatUint(nft.Series(), 10))
+		if err != nil {
+			return nil, err
+		}
+		royalty = series.EffectiveRoyalty(royalty)
+	}
+	royalty = policy.TokenRoyalty(*nft, royalty)
+
+	shares := types.RoyaltyShares(*nft, royalty, price, design.Creator())
+	receivers := make([]nftRoyaltyReceiver, len(shares))
+	for i, share := range shares {
+		receivers[i] = nftRoyaltyReceiver{Receiver: share.Receiver, Amount: share.Amount.String()}
+	}
+
+	h, err := hd.CombineURL(HandlerPathNFTRoyalty, "contract", contract, "nft_idx", id)
+	if err != nil {
+		return nil, err
+	}
+
+	var hal apic.Hal
+	hal = apic.NewBaseHal(nftRoyalty{
+		NFTIdx:    nft.ID(),
+		Price:     price.String(),
+		Royalty:   royalty.Uint(),
+		Receivers: receivers,
+	}, apic.NewHalLink(apic.AddQueryValue(h, "price="+price.String()), nil))
+
+	nh, err := hd.CombineURL(HandlerPathNFT, "contract", contract, "nft_idx", id)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("nft", apic.NewHalLink(nh, nil))
+
+	return hd.Encoder().Marshal(hal)
+}
+
+// nftContentInlineTypes are the content types served inline. The other
+// contents are served as attachments of application/octet-stream, so the
+// user-supplied content types like text/html or image/svg+xml are never
+// rendered in the origin of the api.
+var nftContentInlineTypes = map[string]struct{}{
+	"image/png":        {},
+	"image/jpeg":       {},
+	"image/gif":        {},
+	"image/webp":       {},
+	"image/avif":       {},
+	"audio/mpeg":       {},
+	"audio/ogg":        {},
+	"audio/wav":        {},
+	"video/mp4":        {},
+	"video/webm":       {},
+	"application/json": {},
+	"text/plain":       {},
+}
+
+// HandleNFTContent serves the bytes of the finalized on-chain content. All
+// the chunks are loaded and checked against the size and hash of the content
+// before the response is written.
+func HandleNFTContent(hd *apic.Handlers, w http.ResponseWriter, r *http.Request) {
+	contract, err, status := apic.ParseRequest(w, r, "contract")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	id, err, status := apic.ParseRequest(w, r, "content_id")
+	if err != nil {
+		apic.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	content, err := digest.NFTContent(hd.Database(), contract, id)
+	if err != nil {
+		apic.HTTP2HandleError(w, err)
+
+		return
+	}
+
+	b, err := loadNFTContent(*content, func(i uint64) ([]byte, error) {
+		return digest.NFTContentChunk(hd.Database(), contract, id, i)
+	})
+	if err != nil {
+		apic.HTTP2HandleError(w, err)
+
+		return
+	}
+
+	contentType, inline := nftContentType(content.ContentType())
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(b)))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Content-Security-Policy", "default-src 'none'; sandbox")
+	w.Header().Set("ETag", strconv.Quote(content.Hash().String()))
+
+	if !inline {
+		w.Header().Set("Content-Disposition", "attachment")
+	}
+
+	_, _ = w.Write(b)
+}
+
+// loadNFTContent concatenates the chunks of the content and checks the size
+// and hash of it.
+func loadNFTContent(content types.ContentInfo, chunk func(uint64) ([]byte, error)) ([]byte, error) {
+	chunks := make([][]byte, content.Chunks())
+	for i := range chunks {
+		c, err := chunk(uint64(i))
+		if err != nil {
+			return nil, err
+		}
+
+		chunks[i] = c
+	}
+
+	b := bytes.Join(chunks, nil)
+
+	switch {
+	case uint64(len(b)) != content.Size():
+		return nil, util.ErrInvalid.Errorf("content size not matched, %d != %d", len(b), content.Size())
+	case !types.ContentDigest(b).Equal(content.Hash()):
+		return nil, util.ErrInvalid.Errorf("content hash not matched")
+	}
+
+	return b, nil
+}
+
+// nftContentType returns the content type of the response and whether the
+// content is served inline.
+func nftContentType(t string) (string, bool) {
+	mediaType, params, err := mime.ParseMediaType(t)
+	if err != nil {
+		return "application/octet-stream", false
+	}
+
+	if _, found := nftContentInlineTypes[mediaType]; !found {
+		return "application/octet-stream", false
+	}
+
+	if charset, found := params["charset"]; found && strings.HasPrefix(mediaType, "text/") {
+		return mime.FormatMediaType(mediaType, map[string]string{"charset": charset}), true
+	}
+
+	return mediaType, true
+}
+
+// parseNFTTraitsQuery parses the trait queries, "<key>:<value>". The value is
+// matched with the text form of the attribute value.
+func parseNFTTraitsQuery(qs []string) ([]digest.NFTTrait, error) {
+	if len(qs) < 1 {
+		return nil, nil
+	}
+
+	if len(qs) > types.MaxAttributes {
+		return nil, util.ErrInvalid.Errorf("too many traits, %d > %d", len(qs), types.MaxAttributes)
+	}
+
+	traits := make([]digest.NFTTrait, len(qs))
+	for i, q := range qs {
+		key, value, found := strings.Cut(q, ":")
+		if !found || !types.ReValidAttributeKey.MatchString(key) {
+			return nil, util.ErrInvalid.Errorf("wrong trait query, %q", q)
+		}
+
+		traits[i] = digest.NFTTrait{Key: key, Value: value}
+	}
+
+	return traits, nil
+}
+
+func parseNFTPriceQuery(q string) (common.Big, error) {
+	if len(q) < 1 {
+		return common.Big{}, util.ErrInvalid.Errorf("empty price query")
+	}
+
+	price, err := common.NewBigFromString(q)
+	if err != nil || price.Compare(common.ZeroBig) < 0 {
+		return common.Big{}, util.ErrInvalid.Errorf("wrong price query, %q", q)
+	}
+
+	return price, nil
+}
+
+func parseNFTSeriesQuery(q string) (uint64, error) {
+	if len(q) < 1 {
+		return 0, nil
+	}
+
+	series, err := strconv.ParseUint(q, 10, 64)
+	if err != nil {
+		return 0, util.ErrInvalid.Errorf("wrong series query, %q", q)
+	}
+
+	return series, nil
+}
+
+func stringNFTSeriesQuery(series uint64) string {
+	if series < 1 {
+		return ""
+	}
+
+	return "series=" + strconv.FormatUint(series, 10)
+}
+
+func stringNFTTraitsQuery(traits []digest.NFTTrait) []string {
+	qs := make([]string, len(traits))
+	for i, trait := range traits {
+		qs[i] = "trait=" + url.QueryEscape(trait.Key+":"+trait.Value)
+	}
+
+	return qs
+}
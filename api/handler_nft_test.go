@@ -0,0 +1,79 @@
+package api
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+func TestNFTContentType(t *testing.T) {
+	cases := []struct {
+		contentType string
+		expected    string
+		inline      bool
+	}{
+		{"image/png", "image/png", true},
+		{"IMAGE/PNG", "image/png", true},
+		{"text/plain; charset=utf-8", "text/plain; charset=utf-8", true},
+		{"application/json; foo=bar", "application/json", true},
+		{"text/html", "application/octet-stream", false},
+		{"text/html; charset=utf-8", "application/octet-stream", false},
+		{"image/svg+xml", "application/octet-stream", false},
+		{"application/xhtml+xml", "application/octet-stream", false},
+		{"image/png; charset", "application/octet-stream", false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.contentType, func(t *testing.T) {
+			contentType, inline := nftContentType(c.contentType)
+			if contentType != c.expected || inline != c.inline {
+				t.Fatalf("expected %q, %v, not %q, %v", c.expected, c.inline, contentType, inline)
+			}
+		})
+	}
+}
+
+func TestLoadNFTContent(t *testing.T) {
+	chunks := [][]byte{[]byte("chunk0"), []byte("chunk1"), []byte("chunk2")}
+	b := bytes.Join(chunks, nil)
+
+	chunk := func(i uint64) ([]byte, error) {
+		if i >= uint64(len(chunks)) {
+			return nil, errors.Errorf("chunk %d not found", i)
+		}
+
+		return chunks[i], nil
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		content := types.NewContentInfo(3, uint64(len(b)), types.ContentDigest(b), "text/plain")
+
+		l, err := loadNFTContent(content, chunk)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(l, b) {
+			t.Fatalf("expected %q, not %q", b, l)
+		}
+	})
+
+	cases := []struct {
+		name    string
+		content types.ContentInfo
+	}{
+		{"missing chunk", types.NewContentInfo(4, uint64(len(b)), types.ContentDigest(b), "text/plain")},
+		{"size", types.NewContentInfo(3, uint64(len(b))+1, types.ContentDigest(b), "text/plain")},
+		{"hash", types.NewContentInfo(3, uint64(len(b)), types.ContentDigest(chunks[0]), "text/plain")},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := loadNFTContent(c.content, chunk); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package cmds
+
+import (
+	"context"
+
+	ccmds "github.com/imfact-labs/currency-model/app/cmds"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/operation/nft"
+	"github.com/pkg/errors"
+)
+
+type AcceptCollectionOwnershipCommand struct {
+	BaseCommand
+	ccmds.OperationFlags
+	Sender   ccmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract ccmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	Currency ccmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	sender   base.Address
+	contract base.Address
+}
+
+func (cmd *AcceptCollectionOwnershipCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	ccmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *AcceptCollectionOwnershipCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid sender address format, %v", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Contract.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", cmd.Contract)
+	} else {
+		cmd.contract = a
+	}
+
+	return nil
+}
+
+func (cmd *AcceptCollectionOwnershipCommand) createOperation() (base.Operation, error) {
+	e := util.StringError("failed to create accept-collection-ownership operation")
+
+	fact := nft.NewAcceptCollectionOwnershipFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.Currency.CID,
+	)
+
+	op, err := nft.NewAcceptCollectionOwnership(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
@@ -0,0 +1,124 @@
+package cmds
+
+import (
+	"context"
+	"encoding/hex"
+
+	ccmds "github.com/imfact-labs/currency-model/app/cmds"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/imfact-labs/nft-model/operation/nft"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+type CommitRevealCommand struct {
+	BaseCommand
+	ccmds.OperationFlags
+	Sender       ccmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract     ccmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	PreRevealURI string               `arg:"" name:"pre-reveal-uri" help:"uri shown until reveal" required:"true"`
+	Supply       uint64               `arg:"" name:"supply" help:"number of nfts covered by commitment" required:"true"`
+	Currency     ccmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	Commitment   string               `name:"commitment" help:"commitment hash of final uris" optional:""`
+	BaseURI      string               `name:"base-uri" help:"final base uri to build commitment locally" optional:""`
+	Seed         uint64               `name:"seed" help:"reveal seed to build commitment locally" optional:""`
+	Salt         string               `name:"salt" help:"hex salt of commitment to build commitment locally" optional:""`
+	sender       base.Address
+	contract     base.Address
+	commitment   util.Hash
+}
+
+func (cmd *CommitRevealCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	ccmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *CommitRevealCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid sender address format, %v", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Contract.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", cmd.Contract)
+	} else {
+		cmd.contract = a
+	}
+
+	switch {
+	case len(cmd.Commitment) > 0 && len(cmd.BaseURI) > 0:
+		return errors.Errorf("commitment and base uri can not be given together")
+	case len(cmd.Commitment) > 0:
+		cmd.commitment = valuehash.NewBytesFromString(cmd.Commitment)
+	case len(cmd.BaseURI) > 0:
+		salt, err := parseRevealSalt(cmd.Salt)
+		if err != nil {
+			return err
+		}
+
+		cmd.commitment = types.RevealCommitment(types.RevealURIRoot(types.URI(cmd.BaseURI), cmd.Seed, cmd.Supply), salt)
+	default:
+		return errors.Errorf("commitment or base uri is required")
+	}
+
+	return nil
+}
+
+// parseRevealSalt decodes the hex salt of a reveal commitment.
+func parseRevealSalt(s string) ([]byte, error) {
+	salt, err := hex.DecodeString(s)
+	switch {
+	case err != nil:
+		return nil, errors.Wrap(err, "invalid salt")
+	case len(salt) < types.MinRevealSaltLength:
+		return nil, errors.Errorf("salt shorter than %d bytes, %d", types.MinRevealSaltLength, len(salt))
+	}
+
+	return salt, nil
+}
+
+func (cmd *CommitRevealCommand) createOperation() (base.Operation, error) {
+	e := util.StringError("failed to create commit-reveal operation")
+
+	fact := nft.NewCommitRevealFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		types.URI(cmd.PreRevealURI),
+		cmd.commitment,
+		cmd.Supply,
+		cmd.Currency.CID,
+	)
+
+	op, err := nft.NewCommitReveal(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
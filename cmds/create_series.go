@@ -0,0 +1,106 @@
+package cmds
+
+import (
+	"context"
+
+	ccmds "github.com/imfact-labs/currency-model/app/cmds"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/operation/nft"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+type CreateSeriesCommand struct {
+	BaseCommand
+	ccmds.OperationFlags
+	Sender    ccmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract  ccmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	SeriesID  uint64               `arg:"" name:"series" help:"series id" required:"true"`
+	Name      string               `arg:"" name:"name" help:"series name" required:"true"`
+	Currency  ccmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	URI       string               `name:"uri" help:"series uri" optional:""`
+	MaxSupply uint64               `name:"max-supply" help:"maximum number of nfts in series" optional:""`
+	Royalty   uint                 `name:"royalty" help:"royalty parameter overriding the collection royalty" optional:""`
+	Minter    []ccmds.AddressFlag  `name:"minter" help:"account allowed to mint into series" optional:""`
+	sender    base.Address
+	contract  base.Address
+	minters   []base.Address
+}
+
+func (cmd *CreateSeriesCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	ccmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *CreateSeriesCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid sender address format, %v", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Contract.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", cmd.Contract)
+	} else {
+		cmd.contract = a
+	}
+
+	minters := make([]base.Address, len(cmd.Minter))
+	for i := range cmd.Minter {
+		if a, err := cmd.Minter[i].Encode(cmd.Encoders.JSON()); err != nil {
+			return errors.Wrapf(err, "invalid minter address format, %v", cmd.Minter[i])
+		} else {
+			minters[i] = a
+		}
+	}
+	cmd.minters = minters
+
+	return nil
+}
+
+func (cmd *CreateSeriesCommand) createOperation() (base.Operation, error) {
+	e := util.StringError("failed to create create-series operation")
+
+	fact := nft.NewCreateSeriesFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.SeriesID,
+		types.CollectionName(cmd.Name),
+		types.URI(cmd.URI),
+		cmd.MaxSupply,
+		types.PaymentParameter(cmd.Royalty),
+		cmd.minters,
+		cmd.Currency.CID,
+	)
+
+	op, err := nft.NewCreateSeries(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
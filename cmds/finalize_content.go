@@ -0,0 +1,116 @@
+package cmds
+
+import (
+	"context"
+	"os"
+
+	ccmds "github.com/imfact-labs/currency-model/app/cmds"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/imfact-labs/nft-model/operation/nft"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+type FinalizeContentCommand struct {
+	BaseCommand
+	ccmds.OperationFlags
+	Sender      ccmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract    ccmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	ContentID   uint64               `arg:"" name:"content-id" help:"on-chain content id"`
+	ContentType string               `arg:"" name:"content-type" help:"mime type of content"`
+	Currency    ccmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	File        string               `name:"file" help:"content file; chunks and hash are derived from it" optional:""`
+	Chunks      uint64               `name:"chunks" help:"number of chunks" optional:""`
+	Hash        string               `name:"hash" help:"sha256 hash of content" optional:""`
+	sender      base.Address
+	contract    base.Address
+	chunks      uint64
+	hash        util.Hash
+}
+
+func (cmd *FinalizeContentCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	ccmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *FinalizeContentCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid sender address format, %v", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Contract.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", cmd.Contract)
+	} else {
+		cmd.contract = a
+	}
+
+	if len(cmd.File) > 0 {
+		b, err := os.ReadFile(cmd.File)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read content file, %v", cmd.File)
+		}
+
+		chunks := splitContent(b)
+		cmd.chunks = uint64(len(chunks))
+		cmd.hash = types.ContentDigest(chunks...)
+
+		return nil
+	}
+
+	if cmd.Chunks < 1 || len(cmd.Hash) < 1 {
+		return errors.Errorf("--chunks and --hash required without --file")
+	}
+
+	cmd.chunks = cmd.Chunks
+	cmd.hash = valuehash.NewBytesFromString(cmd.Hash)
+
+	return nil
+}
+
+func (cmd *FinalizeContentCommand) createOperation() (base.Operation, error) {
+	e := util.StringError("failed to create finalize-content operation")
+
+	fact := nft.NewFinalizeContentFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.ContentID,
+		cmd.chunks,
+		cmd.hash,
+		cmd.ContentType,
+		cmd.Currency.CID,
+	)
+
+	op, err := nft.NewFinalizeContent(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
@@ -43,3 +43,31 @@ func (v *SignerFlag) String() string {
 func (v *SignerFlag) Encode(enc encoder.Encoder) (base.Address, error) {
 	return base.DecodeAddress(v.address, enc)
 }
+
+type AttributeFlag struct {
+	attribute types.Attribute
+}
+
+func (v *AttributeFlag) UnmarshalText(b []byte) error {
+	l := strings.SplitN(string(b), ":", 3)
+	if len(l) != 3 {
+		return fmt.Errorf("invalid attribute; %v", string(b))
+	}
+
+	attribute := types.NewAttribute(l[0], types.AttributeType(l[1]), l[2])
+	if err := attribute.IsValid(nil); err != nil {
+		return err
+	}
+
+	v.attribute = attribute
+
+	return nil
+}
+
+func (v *AttributeFlag) String() string {
+	return fmt.Sprintf("%s:%s:%s", v.attribute.Key(), v.attribute.Type(), v.attribute.Text())
+}
+
+func (v *AttributeFlag) Attribute() types.Attribute {
+	return v.attribute
+}
@@ -0,0 +1,95 @@
+package cmds
+
+import (
+	"context"
+
+	ccmds "github.com/imfact-labs/currency-model/app/cmds"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/operation/nft"
+	"github.com/pkg/errors"
+)
+
+type ForceTransferCommand struct {
+	BaseCommand
+	ccmds.OperationFlags
+	Sender   ccmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract ccmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	NFTIdx   uint64               `arg:"" name:"nft" help:"target nft idx"`
+	Receiver ccmds.AddressFlag    `arg:"" name:"receiver" help:"nft receiver" required:"true"`
+	Reason   string               `arg:"" name:"reason" help:"reason of forced transfer" required:"true"`
+	Currency ccmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	sender   base.Address
+	contract base.Address
+	receiver base.Address
+}
+
+func (cmd *ForceTransferCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	ccmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *ForceTransferCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid sender address format, %v", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Contract.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", cmd.Contract)
+	} else {
+		cmd.contract = a
+	}
+
+	if a, err := cmd.Receiver.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid receiver address format, %v", cmd.Receiver)
+	} else {
+		cmd.receiver = a
+	}
+
+	return nil
+}
+
+func (cmd *ForceTransferCommand) createOperation() (base.Operation, error) {
+	e := util.StringError("failed to create force-transfer operation")
+
+	fact := nft.NewForceTransferFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.NFTIdx,
+		cmd.receiver,
+		nft.ForceTransferReason(cmd.Reason),
+		cmd.Currency.CID,
+	)
+
+	op, err := nft.NewForceTransfer(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
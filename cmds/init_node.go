@@ -0,0 +1,89 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/imfact-labs/currency-model/app/modulekit"
+	"github.com/imfact-labs/mitum2/launch"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/logging"
+	"github.com/imfact-labs/mitum2/util/ps"
+	"github.com/imfact-labs/nft-model/runtime/pipeline"
+	"github.com/pkg/errors"
+)
+
+// INITCommand generates the genesis block. The facts of the genesis design
+// are decoded by the hinters which the composed modules register, so the
+// nft genesis facts can be used with the currency facts.
+type INITCommand struct {
+	GenesisDesign string `arg:"" name:"genesis design" help:"genesis design" type:"filepath"`
+	launch.PrivatekeyFlags
+	launch.DesignFlag
+	launch.DevFlags `embed:"" prefix:"dev."`
+}
+
+func (cmd *INITCommand) Run(pctx context.Context) error {
+	var log *logging.Logging
+	if err := util.LoadFromContextOK(pctx, launch.LoggingContextKey, &log); err != nil {
+		return err
+	}
+
+	nctx := util.ContextWithValues(pctx, map[util.ContextKey]interface{}{
+		launch.DesignFlagContextKey:        cmd.DesignFlag,
+		launch.DevFlagsContextKey:          cmd.DevFlags,
+		launch.GenesisDesignFileContextKey: cmd.GenesisDesign,
+		launch.PrivatekeyContextKey:        string(cmd.PrivatekeyFlags.Flag.Body()),
+	})
+
+	pps := pipeline.DefaultINITPS()
+	registry := mustBuildModuleRegistry()
+
+	_ = pps.POK(launch.PNameEncoder).
+		PostAddOK(launch.PNameAddHinters, pAddModuleHinters(registry))
+
+	_ = pps.SetLogging(log)
+
+	log.Log().Debug().Interface("process", pps.Verbose()).Msg("process ready")
+
+	nctx, err := pps.Run(nctx) //revive:disable-line:modifies-parameter
+	defer func() {
+		log.Log().Debug().Interface("process", pps.Verbose()).Msg("process will be closed")
+
+		if _, err = pps.Close(nctx); err != nil {
+			log.Log().Error().Err(err).Msg("failed to close")
+		}
+	}()
+
+	return err
+}
+
+func pAddModuleHinters(registry *modulekit.Registry) ps.Func {
+	return func(pctx context.Context) (context.Context, error) {
+		e := util.StringError("add hinters of modules")
+
+		var encs *encoder.Encoders
+		if err := util.LoadFromContextOK(pctx, launch.EncodersContextKey, &encs); err != nil {
+			return pctx, e.Wrap(err)
+		}
+
+		entries := registry.Entries()
+		for i := range entries {
+			entry := entries[i]
+
+			for j := range entry.Hinters {
+				if err := encs.AddDetail(entry.Hinters[j]); err != nil {
+					return pctx, e.Wrap(errors.Wrapf(err, "add hinter of module %q to encoder", entry.ID))
+				}
+			}
+
+			for j := range entry.SupportedFacts {
+				if err := encs.AddDetail(entry.SupportedFacts[j]); err != nil {
+					return pctx, e.Wrap(errors.Wrapf(err, "add supported fact of module %q to encoder", entry.ID))
+				}
+			}
+		}
+
+		return pctx, nil
+	}
+}
@@ -0,0 +1,73 @@
+package cmds
+
+import (
+	"context"
+	"os"
+
+	ccmds "github.com/imfact-labs/currency-model/app/cmds"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+type ListProposalsCommand struct { //nolint:govet //...
+	BaseNetworkClientCommand
+	Contract ccmds.AddressFlag `arg:"" name:"contract" help:"contract address" required:"true"`
+}
+
+func (cmd *ListProposalsCommand) Run(pctx context.Context) error {
+	if err := cmd.Prepare(pctx); err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = cmd.Client.Close()
+	}()
+
+	contract, err := cmd.Contract.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", cmd.Contract)
+	}
+
+	ctx, cancel := context.WithTimeout(pctx, cmd.Timeout)
+	defer cancel()
+
+	// the proposals are approved from the block after the last block.
+	var height base.Height
+	switch m, found, err := cmd.Client.LastBlockMap(ctx, cmd.Remote.ConnInfo(), nil); {
+	case err != nil:
+		cmd.Log.Error().Err(err).Msg("failed to get last blockmap")
+
+		return err
+	case !found:
+		return errors.Errorf("last blockmap not found")
+	default:
+		height = m.Manifest().Height() + 1
+	}
+
+	switch st, found, err := cmd.Client.State(ctx, cmd.Remote.ConnInfo(), state.StateKeyProposals(contract), nil); {
+	case err != nil:
+		cmd.Log.Error().Err(err).Msg("failed to get proposals")
+
+		return err
+	case !found:
+		cmd.Log.Info().Msg("no proposals")
+
+		return nil
+	default:
+		proposals, err := state.StateProposalsValue(st)
+		if err != nil {
+			return err
+		}
+
+		pending := types.PendingConfigProposals(proposals, height)
+		if len(pending) < 1 {
+			cmd.Log.Info().Interface("height", height).Msg("no pending proposals")
+
+			return nil
+		}
+
+		return cmd.Print(pending, os.Stdout)
+	}
+}
@@ -0,0 +1,518 @@
+package cmds
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	ccmds "github.com/imfact-labs/currency-model/app/cmds"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/nft-model/operation/nft"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+// MigrateCommand migrates a collection exported from an EVM chain. The dump is
+// a JSON array or a CSV file with the columns token_id, owner, token_uri and
+// the optional hash, creators ("<address>:<share>;..."), royalty and
+// royalty_receiver. EVM addresses are mapped to mitum addresses by the mapping
+// file, a JSON object or a CSV file of "<evm address>,<mitum address>".
+type MigrateCommand struct {
+	Mint   MigrateMintCommand   `cmd:"" name:"mint" help:"create mint operations with explicit nft idxes from dump"`
+	Verify MigrateVerifyCommand `cmd:"" name:"verify" help:"compare nfts of digest api with dump"`
+}
+
+type MigrateFlags struct {
+	Dump     string `arg:"" name:"dump" help:"dump file of collection, json or csv" type:"existingfile"`
+	Mapping  string `arg:"" name:"mapping" help:"address mapping file, json or csv" type:"existingfile"`
+	contract base.Address
+	items    []migrateItem
+}
+
+type MigrateMintCommand struct {
+	BaseCommand
+	ccmds.OperationFlags
+	Sender    ccmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract  ccmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	Currency  ccmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	BatchSize uint                 `name:"batch-size" help:"number of nfts in a mint operation, up to max mint items of nft params" default:"100"`
+	Params    string               `name:"params" help:"json file of the nft params of the network; default params if empty"`
+	MigrateFlags
+	sender base.Address
+	params types.Params
+}
+
+func (cmd *MigrateMintCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if cmd.BatchSize < 1 {
+		return errors.Errorf("batch size under one, %d", cmd.BatchSize)
+	}
+
+	a, err := cmd.Sender.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender address format, %v", cmd.Sender)
+	}
+	cmd.sender = a
+
+	if err := cmd.MigrateFlags.load(cmd.Contract, cmd.Encoders.JSON()); err != nil {
+		return err
+	}
+
+	if err := cmd.loadParams(); err != nil {
+		return err
+	}
+
+	if err := cmd.params.CheckMintItems(int(cmd.BatchSize)); err != nil {
+		return errors.WithMessage(err, "invalid batch size")
+	}
+
+	for _, it := range cmd.items {
+		if err := cmd.params.CheckURIs(it.uri); err != nil {
+			return errors.WithMessagef(err, "invalid token %d", it.idx)
+		}
+
+		if err := cmd.params.CheckNFTHash(it.hash); err != nil {
+			return errors.WithMessagef(err, "invalid token %d", it.idx)
+		}
+
+		if err := cmd.params.CheckSigners(it.creators); err != nil {
+			return errors.WithMessagef(err, "invalid token %d", it.idx)
+		}
+	}
+
+	e := util.StringError("failed to create mint operation")
+
+	for i := 0; i < len(cmd.items); i += int(cmd.BatchSize) {
+		end := i + int(cmd.BatchSize)
+		if end > len(cmd.items) {
+			end = len(cmd.items)
+		}
+
+		items := make([]nft.MintItem, end-i)
+		for j, it := range cmd.items[i:end] {
+			items[j] = nft.NewMintItem(
+				cmd.contract, it.owner, it.hash, it.uri, it.creators, types.Attributes{},
+				0, it.royalty, it.royaltyReceiver, cmd.Currency.CID,
+			).WithIdx(it.idx)
+		}
+
+		op, err := nft.NewMint(nft.NewMintFact([]byte(cmd.Token), cmd.sender, items))
+		if err != nil {
+			return e.Wrap(err)
+		}
+
+		if err := op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID()); err != nil {
+			return e.Wrap(err)
+		}
+
+		if err := op.IsValid(cmd.NetworkID.NetworkID()); err != nil {
+			return e.Wrap(err)
+		}
+
+		ccmds.PrettyPrint(cmd.Out, op)
+	}
+
+	return nil
+}
+
+func (cmd *MigrateMintCommand) loadParams() error {
+	if cmd.Params == "" {
+		cmd.params = types.DefaultParams()
+
+		return nil
+	}
+
+	b, err := os.ReadFile(filepath.Clean(cmd.Params))
+	if err != nil {
+		return errors.WithMessagef(err, "failed to load params, %v", cmd.Params)
+	}
+
+	var params types.Params
+	if err := params.DecodeJSON(b, cmd.Encoders.JSON()); err != nil {
+		return errors.WithMessagef(err, "failed to load params, %v", cmd.Params)
+	}
+
+	if err := params.IsValid(nil); err != nil {
+		return errors.WithMessagef(err, "invalid params, %v", cmd.Params)
+	}
+
+	cmd.params = params
+
+	return nil
+}
+
+type MigrateVerifyCommand struct {
+	BaseCommand
+	Contract ccmds.AddressFlag `arg:"" name:"contract" help:"contract address" required:"true"`
+	API      string            `arg:"" name:"api" help:"digest api url, \"http://localhost:54320\""`
+	MigrateFlags
+	Timeout time.Duration `name:"timeout" help:"timeout of a digest api request" default:"10s"`
+}
+
+func (cmd *MigrateVerifyCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	api, err := url.Parse(cmd.API)
+	if err != nil {
+		return errors.Wrapf(err, "invalid digest api url, %v", cmd.API)
+	}
+
+	if err := cmd.MigrateFlags.load(cmd.Contract, cmd.Encoders.JSON()); err != nil {
+		return err
+	}
+
+	client := &http.Client{Timeout: cmd.Timeout}
+
+	var mismatches int
+	for _, it := range cmd.items {
+		n, err := cmd.requestNFT(client, api, it.idx)
+		if err != nil {
+			return err
+		}
+
+		var diffs []string
+		switch {
+		case n == nil:
+			diffs = append(diffs, "not found")
+		default:
+			diffs = it.compare(*n)
+		}
+
+		for _, d := range diffs {
+			cmd.print("nft %d: %s", it.idx, d)
+		}
+
+		if len(diffs) > 0 {
+			mismatches++
+		}
+	}
+
+	cmd.print("verified %d nfts, %d mismatched", len(cmd.items), mismatches)
+
+	if mismatches > 0 {
+		return errors.Errorf("%d nfts mismatched with dump", mismatches)
+	}
+
+	return nil
+}
+
+func (cmd *MigrateVerifyCommand) requestNFT(client *http.Client, api *url.URL, idx uint64) (*types.NFT, error) {
+	u := api.JoinPath("nft", cmd.contract.String(), "nftidx", strconv.FormatUint(idx, 10))
+
+	res, err := client.Get(u.String())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to request nft, %d", idx)
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		return nil, nil
+	case res.StatusCode != http.StatusOK:
+		return nil, errors.Errorf("failed to request nft, %d: status %d", idx, res.StatusCode)
+	}
+
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read nft, %d", idx)
+	}
+
+	var hal struct {
+		Embedded json.RawMessage `json:"_embedded"`
+	}
+	if err := json.Unmarshal(b, &hal); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode nft, %d", idx)
+	}
+
+	var n types.NFT
+	if err := n.DecodeJSON(hal.Embedded, cmd.Encoders.JSON()); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode nft, %d", idx)
+	}
+
+	return &n, nil
+}
+
+type migrateItem struct {
+	idx             uint64
+	owner           base.Address
+	hash            types.NFTHash
+	uri             types.URI
+	creators        types.Signers
+	royalty         types.PaymentParameter
+	royaltyReceiver base.Address
+}
+
+func (it migrateItem) compare(n types.NFT) []string {
+	var diffs []string
+
+	if !n.Owner().Equal(it.owner) {
+		diffs = append(diffs, fmt.Sprintf("owner %v, expected %v", n.Owner(), it.owner))
+	}
+
+	if n.NFTHash() != it.hash {
+		diffs = append(diffs, fmt.Sprintf("hash %q, expected %q", n.NFTHash(), it.hash))
+	}
+
+	if it.uri != "" && n.URI() != it.uri {
+		diffs = append(diffs, fmt.Sprintf("uri %q, expected %q", n.URI(), it.uri))
+	}
+
+	// creators sign the nft after the migration; only address and share are
+	// compared.
+	cs, ecs := n.Creators().Signers(), it.creators.Signers()
+	if len(cs) != len(ecs) {
+		diffs = append(diffs, fmt.Sprintf("%d creators, expected %d", len(cs), len(ecs)))
+	} else {
+		for i := range cs {
+			if !cs[i].Address().Equal(ecs[i].Address()) || cs[i].Share() != ecs[i].Share() {
+				diffs = append(diffs, fmt.Sprintf(
+					"creator %v:%d, expected %v:%d", cs[i].Address(), cs[i].Share(), ecs[i].Address(), ecs[i].Share()))
+			}
+		}
+	}
+
+	return diffs
+}
+
+type migrateRecord struct {
+	TokenID         json.Number `json:"token_id"`
+	Owner           string      `json:"owner"`
+	TokenURI        string      `json:"token_uri"`
+	Hash            string      `json:"hash"`
+	Creators        string      `json:"creators"`
+	Royalty         json.Number `json:"royalty"`
+	RoyaltyReceiver string      `json:"royalty_receiver"`
+}
+
+func (flag *MigrateFlags) load(contract ccmds.AddressFlag, enc encoder.Encoder) error {
+	a, err := contract.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", contract)
+	}
+	flag.contract = a
+
+	mapping, err := loadMigrateMapping(flag.Mapping)
+	if err != nil {
+		return errors.WithMessagef(err, "failed to load mapping, %v", flag.Mapping)
+	}
+
+	records, err := loadMigrateDump(flag.Dump)
+	if err != nil {
+		return errors.WithMessagef(err, "failed to load dump, %v", flag.Dump)
+	}
+
+	addr := func(s string) (base.Address, error) {
+		if m, found := mapping[strings.ToLower(s)]; found {
+			s = m
+		} else if strings.HasPrefix(s, "0x") {
+			return nil, errors.Errorf("unmapped address, %v", s)
+		}
+
+		return base.DecodeAddress(s, enc)
+	}
+
+	items := make([]migrateItem, len(records))
+	founds := map[uint64]struct{}{}
+
+	for i, r := range records {
+		it, err := r.item(addr)
+		if err != nil {
+			return errors.WithMessagef(err, "invalid token %q", r.TokenID)
+		}
+
+		if _, found := founds[it.idx]; found {
+			return errors.Errorf("duplicated token, %v", r.TokenID)
+		}
+		founds[it.idx] = struct{}{}
+
+		items[i] = it
+	}
+
+	flag.items = items
+
+	return nil
+}
+
+func (r migrateRecord) item(addr func(string) (base.Address, error)) (migrateItem, error) {
+	var it migrateItem
+
+	idx, err := strconv.ParseUint(r.TokenID.String(), 10, 64)
+	if err != nil {
+		return it, errors.Wrap(err, "invalid token id")
+	}
+
+	if idx >= types.MaxNFTIndex {
+		return it, errors.Errorf("token id over max nft index, %d", types.MaxNFTIndex)
+	}
+	it.idx = idx
+
+	if it.owner, err = addr(r.Owner); err != nil {
+		return it, errors.WithMessage(err, "invalid owner")
+	}
+
+	it.hash = types.NFTHash(r.Hash)
+	it.uri = types.URI(r.TokenURI)
+
+	var signers []types.Signer
+	for _, c := range strings.Split(r.Creators, ";") {
+		if c = strings.TrimSpace(c); c == "" {
+			continue
+		}
+
+		l := strings.SplitN(c, ":", 2)
+		if len(l) != 2 {
+			return it, errors.Errorf("invalid creator, %q", c)
+		}
+
+		a, err := addr(l[0])
+		if err != nil {
+			return it, errors.WithMessage(err, "invalid creator")
+		}
+
+		share, err := strconv.ParseUint(l[1], 10, 8)
+		if err != nil {
+			return it, errors.Wrapf(err, "invalid creator share, %q", c)
+		}
+
+		signers = append(signers, types.NewSigner(a, uint(share), false))
+	}
+	it.creators = types.NewSigners(signers)
+
+	if r.Royalty != "" {
+		royalty, err := strconv.ParseUint(r.Royalty.String(), 10, 64)
+		if err != nil {
+			return it, errors.Wrap(err, "invalid royalty")
+		}
+		it.royalty = types.PaymentParameter(royalty)
+	}
+
+	if r.RoyaltyReceiver != "" {
+		if it.royaltyReceiver, err = addr(r.RoyaltyReceiver); err != nil {
+			return it, errors.WithMessage(err, "invalid royalty receiver")
+		}
+	}
+
+	if err := util.CheckIsValiders(nil, false, it.hash, it.uri, it.creators, it.royalty); err != nil {
+		return it, err
+	}
+
+	return it, nil
+}
+
+func loadMigrateDump(path string) ([]migrateRecord, error) {
+	b, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	if !isCSVFile(path) {
+		var records []migrateRecord
+		if err := json.Unmarshal(b, &records); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		return records, nil
+	}
+
+	rows, err := csv.NewReader(strings.NewReader(string(b))).ReadAll()
+	switch {
+	case err != nil:
+		return nil, errors.WithStack(err)
+	case len(rows) < 1:
+		return nil, nil
+	}
+
+	columns := map[string]int{}
+	for i, c := range rows[0] {
+		columns[strings.TrimSpace(c)] = i
+	}
+
+	for _, c := range []string{"token_id", "owner", "token_uri"} {
+		if _, found := columns[c]; !found {
+			return nil, errors.Errorf("missing column, %q", c)
+		}
+	}
+
+	records := make([]migrateRecord, len(rows)-1)
+	for i, row := range rows[1:] {
+		column := func(c string) string {
+			if j, found := columns[c]; found && j < len(row) {
+				return strings.TrimSpace(row[j])
+			}
+
+			return ""
+		}
+
+		records[i] = migrateRecord{
+			TokenID:         json.Number(column("token_id")),
+			Owner:           column("owner"),
+			TokenURI:        column("token_uri"),
+			Hash:            column("hash"),
+			Creators:        column("creators"),
+			Royalty:         json.Number(column("royalty")),
+			RoyaltyReceiver: column("royalty_receiver"),
+		}
+	}
+
+	return records, nil
+}
+
+func loadMigrateMapping(path string) (map[string]string, error) {
+	b, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	m := map[string]string{}
+
+	if !isCSVFile(path) {
+		if err := json.Unmarshal(b, &m); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	} else {
+		rows, err := csv.NewReader(strings.NewReader(string(b))).ReadAll()
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, row := range rows {
+			if len(row) != 2 {
+				return nil, errors.Errorf("invalid mapping, %v", row)
+			}
+			m[strings.TrimSpace(row[0])] = strings.TrimSpace(row[1])
+		}
+	}
+
+	mapping := make(map[string]string, len(m))
+	for k, v := range m {
+		mapping[strings.ToLower(k)] = v
+	}
+
+	return mapping, nil
+}
+
+func isCSVFile(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".csv")
+}
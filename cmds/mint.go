@@ -2,6 +2,7 @@ package cmds
 
 import (
 	"context"
+	"strconv"
 
 	ccmds "github.com/imfact-labs/currency-model/app/cmds"
 	"github.com/imfact-labs/mitum2/base"
@@ -14,19 +15,28 @@ import (
 type MintCommand struct {
 	BaseCommand
 	ccmds.OperationFlags
-	Sender   ccmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
-	Contract ccmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
-	Receiver ccmds.AddressFlag    `arg:"" name:"receiver" help:"receiver address" required:"true"`
-	Hash     string               `arg:"" name:"hash" help:"nft hash" required:"true"`
-	Uri      string               `arg:"" name:"uri" help:"nft uri" required:"true"`
-	Currency ccmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
-	Creator  SignerFlag           `name:"creator" help:"nft contents creator \"<address>,<share>\"" optional:""`
-	sender   base.Address
-	contract base.Address
-	receiver base.Address
-	hash     types.NFTHash
-	uri      types.URI
-	creators types.Signers
+	Sender     ccmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract   ccmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	Receiver   ccmds.AddressFlag    `arg:"" name:"receiver" help:"receiver address" required:"true"`
+	Hash       string               `arg:"" name:"hash" help:"nft hash" required:"true"`
+	Uri        string               `arg:"" name:"uri" help:"nft uri" required:"true"`
+	Currency   ccmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	Creator    SignerFlag           `name:"creator" help:"nft contents creator \"<address>,<share>\"" optional:""`
+	Attribute  []AttributeFlag      `name:"attribute" help:"nft attribute \"<key>:<string|int|bool>:<value>\"" optional:""`
+	Series     uint64               `name:"series" help:"id of the series to mint into" optional:""`
+	Royalty    uint                 `name:"royalty" help:"royalty parameter overriding the collection royalty" optional:""`
+	RoyaltyTo  ccmds.AddressFlag    `name:"royalty-receiver" help:"account receiving the royalties instead of the creators" optional:""`
+	Idx        string               `name:"nft-idx" help:"nft idx to mint in explicit mint mode" optional:""`
+	sender     base.Address
+	contract   base.Address
+	receiver   base.Address
+	hash       types.NFTHash
+	uri        types.URI
+	creators   types.Signers
+	attributes types.Attributes
+	royalty    types.PaymentParameter
+	royaltyTo  base.Address
+	idx        *uint64
 }
 
 func (cmd *MintCommand) Run(pctx context.Context) error { // nolint:dupl
@@ -110,6 +120,39 @@ func (cmd *MintCommand) parseFlags() error {
 		cmd.creators = creators
 	}
 
+	attributes := make(types.Attributes, len(cmd.Attribute))
+	for i := range cmd.Attribute {
+		attributes[i] = cmd.Attribute[i].Attribute()
+	}
+	if err := attributes.IsValid(nil); err != nil {
+		return err
+	} else {
+		cmd.attributes = attributes
+	}
+
+	royalty := types.PaymentParameter(cmd.Royalty)
+	if err := royalty.IsValid(nil); err != nil {
+		return err
+	} else {
+		cmd.royalty = royalty
+	}
+
+	if cmd.RoyaltyTo.String() != "" {
+		if a, err := cmd.RoyaltyTo.Encode(cmd.Encoders.JSON()); err != nil {
+			return errors.Wrapf(err, "invalid royalty receiver address format, %v", cmd.RoyaltyTo)
+		} else {
+			cmd.royaltyTo = a
+		}
+	}
+
+	if cmd.Idx != "" {
+		idx, err := strconv.ParseUint(cmd.Idx, 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "invalid nft idx, %v", cmd.Idx)
+		}
+		cmd.idx = &idx
+	}
+
 	return nil
 
 }
@@ -117,7 +160,13 @@ func (cmd *MintCommand) parseFlags() error {
 func (cmd *MintCommand) createOperation() (base.Operation, error) { // nolint:dupl
 	e := util.StringError("failed to create mint operation")
 
-	item := nft.NewMintItem(cmd.contract, cmd.receiver, cmd.hash, cmd.uri, cmd.creators, cmd.Currency.CID)
+	item := nft.NewMintItem(
+		cmd.contract, cmd.receiver, cmd.hash, cmd.uri, cmd.creators, cmd.attributes,
+		cmd.Series, cmd.royalty, cmd.royaltyTo, cmd.Currency.CID,
+	)
+	if cmd.idx != nil {
+		item = item.WithIdx(*cmd.idx)
+	}
 	fact := nft.NewMintFact([]byte(cmd.Token), cmd.sender, []nft.MintItem{item})
 
 	op, err := nft.NewMint(fact)
@@ -1,11 +1,31 @@
 package cmds
 
 type NFTCommand struct {
-	RegisterModel     RegisterModelCommand     `cmd:"" name:"register-model" help:"register new nft service"`
-	UpdateModelConfig UpdateModelConfigCommand `cmd:"" name:"update-model-config" help:"update model config"`
-	Mint              MintCommand              `cmd:"" name:"mint" help:"mint new nft to collection"`
-	Transfer          TransferCommand          `cmd:"" name:"transfer" help:"transfer nfts to receiver"`
-	Delegate          DelegateCommand          `cmd:"" name:"delegate" help:"delegate operator or cancel operator delegation"`
-	Approve           ApproveCommand           `cmd:"" name:"approve" help:"approve account for nft"`
-	Sign              SignCommand              `cmd:"" name:"sign" help:"sign nft as creator | copyrighter"`
+	RegisterModel               RegisterModelCommand               `cmd:"" name:"register-model" help:"register new nft service"`
+	UpdateModelConfig           UpdateModelConfigCommand           `cmd:"" name:"update-model-config" help:"update model config"`
+	Mint                        MintCommand                        `cmd:"" name:"mint" help:"mint new nft to collection"`
+	Transfer                    TransferCommand                    `cmd:"" name:"transfer" help:"transfer nfts to receiver"`
+	Delegate                    DelegateCommand                    `cmd:"" name:"delegate" help:"delegate operator or cancel operator delegation"`
+	Approve                     ApproveCommand                     `cmd:"" name:"approve" help:"approve account for nft"`
+	Sign                        SignCommand                        `cmd:"" name:"sign" help:"sign nft as creator | copyrighter"`
+	Pause                       PauseCommand                       `cmd:"" name:"pause" help:"pause nft transfers of collection"`
+	Unpause                     UnpauseCommand                     `cmd:"" name:"unpause" help:"resume nft transfers of collection"`
+	UpdateDenylist              UpdateDenylistCommand              `cmd:"" name:"update-denylist" help:"add or remove accounts in collection denylist"`
+	ForceTransfer               ForceTransferCommand               `cmd:"" name:"force-transfer" help:"force transfer nft by collection owner of clawback enabled collection"`
+	CommitReveal                CommitRevealCommand                `cmd:"" name:"commit-reveal" help:"register pre-reveal uri and commitment of final uris"`
+	Reveal                      RevealCommand                      `cmd:"" name:"reveal" help:"reveal final base uri and seed of collection"`
+	UpdateAttributes            UpdateAttributesCommand            `cmd:"" name:"update-attributes" help:"set or remove attributes of nft"`
+	UpdateDynamicState          UpdateDynamicStateCommand          `cmd:"" name:"update-dynamic-state" help:"update dynamic state of nft by collection oracle"`
+	StoreContent                StoreContentCommand                `cmd:"" name:"store-content" help:"store chunk of on-chain content"`
+	FinalizeContent             FinalizeContentCommand             `cmd:"" name:"finalize-content" help:"finalize on-chain content with its hash and content type"`
+	Renew                       RenewCommand                       `cmd:"" name:"renew" help:"renew membership of nft"`
+	CreateSeries                CreateSeriesCommand                `cmd:"" name:"create-series" help:"create series in collection"`
+	ProposeModelConfig          ProposeModelConfigCommand          `cmd:"" name:"propose-model-config" help:"propose model config change by collection admin"`
+	ApproveModelConfig          ApproveModelConfigCommand          `cmd:"" name:"approve-model-config" help:"approve model config change proposal by collection admin"`
+	ListProposals               ListProposalsCommand               `cmd:"" name:"list-proposals" help:"list pending model config change proposals"`
+	TransferCollectionOwnership TransferCollectionOwnershipCommand `cmd:"" name:"transfer-collection-ownership" help:"start ownership transfer of collection to new owner"`
+	AcceptCollectionOwnership   AcceptCollectionOwnershipCommand   `cmd:"" name:"accept-collection-ownership" help:"accept pending ownership transfer of collection by new owner"`
+	Migrate                     MigrateCommand                     `cmd:"" name:"migrate" help:"migrate collection from erc-721 dump"`
+	UpgradeStates               UpgradeStatesCommand               `cmd:"" name:"upgrade-states" help:"rewrite collection and nft states in the latest version"`
+	UpdateParams                UpdateParamsCommand                `cmd:"" name:"update-params" help:"update chain-level nft params by suffrage nodes"`
 }
@@ -0,0 +1,95 @@
+package cmds
+
+import (
+	"context"
+
+	ccmds "github.com/imfact-labs/currency-model/app/cmds"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/operation/nft"
+	"github.com/pkg/errors"
+)
+
+type PauseCommand struct {
+	BaseCommand
+	ccmds.OperationFlags
+	Sender   ccmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract ccmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	Currency ccmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	sender   base.Address
+	contract base.Address
+}
+
+func (cmd *PauseCommand) Run(pctx context.Context) error { // nolint:dupl
+	return cmd.run(pctx, nft.PauseModePause)
+}
+
+func (cmd *PauseCommand) run(pctx context.Context, mode nft.PauseMode) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation(mode)
+	if err != nil {
+		return err
+	}
+
+	ccmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *PauseCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid sender address format, %v", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Contract.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", cmd.Contract)
+	} else {
+		cmd.contract = a
+	}
+
+	return nil
+}
+
+func (cmd *PauseCommand) createOperation(mode nft.PauseMode) (base.Operation, error) {
+	e := util.StringError("failed to create %s operation", mode)
+
+	fact := nft.NewPauseFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		mode,
+		cmd.Currency.CID,
+	)
+
+	op, err := nft.NewPause(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
+
+type UnpauseCommand struct {
+	PauseCommand
+}
+
+func (cmd *UnpauseCommand) Run(pctx context.Context) error {
+	return cmd.run(pctx, nft.PauseModeUnpause)
+}
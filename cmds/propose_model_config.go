@@ -0,0 +1,86 @@
+package cmds
+
+import (
+	"context"
+
+	ccmds "github.com/imfact-labs/currency-model/app/cmds"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/operation/nft"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+type ProposeModelConfigCommand struct {
+	UpdateModelConfigCommand
+	Proposal  uint64 `arg:"" name:"proposal" help:"proposal id" required:"true"`
+	ExpiresAt uint64 `arg:"" name:"expires-at" help:"block height the proposal expires at" required:"true"`
+}
+
+func (cmd *ProposeModelConfigCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	if cmd.EffectiveAt > 0 {
+		return errors.Errorf("effective-at not supported by proposals")
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	ccmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *ProposeModelConfigCommand) createOperation() (base.Operation, error) {
+	e := util.StringError("failed to create propose-model-config operation")
+
+	// fields fixed at registration are kept from the current policy when the
+	// proposal is applied.
+	policy := types.NewCollectionPolicy(cmd.name, cmd.royalty, cmd.uri, cmd.white).
+		WithPauser(cmd.pauser).
+		WithBaseURI(cmd.baseURI, cmd.uriSuffix).
+		WithMetadata(
+			types.CollectionSymbol(cmd.Symbol),
+			types.CollectionDescription(cmd.Description),
+			types.URI(cmd.ExternalURL),
+			types.URI(cmd.ContractURI),
+		).
+		WithHashAlgorithms(cmd.hashAlgorithms).
+		WithURIRule(cmd.uriRule).
+		WithAttributeUpdater(cmd.attributeUpdater).
+		WithOracleRule(cmd.oracleRule).
+		WithMembershipRule(cmd.membershipRule).
+		WithMaxRoyalty(cmd.maxRoyalty).
+		WithAdminRule(cmd.adminRule).
+		WithMinUpdateDelay(cmd.MinUpdateDelay)
+
+	fact := nft.NewProposeModelConfigFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.Proposal,
+		policy,
+		base.Height(cmd.ExpiresAt),
+		cmd.Currency.CID,
+	)
+
+	op, err := nft.NewProposeModelConfig(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
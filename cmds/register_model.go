@@ -14,19 +14,54 @@ import (
 type RegisterModelCommand struct {
 	BaseCommand
 	ccmds.OperationFlags
-	Sender    ccmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
-	Contract  ccmds.AddressFlag    `arg:"" name:"contract" help:"contract account to register policy" required:"true"`
-	Name      string               `arg:"" name:"name" help:"collection name" required:"true"`
-	Royalty   uint                 `arg:"" name:"royalty" help:"royalty parameter; 0 <= royalty param < 100" required:"true"`
-	Currency  ccmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
-	URI       string               `name:"uri" help:"collection uri" optional:""`
-	White     ccmds.AddressFlag    `name:"white" help:"whitelisted address" optional:""`
-	sender    base.Address
-	contract  base.Address
-	name      types.CollectionName
-	royalty   types.PaymentParameter
-	uri       types.URI
-	whitelist []base.Address
+	Sender           ccmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract         ccmds.AddressFlag    `arg:"" name:"contract" help:"contract account to register policy" required:"true"`
+	Name             string               `arg:"" name:"name" help:"collection name" required:"true"`
+	Royalty          uint                 `arg:"" name:"royalty" help:"royalty parameter; 0 <= royalty param < 100" required:"true"`
+	Currency         ccmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	URI              string               `name:"uri" help:"collection uri" optional:""`
+	White            ccmds.AddressFlag    `name:"white" help:"whitelisted address" optional:""`
+	Pauser           ccmds.AddressFlag    `name:"pauser" help:"account allowed to pause transfers" optional:""`
+	Clawback         bool                 `name:"clawback" help:"allow collection owner to force transfers" optional:""`
+	MintMode         string               `name:"mint-mode" help:"nft idx assignment; sequential | random | explicit" optional:""`
+	MaxSupply        uint64               `name:"max-supply" help:"maximum number of nfts; required for random mint mode" optional:""`
+	BaseURI          string               `name:"base-uri" help:"uri prefix of nfts minted without uri" optional:""`
+	URISuffix        string               `name:"uri-suffix" help:"uri suffix appended after the nft idx" optional:""`
+	Symbol           string               `name:"symbol" help:"ticker-style collection symbol" optional:""`
+	Description      string               `name:"description" help:"collection description" optional:""`
+	ExternalURL      string               `name:"external-url" help:"link to the external page of the collection" optional:""`
+	ContractURI      string               `name:"contract-uri" help:"uri of the contract-level metadata" optional:""`
+	HashAlgorithm    []string             `name:"hash-algorithm" help:"accepted nft hash algorithm; sha2-256 | sha2-512 | sha3-256 | sha3-512 | keccak-256 | blake2b-256" optional:""`
+	URIScheme        []string             `name:"uri-scheme" help:"allowed uri scheme, eg. ipfs, ar, https" optional:""`
+	URIHost          []string             `name:"uri-host" help:"allowed host of http and https uris" optional:""`
+	AttributeUpdater ccmds.AddressFlag    `name:"attribute-updater" help:"account allowed to update nft attributes" optional:""`
+	Oracle           []ccmds.AddressFlag  `name:"oracle" help:"account allowed to update nft dynamic state" optional:""`
+	DynamicInterval  uint64               `name:"dynamic-update-interval" help:"minimum blocks between dynamic state updates of a nft" optional:""`
+	MemberCurrency   ccmds.CurrencyIDFlag `name:"membership-currency" help:"currency of membership renewal payments" optional:""`
+	MemberAmount     ccmds.BigFlag        `name:"membership-amount" help:"renewal price per membership period" optional:""`
+	MemberPeriod     uint64               `name:"membership-period" help:"blocks a membership lasts per period" optional:""`
+	Treasury         ccmds.AddressFlag    `name:"treasury" help:"account receiving membership renewal payments" optional:""`
+	MaxRoyalty       uint                 `name:"max-royalty" help:"max royalty parameter a nft can override; 0 disables overrides" optional:""`
+	Admin            []ccmds.AddressFlag  `name:"admin" help:"account approving config change proposals" optional:""`
+	AdminThreshold   uint                 `name:"admin-threshold" help:"number of admin approvals applying a proposal" optional:""`
+	MinUpdateDelay   uint64               `name:"min-update-delay" help:"minimum blocks before a config update takes effect" optional:""`
+	sender           base.Address
+	contract         base.Address
+	name             types.CollectionName
+	royalty          types.PaymentParameter
+	uri              types.URI
+	whitelist        []base.Address
+	pauser           base.Address
+	mintMode         types.MintMode
+	baseURI          types.URI
+	uriSuffix        types.URI
+	hashAlgorithms   []types.HashAlgorithm
+	uriRule          types.URIRule
+	attributeUpdater base.Address
+	oracleRule       types.OracleRule
+	membershipRule   types.MembershipRule
+	maxRoyalty       types.PaymentParameter
+	adminRule        types.AdminRule
 }
 
 func (cmd *RegisterModelCommand) Run(pctx context.Context) error {
@@ -74,7 +109,23 @@ func (cmd *RegisterModelCommand) parseFlags() error {
 		}
 	}
 
-	name := types.CollectionName(cmd.Name)
+	if cmd.Pauser.String() != "" {
+		if a, err := cmd.Pauser.Encode(cmd.Encoders.JSON()); err != nil {
+			return errors.Wrapf(err, "invalid pauser address format, %v", cmd.Pauser)
+		} else {
+			cmd.pauser = a
+		}
+	}
+
+	if cmd.AttributeUpdater.String() != "" {
+		if a, err := cmd.AttributeUpdater.Encode(cmd.Encoders.JSON()); err != nil {
+			return errors.Wrapf(err, "invalid attribute updater address format, %v", cmd.AttributeUpdater)
+		} else {
+			cmd.attributeUpdater = a
+		}
+	}
+
+	name := types.NormalizeCollectionName(cmd.Name)
 	if err := name.IsValid(nil); err != nil {
 		return err
 	} else {
@@ -88,6 +139,13 @@ func (cmd *RegisterModelCommand) parseFlags() error {
 		cmd.royalty = royalty
 	}
 
+	maxRoyalty := types.PaymentParameter(cmd.MaxRoyalty)
+	if err := maxRoyalty.IsValid(nil); err != nil {
+		return err
+	} else {
+		cmd.maxRoyalty = maxRoyalty
+	}
+
 	uri := types.URI(cmd.URI)
 	if err := uri.IsValid(nil); err != nil {
 		return err
@@ -95,6 +153,95 @@ func (cmd *RegisterModelCommand) parseFlags() error {
 		cmd.uri = uri
 	}
 
+	if err := util.CheckIsValiders(nil, false,
+		types.CollectionSymbol(cmd.Symbol),
+		types.CollectionDescription(cmd.Description),
+		types.URI(cmd.ExternalURL),
+		types.URI(cmd.ContractURI),
+	); err != nil {
+		return err
+	}
+
+	hashAlgorithms := make([]types.HashAlgorithm, len(cmd.HashAlgorithm))
+	for i, ha := range cmd.HashAlgorithm {
+		hashAlgorithms[i] = types.HashAlgorithm(ha)
+	}
+	if err := types.IsValidHashAlgorithms(hashAlgorithms); err != nil {
+		return err
+	} else {
+		cmd.hashAlgorithms = hashAlgorithms
+	}
+
+	uriRule := types.NewURIRule(cmd.URIScheme, cmd.URIHost)
+	if err := uriRule.IsValid(nil); err != nil {
+		return err
+	} else {
+		cmd.uriRule = uriRule
+	}
+
+	oracles := make([]base.Address, len(cmd.Oracle))
+	for i := range cmd.Oracle {
+		if a, err := cmd.Oracle[i].Encode(cmd.Encoders.JSON()); err != nil {
+			return errors.Wrapf(err, "invalid oracle address format, %v", cmd.Oracle[i])
+		} else {
+			oracles[i] = a
+		}
+	}
+
+	oracleRule := types.NewOracleRule(oracles, cmd.DynamicInterval)
+	if err := oracleRule.IsValid(nil); err != nil {
+		return err
+	} else {
+		cmd.oracleRule = oracleRule
+	}
+
+	admins := make([]base.Address, len(cmd.Admin))
+	for i := range cmd.Admin {
+		if a, err := cmd.Admin[i].Encode(cmd.Encoders.JSON()); err != nil {
+			return errors.Wrapf(err, "invalid admin address format, %v", cmd.Admin[i])
+		} else {
+			admins[i] = a
+		}
+	}
+
+	adminRule := types.NewAdminRule(admins, cmd.AdminThreshold)
+	if err := adminRule.IsValid(nil); err != nil {
+		return err
+	} else {
+		cmd.adminRule = adminRule
+	}
+
+	var treasury base.Address
+	if cmd.Treasury.String() != "" {
+		if a, err := cmd.Treasury.Encode(cmd.Encoders.JSON()); err != nil {
+			return errors.Wrapf(err, "invalid treasury address format, %v", cmd.Treasury)
+		} else {
+			treasury = a
+		}
+	}
+
+	membershipRule := types.NewMembershipRule(cmd.MemberCurrency.CID, cmd.MemberAmount.Big, cmd.MemberPeriod, treasury)
+	if err := membershipRule.IsValid(nil); err != nil {
+		return err
+	} else {
+		cmd.membershipRule = membershipRule
+	}
+
+	baseURI, uriSuffix := types.URI(cmd.BaseURI), types.URI(cmd.URISuffix)
+	if err := types.IsValidBaseURI(baseURI, uriSuffix); err != nil {
+		return err
+	} else {
+		cmd.baseURI = baseURI
+		cmd.uriSuffix = uriSuffix
+	}
+
+	mintMode := types.MintMode(cmd.MintMode)
+	if err := mintMode.IsValid(nil); err != nil {
+		return err
+	} else {
+		cmd.mintMode = mintMode
+	}
+
 	whitelist := []base.Address{}
 	if white != nil {
 		whitelist = append(whitelist, white)
@@ -116,6 +263,24 @@ func (cmd *RegisterModelCommand) createOperation() (base.Operation, error) {
 		cmd.royalty,
 		cmd.uri,
 		cmd.whitelist,
+		cmd.pauser,
+		cmd.Clawback,
+		cmd.mintMode,
+		cmd.MaxSupply,
+		cmd.baseURI,
+		cmd.uriSuffix,
+		types.CollectionSymbol(cmd.Symbol),
+		types.CollectionDescription(cmd.Description),
+		types.URI(cmd.ExternalURL),
+		types.URI(cmd.ContractURI),
+		cmd.hashAlgorithms,
+		cmd.uriRule,
+		cmd.attributeUpdater,
+		cmd.oracleRule,
+		cmd.membershipRule,
+		cmd.maxRoyalty,
+		cmd.adminRule,
+		cmd.MinUpdateDelay,
 		cmd.Currency.CID,
 	)
 
@@ -0,0 +1,98 @@
+package cmds
+
+import (
+	"context"
+
+	ccmds "github.com/imfact-labs/currency-model/app/cmds"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/operation/nft"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+type RevealCommand struct {
+	BaseCommand
+	ccmds.OperationFlags
+	Sender   ccmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract ccmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	BaseURI  string               `arg:"" name:"base-uri" help:"final base uri" required:"true"`
+	Seed     uint64               `arg:"" name:"seed" help:"reveal seed"`
+	Supply   uint64               `arg:"" name:"supply" help:"number of nfts covered by commitment" required:"true"`
+	Salt     string               `arg:"" name:"salt" help:"hex salt of commitment" required:"true"`
+	Currency ccmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	sender   base.Address
+	contract base.Address
+	salt     []byte
+}
+
+func (cmd *RevealCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	ccmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *RevealCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid sender address format, %v", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Contract.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", cmd.Contract)
+	} else {
+		cmd.contract = a
+	}
+
+	salt, err := parseRevealSalt(cmd.Salt)
+	if err != nil {
+		return err
+	}
+	cmd.salt = salt
+
+	return nil
+}
+
+func (cmd *RevealCommand) createOperation() (base.Operation, error) {
+	e := util.StringError("failed to create reveal operation")
+
+	fact := nft.NewRevealFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		types.URI(cmd.BaseURI),
+		cmd.Seed,
+		types.RevealURIRoot(types.URI(cmd.BaseURI), cmd.Seed, cmd.Supply),
+		cmd.salt,
+		cmd.Currency.CID,
+	)
+
+	op, err := nft.NewReveal(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
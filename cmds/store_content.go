@@ -0,0 +1,133 @@
+package cmds
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+
+	ccmds "github.com/imfact-labs/currency-model/app/cmds"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/operation/nft"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+type StoreContentCommand struct {
+	BaseCommand
+	ccmds.OperationFlags
+	Sender    ccmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract  ccmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	ContentID uint64               `arg:"" name:"content-id" help:"on-chain content id"`
+	Index     uint64               `arg:"" name:"index" help:"chunk index"`
+	Currency  ccmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	File      string               `name:"file" help:"content file; the chunk at index is stored" optional:""`
+	Hex       string               `name:"hex" help:"chunk data in hex" optional:""`
+	sender    base.Address
+	contract  base.Address
+	data      []byte
+}
+
+func (cmd *StoreContentCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	ccmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *StoreContentCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid sender address format, %v", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Contract.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", cmd.Contract)
+	} else {
+		cmd.contract = a
+	}
+
+	switch {
+	case len(cmd.File) > 0 && len(cmd.Hex) > 0:
+		return errors.Errorf("both --file and --hex given")
+	case len(cmd.File) > 0:
+		b, err := os.ReadFile(cmd.File)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read content file, %v", cmd.File)
+		}
+
+		chunks := splitContent(b)
+		if cmd.Index >= uint64(len(chunks)) {
+			return errors.Errorf("chunk index over chunks of file, %d >= %d", cmd.Index, len(chunks))
+		}
+		cmd.data = chunks[cmd.Index]
+	case len(cmd.Hex) > 0:
+		b, err := hex.DecodeString(cmd.Hex)
+		if err != nil {
+			return errors.Wrap(err, "invalid chunk hex")
+		}
+		cmd.data = b
+	default:
+		return errors.Errorf("empty chunk data; --file or --hex required")
+	}
+
+	return nil
+}
+
+func (cmd *StoreContentCommand) createOperation() (base.Operation, error) {
+	e := util.StringError("failed to create store-content operation")
+
+	fact := nft.NewStoreContentFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.ContentID,
+		cmd.Index,
+		cmd.data,
+		cmd.Currency.CID,
+	)
+
+	op, err := nft.NewStoreContent(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
+
+// splitContent splits the content into the chunks of the max chunk size.
+func splitContent(b []byte) [][]byte {
+	var chunks [][]byte
+	for len(b) > 0 {
+		n := len(b)
+		if n > types.MaxContentChunkSize {
+			n = types.MaxContentChunkSize
+		}
+
+		chunks = append(chunks, b[:n])
+		b = b[n:]
+	}
+
+	return chunks
+}
@@ -0,0 +1,100 @@
+package cmds
+
+import (
+	"context"
+
+	ccmds "github.com/imfact-labs/currency-model/app/cmds"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/operation/nft"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+type UpdateAttributesCommand struct {
+	BaseCommand
+	ccmds.OperationFlags
+	Sender     ccmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract   ccmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	NFTIdx     uint64               `arg:"" name:"nft" help:"target nft idx"`
+	Currency   ccmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	Set        []AttributeFlag      `name:"set" help:"attribute to set \"<key>:<string|int|bool>:<value>\"" optional:""`
+	Remove     []string             `name:"remove" help:"attribute key to remove" optional:""`
+	sender     base.Address
+	contract   base.Address
+	attributes types.Attributes
+}
+
+func (cmd *UpdateAttributesCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	ccmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *UpdateAttributesCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid sender address format, %v", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Contract.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", cmd.Contract)
+	} else {
+		cmd.contract = a
+	}
+
+	attributes := make(types.Attributes, len(cmd.Set))
+	for i := range cmd.Set {
+		attributes[i] = cmd.Set[i].Attribute()
+	}
+	if err := attributes.IsValid(nil); err != nil {
+		return err
+	} else {
+		cmd.attributes = attributes
+	}
+
+	return nil
+}
+
+func (cmd *UpdateAttributesCommand) createOperation() (base.Operation, error) {
+	e := util.StringError("failed to create update-attributes operation")
+
+	fact := nft.NewUpdateAttributesFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.NFTIdx,
+		cmd.attributes,
+		cmd.Remove,
+		cmd.Currency.CID,
+	)
+
+	op, err := nft.NewUpdateAttributes(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
@@ -0,0 +1,108 @@
+package cmds
+
+import (
+	"context"
+
+	ccmds "github.com/imfact-labs/currency-model/app/cmds"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/operation/nft"
+	"github.com/pkg/errors"
+)
+
+type UpdateDenylistCommand struct {
+	BaseCommand
+	ccmds.OperationFlags
+	Sender   ccmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract ccmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	Currency ccmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	Accounts []ccmds.AddressFlag  `arg:"" name:"accounts" help:"accounts to add or remove"`
+	Mode     string               `name:"mode" help:"denylist mode; add | remove" optional:""`
+	sender   base.Address
+	contract base.Address
+	accounts []base.Address
+	mode     nft.DenylistMode
+}
+
+func (cmd *UpdateDenylistCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	ccmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *UpdateDenylistCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid sender address format, %v", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Contract.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", cmd.Contract)
+	} else {
+		cmd.contract = a
+	}
+
+	accounts := make([]base.Address, len(cmd.Accounts))
+	for i := range cmd.Accounts {
+		if a, err := cmd.Accounts[i].Encode(cmd.Encoders.JSON()); err != nil {
+			return errors.Wrapf(err, "invalid account address format, %v", cmd.Accounts[i])
+		} else {
+			accounts[i] = a
+		}
+	}
+	cmd.accounts = accounts
+
+	if len(cmd.Mode) < 1 {
+		cmd.mode = nft.DenylistAdd
+	} else {
+		mode := nft.DenylistMode(cmd.Mode)
+		if err := mode.IsValid(nil); err != nil {
+			return err
+		}
+		cmd.mode = mode
+	}
+
+	return nil
+}
+
+func (cmd *UpdateDenylistCommand) createOperation() (base.Operation, error) {
+	e := util.StringError("failed to create update-denylist operation")
+
+	fact := nft.NewUpdateDenylistFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.mode,
+		cmd.accounts,
+		cmd.Currency.CID,
+	)
+
+	op, err := nft.NewUpdateDenylist(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
@@ -0,0 +1,85 @@
+package cmds
+
+import (
+	"context"
+
+	ccmds "github.com/imfact-labs/currency-model/app/cmds"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/operation/nft"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+type UpdateDynamicStateCommand struct {
+	BaseCommand
+	ccmds.OperationFlags
+	Sender   ccmds.AddressFlag    `arg:"" name:"sender" help:"oracle address" required:"true"`
+	Contract ccmds.AddressFlag    `arg:"" name:"contract" help:"contract address" required:"true"`
+	NFTIdx   uint64               `arg:"" name:"nft" help:"target nft idx"`
+	Currency ccmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	Level    uint64               `name:"level" help:"nft level" optional:""`
+	Score    int64                `name:"score" help:"nft score" optional:""`
+	Variant  string               `name:"variant" help:"uri variant of nft" optional:""`
+	sender   base.Address
+	contract base.Address
+}
+
+func (cmd *UpdateDynamicStateCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	ccmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *UpdateDynamicStateCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid sender address format, %v", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Contract.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", cmd.Contract)
+	} else {
+		cmd.contract = a
+	}
+
+	return types.IsValidDynamicVariant(cmd.Variant)
+}
+
+func (cmd *UpdateDynamicStateCommand) createOperation() (base.Operation, error) {
+	e := util.StringError("failed to create update-dynamic-state operation")
+
+	item := nft.NewUpdateDynamicStateItem(
+		cmd.contract, cmd.NFTIdx, cmd.Level, cmd.Score, cmd.Variant, cmd.Currency.CID)
+
+	fact := nft.NewUpdateDynamicStateFact([]byte(cmd.Token), cmd.sender, []nft.UpdateDynamicStateItem{item})
+
+	op, err := nft.NewUpdateDynamicState(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
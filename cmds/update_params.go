@@ -0,0 +1,89 @@
+package cmds
+
+import (
+	"context"
+
+	ccmds "github.com/imfact-labs/currency-model/app/cmds"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/operation/nft"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+type UpdateParamsCommand struct {
+	BaseCommand
+	ccmds.OperationFlags
+	Node             ccmds.AddressFlag `arg:"" name:"node" help:"node address" required:"true"`
+	MaxMintItems     uint64            `name:"max-mint-items" help:"max items in a mint operation" default:"100"`
+	MaxTransferItems uint64            `name:"max-transfer-items" help:"max items in a transfer operation" default:"100"`
+	MaxWhitelist     uint64            `name:"max-whitelist" help:"max minters of a collection or a series" default:"20"`
+	MaxAllApproved   uint64            `name:"max-all-approved" help:"max operators of an account" default:"10"`
+	MaxSigners       uint64            `name:"max-signers" help:"max creators of an nft" default:"10"`
+	MaxURILength     uint64            `name:"max-uri-length" help:"max length of uris" default:"1000"`
+	MaxNFTHashLength uint64            `name:"max-nft-hash-length" help:"max length of nft hash" default:"1024"`
+	MaxApproveItems  uint64            `name:"max-approve-items" help:"max items in an approve operation" default:"100"`
+	MaxAddSignItems  uint64            `name:"max-add-signature-items" help:"max items in an add-signature operation" default:"100"`
+	URIScheme        []string          `name:"uri-scheme" help:"default allowed uri scheme of collections, eg. ipfs, ar, https" optional:""`
+	URIHost          []string          `name:"uri-host" help:"default allowed host of http and https uris of collections" optional:""`
+	node             base.Address
+	params           types.Params
+}
+
+func (cmd *UpdateParamsCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	ccmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *UpdateParamsCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Node.Encode(cmd.Encoders.JSON()); err != nil {
+		return errors.Wrapf(err, "invalid node address format, %v", cmd.Node)
+	} else {
+		cmd.node = a
+	}
+
+	cmd.params = types.NewParams(
+		cmd.MaxMintItems, cmd.MaxTransferItems, cmd.MaxWhitelist, cmd.MaxAllApproved,
+		cmd.MaxSigners, cmd.MaxURILength, cmd.MaxNFTHashLength, cmd.MaxApproveItems, cmd.MaxAddSignItems,
+	).WithURIRule(types.NewURIRule(cmd.URIScheme, cmd.URIHost))
+	if err := cmd.params.IsValid(nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cmd *UpdateParamsCommand) createOperation() (base.Operation, error) {
+	e := util.StringError("failed to create update-params operation")
+
+	fact := nft.NewUpdateParamsFact([]byte(cmd.Token), cmd.params)
+
+	op, err := nft.NewUpdateParams(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.NodeSign(cmd.Privatekey, cmd.NetworkID.NetworkID(), cmd.node)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
@@ -1,9 +1,15 @@
 package digest
 
 import (
+	"errors"
+
 	cdigest "github.com/imfact-labs/currency-model/digest"
+	cstate "github.com/imfact-labs/currency-model/state"
 	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
 	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 )
 
@@ -34,15 +40,92 @@ func PrepareNFTs(bs *cdigest.BlockSession, st base.State) (string, []mongo.Write
 		}
 
 		return DefaultColNameNFT, j, nil
+	case state.DenylistKey:
+		j, err := handleNFTDenylistState(bs, st)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return DefaultColNameNFTDenylist, j, nil
+	case state.ClawbackKey:
+		j, err := handleNFTClawbackState(bs, st)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return DefaultColNameNFTClawback, j, nil
+	case state.RevealKey:
+		j, err := handleNFTRevealState(bs, st)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return DefaultColNameNFTReveal, j, nil
+	case state.DynamicKey:
+		j, err := handleNFTDynamicState(bs, st)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return DefaultColNameNFTDynamic, j, nil
+	case state.SeriesKey:
+		j, err := handleNFTSeriesState(bs, st)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return DefaultColNameNFTSeries, j, nil
+	case state.PendingPolicyKey:
+		j, err := handleNFTPendingPolicyState(bs, st)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return DefaultColNameNFTPending, j, nil
+	case state.OwnershipKey:
+		j, err := handleNFTOwnershipState(bs, st)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return DefaultColNameNFTOwnership, j, nil
+	case state.ContentKey:
+		j, err := handleNFTContentState(bs, st)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return DefaultColNameNFTContent, j, nil
+	case state.ContentChunkKey:
+		j, err := handleNFTContentChunkState(bs, st)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return DefaultColNameNFTChunk, j, nil
 	}
 
 	return "", nil, nil
 }
 
 func handleNFTCollectionState(bs *cdigest.BlockSession, st base.State) ([]mongo.WriteModel, error) {
-	if nftCollectionDoc, err := NewNFTCollectionDoc(st, bs.Database().Encoder()); err != nil {
+	parsedKey, err := cstate.ParseStateKey(st.Key(), state.NFTPrefix, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := blockNFTPendingPolicy(bs, parsedKey[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if nftCollectionDoc, err := NewNFTCollectionDoc(st, pending, bs.Database().Encoder()); err != nil {
 		return nil, err
 	} else {
+		if err := reindexNFTURIs(bs, parsedKey[1], st.Height(), nftCollectionDoc.de.Policy()); err != nil {
+			return nil, err
+		}
+
 		return []mongo.WriteModel{
 			mongo.NewInsertOneModel().SetDocument(nftCollectionDoc),
 		}, nil
@@ -60,7 +143,17 @@ func handleNFTOperatorsState(bs *cdigest.BlockSession, st base.State) ([]mongo.W
 }
 
 func handleNFTState(bs *cdigest.BlockSession, st base.State) ([]mongo.WriteModel, error) {
-	if nftDoc, err := NewNFTDoc(st, bs.Database().Encoder()); err != nil {
+	parsedKey, err := cstate.ParseStateKey(st.Key(), state.NFTPrefix, 4)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := blockNFTCollectionPolicy(bs, parsedKey[1], st.Height())
+	if err != nil {
+		return nil, err
+	}
+
+	if nftDoc, err := NewNFTDoc(st, policy, bs.Database().Encoder()); err != nil {
 		return nil, err
 	} else {
 		return []mongo.WriteModel{
@@ -68,3 +161,245 @@ func handleNFTState(bs *cdigest.BlockSession, st base.State) ([]mongo.WriteModel
 		}, nil
 	}
 }
+
+func handleNFTDenylistState(bs *cdigest.BlockSession, st base.State) ([]mongo.WriteModel, error) {
+	if nftDenylistDoc, err := NewNFTDenylistDoc(st, bs.Database().Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(nftDenylistDoc),
+		}, nil
+	}
+}
+
+func handleNFTClawbackState(bs *cdigest.BlockSession, st base.State) ([]mongo.WriteModel, error) {
+	if nftClawbackDoc, err := NewNFTClawbackDoc(st, bs.Database().Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(nftClawbackDoc),
+		}, nil
+	}
+}
+
+func handleNFTRevealState(bs *cdigest.BlockSession, st base.State) ([]mongo.WriteModel, error) {
+	if nftRevealDoc, err := NewNFTRevealDoc(st, bs.Database().Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(nftRevealDoc),
+		}, nil
+	}
+}
+
+func handleNFTDynamicState(bs *cdigest.BlockSession, st base.State) ([]mongo.WriteModel, error) {
+	if nftDynamicDoc, err := NewNFTDynamicDoc(st, bs.Database().Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(nftDynamicDoc),
+		}, nil
+	}
+}
+
+func handleNFTSeriesState(bs *cdigest.BlockSession, st base.State) ([]mongo.WriteModel, error) {
+	if nftSeriesDoc, err := NewNFTSeriesDoc(st, bs.Database().Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(nftSeriesDoc),
+		}, nil
+	}
+}
+
+func handleNFTPendingPolicyState(bs *cdigest.BlockSession, st base.State) ([]mongo.WriteModel, error) {
+	if nftPendingDoc, err := NewNFTPendingPolicyDoc(st, bs.Database().Encoder()); err != nil {
+		return nil, err
+	} else {
+		pending := nftPendingDoc.pending
+		if pending.IsScheduled() && pending.EffectiveAt <= st.Height() {
+			if err := reindexNFTURIs(bs, nftPendingDoc.contract(), st.Height(), pending.Policy); err != nil {
+				return nil, err
+			}
+		}
+
+		// the collection states of this block prepared before the pending
+		// policy state.
+		for _, doc := range blockDocs[*NFTCollectionDoc](bs, DefaultColNameNFTCollection) {
+			if doc.de.Contract().String() == nftPendingDoc.contract() {
+				doc.applyPending(pending)
+			}
+		}
+
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(nftPendingDoc),
+		}, nil
+	}
+}
+
+func handleNFTOwnershipState(bs *cdigest.BlockSession, st base.State) ([]mongo.WriteModel, error) {
+	if nftOwnershipDoc, err := NewNFTOwnershipDoc(st, bs.Database().Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(nftOwnershipDoc),
+		}, nil
+	}
+}
+
+func handleNFTContentState(bs *cdigest.BlockSession, st base.State) ([]mongo.WriteModel, error) {
+	if nftContentDoc, err := NewNFTContentDoc(st, bs.Database().Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(nftContentDoc),
+		}, nil
+	}
+}
+
+func handleNFTContentChunkState(bs *cdigest.BlockSession, st base.State) ([]mongo.WriteModel, error) {
+	if nftContentChunkDoc, err := NewNFTContentChunkDoc(st, bs.Database().Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(nftContentChunkDoc),
+		}, nil
+	}
+}
+
+// blockDocs returns the documents of the collection prepared so far by the
+// block session.
+func blockDocs[T any](bs *cdigest.BlockSession, col string) []T {
+	var docs []T
+
+	for i := range bs.WriteModels[col] {
+		m, ok := bs.WriteModels[col][i].(*mongo.InsertOneModel)
+		if !ok {
+			continue
+		}
+
+		if doc, ok := m.Document.(T); ok {
+			docs = append(docs, doc)
+		}
+	}
+
+	return docs
+}
+
+// blockNFTPendingPolicy returns the latest pending policy of the collection
+// from the states of the block session, or from the database; it is nil when
+// the collection has no pending policy.
+func blockNFTPendingPolicy(bs *cdigest.BlockSession, contract string) (*state.PendingPolicyStateValue, error) {
+	docs := blockDocs[*NFTPendingPolicyDoc](bs, DefaultColNameNFTPending)
+	for i := len(docs) - 1; i >= 0; i-- {
+		if docs[i].contract() == contract {
+			return &docs[i].pending, nil
+		}
+	}
+
+	switch pending, err := NFTPendingPolicy(bs.Database(), contract); {
+	case err == nil:
+		return pending, nil
+	case errors.Is(err, util.ErrNotFound):
+		return nil, nil
+	default:
+		return nil, err
+	}
+}
+
+// blockNFTCollectionPolicy returns the policy of the collection effective at
+// height from the latest collection state of the block session, or from the
+// database; it is nil when the collection is not found.
+func blockNFTCollectionPolicy(
+	bs *cdigest.BlockSession, contract string, height base.Height,
+) (*types.CollectionPolicy, error) {
+	var design *types.Design
+
+	docs := blockDocs[*NFTCollectionDoc](bs, DefaultColNameNFTCollection)
+	for i := len(docs) - 1; i >= 0; i-- {
+		if docs[i].de.Contract().String() == contract {
+			design = &docs[i].de
+
+			break
+		}
+	}
+
+	if design == nil {
+		switch de, err := nftCollectionState(bs.Database(), contract); {
+		case errors.Is(err, util.ErrNotFound):
+			return nil, nil
+		case err != nil:
+			return nil, err
+		default:
+			pending, err := blockNFTPendingPolicy(bs, contract)
+			if err != nil {
+				return nil, err
+			}
+
+			if pending != nil {
+				*de = pending.Apply(*de, height)
+			}
+
+			design = de
+		}
+	}
+
+	policy, ok := design.Policy().(types.CollectionPolicy)
+	if !ok {
+		return nil, nil
+	}
+
+	return &policy, nil
+}
+
+// reindexNFTURIs updates the uris of the nfts of the collection built from the
+// base uri when the base uri or the uri suffix of the policy changes.
+func reindexNFTURIs(bs *cdigest.BlockSession, contract string, height base.Height, p types.BasePolicy) error {
+	policy, ok := p.(types.CollectionPolicy)
+	if !ok {
+		return nil
+	}
+
+	switch prev, err := blockNFTCollectionPolicy(bs, contract, height); {
+	case err != nil:
+		return err
+	case prev == nil,
+		prev.BaseURI() == policy.BaseURI() && prev.URISuffix() == policy.URISuffix():
+		return nil
+	}
+
+	// the nft states of this block prepared before the policy.
+	for _, doc := range blockDocs[*NFTDoc](bs, DefaultColNameNFT) {
+		if doc.contract() == contract {
+			doc.uri = policy.TokenURI(doc.nft.ID(), doc.nft.URI())
+		}
+	}
+
+	var models []mongo.WriteModel
+	if err := NFTsByCollection(bs.Database(), contract, "", "", false, nil, 0, 0,
+		func(nft types.NFT, st base.State) (bool, error) {
+			if nft.URI() != "" {
+				return true, nil
+			}
+
+			models = append(models, mongo.NewUpdateOneModel().
+				SetFilter(bson.D{
+					{Key: "contract", Value: contract},
+					{Key: "nft_idx", Value: nft.ID()},
+					{Key: "height", Value: st.Height()},
+				}).
+				SetUpdate(bson.D{{Key: "$set", Value: bson.D{
+					{Key: "uri", Value: policy.TokenURI(nft.ID(), nft.URI())},
+				}}}),
+			)
+
+			return true, nil
+		},
+	); err != nil {
+		return err
+	}
+
+	bs.WriteModels[DefaultColNameNFT] = append(bs.WriteModels[DefaultColNameNFT], models...)
+
+	return nil
+}
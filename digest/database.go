@@ -2,6 +2,7 @@ package digest
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	cdigest "github.com/imfact-labs/currency-model/digest"
@@ -21,9 +22,39 @@ var (
 	DefaultColNameNFTCollection = "digest_nftcollection"
 	DefaultColNameNFT           = "digest_nft"
 	DefaultColNameNFTOperator   = "digest_nftoperator"
+	DefaultColNameNFTDenylist   = "digest_nftdenylist"
+	DefaultColNameNFTClawback   = "digest_nftclawback"
+	DefaultColNameNFTReveal     = "digest_nftreveal"
+	DefaultColNameNFTDynamic    = "digest_nftdynamic"
+	DefaultColNameNFTContent    = "digest_nftcontent"
+	DefaultColNameNFTChunk      = "digest_nftcontentchunk"
+	DefaultColNameNFTSeries     = "digest_nftseries"
+	DefaultColNameNFTPending    = "digest_nftpendingpolicy"
+	DefaultColNameNFTOwnership  = "digest_nftownership"
 )
 
+// NFTCollection returns the collection design with the scheduled policy
+// applied when it takes effect at or before the last block.
 func NFTCollection(st *cdigest.Database, contract string) (*types.Design, error) {
+	design, err := nftCollectionState(st, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := NFTPendingPolicy(st, contract)
+	switch {
+	case errors.Is(err, util.ErrNotFound):
+		return design, nil
+	case err != nil:
+		return nil, err
+	}
+
+	de := pending.Apply(*design, st.LastBlock())
+
+	return &de, nil
+}
+
+func nftCollectionState(st *cdigest.Database, contract string) (*types.Design, error) {
 	filter := cutil.NewBSONFilter("contract", contract)
 
 	var design *types.Design
@@ -53,6 +84,37 @@ func NFTCollection(st *cdigest.Database, contract string) (*types.Design, error)
 	return design, nil
 }
 
+// NFTPendingPolicy returns the latest pending policy state of the collection.
+func NFTPendingPolicy(st *cdigest.Database, contract string) (*state.PendingPolicyStateValue, error) {
+	filter := cutil.NewBSONFilter("contract", contract)
+
+	var pending *state.PendingPolicyStateValue
+	var sta base.State
+	var err error
+	if err := st.MongoClient().GetByFilter(
+		DefaultColNameNFTPending,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = cdigest.LoadState(res.Decode, st.Encoders())
+			if err != nil {
+				return err
+			}
+
+			pending, err = state.StatePendingPolicyValue(sta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		options.FindOne().SetSort(cutil.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, util.ErrNotFound.WithMessage(err, "nft pending policy for contract account %v", contract)
+	}
+
+	return pending, nil
+}
+
 func NFT(st *cdigest.Database, contract, idx string) (*types.NFT, error) {
 	i, err := strconv.ParseUint(idx, 10, 64)
 	if err != nil {
@@ -87,10 +149,19 @@ func NFT(st *cdigest.Database, contract, idx string) (*types.NFT, error) {
 	return nft, nil
 }
 
+// NFTTrait is the attribute key and the text form of its value used to filter
+// nfts.
+type NFTTrait struct {
+	Key   string
+	Value string
+}
+
 func NFTsByCollection(
 	st *cdigest.Database,
 	contract, factHash, offset string,
 	reverse bool,
+	traits []NFTTrait,
+	series uint64,
 	limit int64,
 	callback func(nft types.NFT, st base.State) (bool, error),
 ) error {
@@ -109,6 +180,10 @@ func NFTsByCollection(
 		match = append(match, bson.E{Key: "facthash", Value: factHash})
 	}
 
+	if series > 0 {
+		match = append(match, bson.E{Key: "series_id", Value: series})
+	}
+
 	if offset != "" {
 		match = append(match, bson.E{
 			Key:   "nft_idx",
@@ -135,6 +210,18 @@ func NFTsByCollection(
 		}}},
 	}
 
+	if len(traits) > 0 {
+		matches := make(bson.A, len(traits))
+		for i, trait := range traits {
+			matches[i] = bson.D{{Key: "traits", Value: bson.D{{Key: "$elemMatch", Value: bson.D{
+				{Key: "key", Value: trait.Key},
+				{Key: "value", Value: trait.Value},
+			}}}}}
+		}
+
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.D{{Key: "$and", Value: matches}}}})
+	}
+
 	if limit > 0 {
 		pipeline = append(pipeline, bson.D{{Key: "$limit", Value: limit}})
 	}
@@ -190,3 +277,292 @@ func NFTOperators(
 
 	return operators, nil
 }
+
+func NFTDenied(
+	st *cdigest.Database,
+	contract, account string,
+) (bool, error) {
+	filter := cutil.NewBSONFilter("contract", contract)
+	filter = filter.Add("address", account)
+
+	var denied bool
+	var sta base.State
+	var err error
+	if err := st.MongoClient().GetByFilter(
+		DefaultColNameNFTDenylist,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = cdigest.LoadState(res.Decode, st.Encoders())
+			if err != nil {
+				return err
+			}
+
+			denied, err = state.StateDenylistValue(sta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		options.FindOne().SetSort(cutil.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return false, util.ErrNotFound.WithMessage(err, "nft denylist by contract %s and account %s", contract, account)
+	}
+
+	return denied, nil
+}
+
+func NFTClawbacks(
+	st *cdigest.Database,
+	contract, idx string,
+	limit int64,
+	callback func(clawback state.ClawbackStateValue, st base.State) (bool, error),
+) error {
+	i, err := strconv.ParseUint(idx, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	filter := cutil.NewBSONFilter("contract", contract)
+	filter = filter.Add("nft_idx", i)
+
+	if limit < 1 || limit > maxLimit {
+		limit = maxLimit
+	}
+
+	opt := options.Find().SetSort(
+		cutil.NewBSONFilter("height", -1).D(),
+	).SetLimit(limit)
+
+	return st.MongoClient().Find(
+		context.Background(),
+		DefaultColNameNFTClawback,
+		filter.D(),
+		func(cursor *mongo.Cursor) (bool, error) {
+			st, err := cdigest.LoadState(cursor.Decode, st.Encoders())
+			if err != nil {
+				return false, err
+			}
+			clawback, err := state.StateClawbackValue(st)
+			if err != nil {
+				return false, err
+			}
+			return callback(*clawback, st)
+		},
+		opt,
+	)
+}
+
+// NFTOwnerships returns the ownership transfers of the collection from the
+// latest one.
+func NFTOwnerships(
+	st *cdigest.Database,
+	contract string,
+	limit int64,
+	callback func(ownership state.OwnershipStateValue, st base.State) (bool, error),
+) error {
+	filter := cutil.NewBSONFilter("contract", contract)
+
+	if limit < 1 || limit > maxLimit {
+		limit = maxLimit
+	}
+
+	opt := options.Find().SetSort(
+		cutil.NewBSONFilter("height", -1).D(),
+	).SetLimit(limit)
+
+	return st.MongoClient().Find(
+		context.Background(),
+		DefaultColNameNFTOwnership,
+		filter.D(),
+		func(cursor *mongo.Cursor) (bool, error) {
+			st, err := cdigest.LoadState(cursor.Decode, st.Encoders())
+			if err != nil {
+				return false, err
+			}
+			ownership, err := state.StateOwnershipValue(st)
+			if err != nil {
+				return false, err
+			}
+			return callback(*ownership, st)
+		},
+		opt,
+	)
+}
+
+func NFTReveal(st *cdigest.Database, contract string) (*types.Reveal, error) {
+	filter := cutil.NewBSONFilter("contract", contract)
+
+	var reveal *types.Reveal
+	var sta base.State
+	var err error
+	if err := st.MongoClient().GetByFilter(
+		DefaultColNameNFTReveal,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = cdigest.LoadState(res.Decode, st.Encoders())
+			if err != nil {
+				return err
+			}
+
+			reveal, err = state.StateRevealValue(sta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		options.FindOne().SetSort(cutil.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, util.ErrNotFound.WithMessage(err, "nft reveal for contract account %v", contract)
+	}
+
+	return reveal, nil
+}
+
+func NFTDynamic(st *cdigest.Database, contract, idx string) (*types.DynamicState, error) {
+	i, err := strconv.ParseUint(idx, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := cutil.NewBSONFilter("contract", contract)
+	filter = filter.Add("nft_idx", i)
+
+	var dynamic *types.DynamicState
+	var sta base.State
+	if err := st.MongoClient().GetByFilter(
+		DefaultColNameNFTDynamic,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = cdigest.LoadState(res.Decode, st.Encoders())
+			if err != nil {
+				return err
+			}
+
+			dynamic, err = state.StateDynamicValue(sta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		options.FindOne().SetSort(cutil.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, util.ErrNotFound.WithMessage(
+			err, "nft dynamic state by contract %s and nft idx %s", contract, idx)
+	}
+
+	return dynamic, nil
+}
+
+func NFTSeries(st *cdigest.Database, contract, id string) (*types.Series, error) {
+	i, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := cutil.NewBSONFilter("contract", contract)
+	filter = filter.Add("series_id", i)
+
+	var series *types.Series
+	var sta base.State
+	if err := st.MongoClient().GetByFilter(
+		DefaultColNameNFTSeries,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = cdigest.LoadState(res.Decode, st.Encoders())
+			if err != nil {
+				return err
+			}
+
+			series, err = state.StateSeriesValue(sta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		options.FindOne().SetSort(cutil.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, util.ErrNotFound.WithMessage(
+			err, "nft series state by contract %s and series id %s", contract, id)
+	}
+
+	return series, nil
+}
+
+func NFTContent(st *cdigest.Database, contract, id string) (*types.ContentInfo, error) {
+	i, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := cutil.NewBSONFilter("contract", contract)
+	filter = filter.Add("content_id", i)
+
+	var content *types.ContentInfo
+	var sta base.State
+	if err := st.MongoClient().GetByFilter(
+		DefaultColNameNFTContent,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = cdigest.LoadState(res.Decode, st.Encoders())
+			if err != nil {
+				return err
+			}
+
+			content, err = state.StateContentValue(sta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		options.FindOne().SetSort(cutil.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, util.ErrNotFound.WithMessage(
+			err, "nft content by contract %s and content id %s", contract, id)
+	}
+
+	return content, nil
+}
+
+// NFTContentChunk returns the latest n-th chunk of the content. The chunks of
+// a finalized content are not changed.
+func NFTContentChunk(st *cdigest.Database, contract, id string, n uint64) ([]byte, error) {
+	i, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := cutil.NewBSONFilter("contract", contract)
+	filter = filter.Add("content_id", i)
+	filter = filter.Add("index", n)
+
+	var chunk []byte
+	var sta base.State
+	if err := st.MongoClient().GetByFilter(
+		DefaultColNameNFTChunk,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = cdigest.LoadState(res.Decode, st.Encoders())
+			if err != nil {
+				return err
+			}
+
+			chunk, err = state.StateContentChunkValue(sta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		options.FindOne().SetSort(cutil.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, util.ErrNotFound.WithMessage(
+			err, "nft content chunk %d by contract %s and content id %s", n, contract, id)
+	}
+
+	return chunk, nil
+}
@@ -1,6 +1,8 @@
 package digest
 
 import (
+	"strconv"
+
 	mongodbst "github.com/imfact-labs/currency-model/digest/mongodb"
 	cstate "github.com/imfact-labs/currency-model/state"
 	"github.com/imfact-labs/currency-model/utils/bsonenc"
@@ -8,6 +10,7 @@ import (
 	"github.com/imfact-labs/mitum2/util/encoder"
 	"github.com/imfact-labs/nft-model/state"
 	"github.com/imfact-labs/nft-model/types"
+	"go.mongodb.org/mongo-driver/v2/bson"
 )
 
 type NFTCollectionDoc struct {
@@ -16,21 +19,35 @@ type NFTCollectionDoc struct {
 	de types.Design
 }
 
-func NewNFTCollectionDoc(st base.State, enc encoder.Encoder) (NFTCollectionDoc, error) {
+// NewNFTCollectionDoc creates the document of the collection state with the
+// pending policy applied when it takes effect at the state height; pending
+// may be nil.
+func NewNFTCollectionDoc(
+	st base.State, pending *state.PendingPolicyStateValue, enc encoder.Encoder,
+) (*NFTCollectionDoc, error) {
 	de, err := state.StateCollectionValue(st)
 	if err != nil {
-		return NFTCollectionDoc{}, err
+		return nil, err
 	}
 	b, err := mongodbst.NewBaseDoc(nil, st, enc)
 	if err != nil {
-		return NFTCollectionDoc{}, err
+		return nil, err
 	}
 
-	return NFTCollectionDoc{
+	doc := &NFTCollectionDoc{
 		BaseDoc: b,
 		st:      st,
 		de:      *de,
-	}, nil
+	}
+	if pending != nil {
+		doc.applyPending(*pending)
+	}
+
+	return doc, nil
+}
+
+func (doc *NFTCollectionDoc) applyPending(pending state.PendingPolicyStateValue) {
+	doc.de = pending.Apply(doc.de, doc.st.Height())
 }
 
 func (doc NFTCollectionDoc) MarshalBSON() ([]byte, error) {
@@ -52,9 +69,12 @@ type NFTDoc struct {
 	nft       types.NFT
 	addresses []base.Address
 	owner     string
+	uri       types.URI
 }
 
-func NewNFTDoc(st base.State, enc encoder.Encoder) (*NFTDoc, error) {
+// NewNFTDoc creates the document of the nft state. policy is the policy of
+// the collection used to resolve the nft uri; it may be nil.
+func NewNFTDoc(st base.State, policy *types.CollectionPolicy, enc encoder.Encoder) (*NFTDoc, error) {
 	nft, err := state.StateNFTValue(st)
 	if err != nil {
 		return nil, err
@@ -69,15 +89,30 @@ func NewNFTDoc(st base.State, enc encoder.Encoder) (*NFTDoc, error) {
 		return nil, err
 	}
 
+	uri := nft.URI()
+	if policy != nil {
+		uri = policy.TokenURI(nft.ID(), uri)
+	}
+
 	return &NFTDoc{
 		BaseDoc:   b,
 		st:        st,
 		nft:       *nft,
 		addresses: nft.Addresses(),
 		owner:     nft.Owner().String(),
+		uri:       uri,
 	}, nil
 }
 
+func (doc NFTDoc) contract() string {
+	parsedKey, err := cstate.ParseStateKey(doc.st.Key(), state.NFTPrefix, 4)
+	if err != nil {
+		return ""
+	}
+
+	return parsedKey[1]
+}
+
 func (doc NFTDoc) MarshalBSON() ([]byte, error) {
 	m, err := doc.BaseDoc.M()
 	if err != nil {
@@ -92,6 +127,20 @@ func (doc NFTDoc) MarshalBSON() ([]byte, error) {
 	m["contract"] = parsedKey[1]
 	m["nft_idx"] = doc.nft.ID()
 	m["owner"] = doc.nft.Owner()
+	m["uri"] = doc.uri
+	if ch, err := doc.nft.NFTHash().ContentHash(); err == nil {
+		m["hash_algorithm"] = ch.Algorithm()
+	}
+	if attrs := doc.nft.Attributes(); len(attrs) > 0 {
+		traits := make([]bson.M, len(attrs))
+		for i, a := range attrs {
+			traits[i] = bson.M{"key": a.Key(), "value": a.Text()}
+		}
+		m["traits"] = traits
+	}
+	if series := doc.nft.Series(); series > 0 {
+		m["series_id"] = series
+	}
 	m["addresses"] = doc.addresses
 	m["istoken"] = true
 	m["height"] = doc.st.Height()
@@ -179,3 +228,401 @@ func (doc NFTLastIndexDoc) MarshalBSON() ([]byte, error) {
 
 	return bsonenc.Marshal(m)
 }
+
+type NFTDenylistDoc struct {
+	mongodbst.BaseDoc
+	st     base.State
+	denied bool
+}
+
+func NewNFTDenylistDoc(st base.State, enc encoder.Encoder) (*NFTDenylistDoc, error) {
+	denied, err := state.StateDenylistValue(st)
+	if err != nil {
+		return nil, err
+	}
+	b, err := mongodbst.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NFTDenylistDoc{
+		BaseDoc: b,
+		st:      st,
+		denied:  denied,
+	}, nil
+}
+
+func (doc NFTDenylistDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+	parsedKey, err := cstate.ParseStateKey(doc.st.Key(), state.NFTPrefix, 4)
+	if err != nil {
+		return nil, err
+	}
+
+	m["contract"] = parsedKey[1]
+	m["address"] = parsedKey[2]
+	m["denied"] = doc.denied
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type NFTClawbackDoc struct {
+	mongodbst.BaseDoc
+	st       base.State
+	clawback state.ClawbackStateValue
+}
+
+func NewNFTClawbackDoc(st base.State, enc encoder.Encoder) (*NFTClawbackDoc, error) {
+	clawback, err := state.StateClawbackValue(st)
+	if err != nil {
+		return nil, err
+	}
+	b, err := mongodbst.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NFTClawbackDoc{
+		BaseDoc:  b,
+		st:       st,
+		clawback: *clawback,
+	}, nil
+}
+
+func (doc NFTClawbackDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+	parsedKey, err := cstate.ParseStateKey(doc.st.Key(), state.NFTPrefix, 5)
+	if err != nil {
+		return nil, err
+	}
+
+	nftIdx, err := strconv.ParseUint(parsedKey[2], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	m["contract"] = parsedKey[1]
+	m["nft_idx"] = nftIdx
+	m["operation"] = parsedKey[3]
+	m["from"] = doc.clawback.From().String()
+	m["to"] = doc.clawback.To().String()
+	m["reason"] = doc.clawback.Reason()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type NFTRevealDoc struct {
+	mongodbst.BaseDoc
+	st     base.State
+	reveal types.Reveal
+}
+
+func NewNFTRevealDoc(st base.State, enc encoder.Encoder) (*NFTRevealDoc, error) {
+	reveal, err := state.StateRevealValue(st)
+	if err != nil {
+		return nil, err
+	}
+	b, err := mongodbst.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NFTRevealDoc{
+		BaseDoc: b,
+		st:      st,
+		reveal:  *reveal,
+	}, nil
+}
+
+func (doc NFTRevealDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+	parsedKey, err := cstate.ParseStateKey(doc.st.Key(), state.NFTPrefix, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	m["contract"] = parsedKey[1]
+	m["revealed"] = doc.reveal.Revealed()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type NFTSeriesDoc struct {
+	mongodbst.BaseDoc
+	st     base.State
+	series types.Series
+}
+
+func NewNFTSeriesDoc(st base.State, enc encoder.Encoder) (*NFTSeriesDoc, error) {
+	series, err := state.StateSeriesValue(st)
+	if err != nil {
+		return nil, err
+	}
+	b, err := mongodbst.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NFTSeriesDoc{
+		BaseDoc: b,
+		st:      st,
+		series:  *series,
+	}, nil
+}
+
+func (doc NFTSeriesDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+	parsedKey, err := cstate.ParseStateKey(doc.st.Key(), state.NFTPrefix, 4)
+	if err != nil {
+		return nil, err
+	}
+
+	m["contract"] = parsedKey[1]
+	m["series_id"] = doc.series.ID()
+	m["count"] = doc.series.Count()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type NFTPendingPolicyDoc struct {
+	mongodbst.BaseDoc
+	st      base.State
+	pending state.PendingPolicyStateValue
+}
+
+func NewNFTPendingPolicyDoc(st base.State, enc encoder.Encoder) (*NFTPendingPolicyDoc, error) {
+	pending, err := state.StatePendingPolicyValue(st)
+	if err != nil {
+		return nil, err
+	}
+	b, err := mongodbst.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NFTPendingPolicyDoc{
+		BaseDoc: b,
+		st:      st,
+		pending: *pending,
+	}, nil
+}
+
+func (doc NFTPendingPolicyDoc) contract() string {
+	parsedKey, err := cstate.ParseStateKey(doc.st.Key(), state.NFTPrefix, 3)
+	if err != nil {
+		return ""
+	}
+
+	return parsedKey[1]
+}
+
+func (doc NFTPendingPolicyDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+	parsedKey, err := cstate.ParseStateKey(doc.st.Key(), state.NFTPrefix, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	m["contract"] = parsedKey[1]
+	m["effective_at"] = doc.pending.EffectiveAt
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type NFTOwnershipDoc struct {
+	mongodbst.BaseDoc
+	st        base.State
+	ownership state.OwnershipStateValue
+}
+
+func NewNFTOwnershipDoc(st base.State, enc encoder.Encoder) (*NFTOwnershipDoc, error) {
+	ownership, err := state.StateOwnershipValue(st)
+	if err != nil {
+		return nil, err
+	}
+	b, err := mongodbst.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NFTOwnershipDoc{
+		BaseDoc:   b,
+		st:        st,
+		ownership: *ownership,
+	}, nil
+}
+
+func (doc NFTOwnershipDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+	parsedKey, err := cstate.ParseStateKey(doc.st.Key(), state.NFTPrefix, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	m["contract"] = parsedKey[1]
+	m["from"] = doc.ownership.From().String()
+	m["to"] = doc.ownership.To().String()
+	m["accepted"] = doc.ownership.Accepted()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type NFTDynamicDoc struct {
+	mongodbst.BaseDoc
+	st      base.State
+	dynamic types.DynamicState
+}
+
+func NewNFTDynamicDoc(st base.State, enc encoder.Encoder) (*NFTDynamicDoc, error) {
+	dynamic, err := state.StateDynamicValue(st)
+	if err != nil {
+		return nil, err
+	}
+	b, err := mongodbst.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NFTDynamicDoc{
+		BaseDoc: b,
+		st:      st,
+		dynamic: *dynamic,
+	}, nil
+}
+
+func (doc NFTDynamicDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+	parsedKey, err := cstate.ParseStateKey(doc.st.Key(), state.NFTPrefix, 4)
+	if err != nil {
+		return nil, err
+	}
+
+	nftIdx, err := strconv.ParseUint(parsedKey[2], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	m["contract"] = parsedKey[1]
+	m["nft_idx"] = nftIdx
+	m["counter"] = doc.dynamic.Counter()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type NFTContentDoc struct {
+	mongodbst.BaseDoc
+	st base.State
+}
+
+func NewNFTContentDoc(st base.State, enc encoder.Encoder) (*NFTContentDoc, error) {
+	if _, err := state.StateContentValue(st); err != nil {
+		return nil, err
+	}
+	b, err := mongodbst.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NFTContentDoc{
+		BaseDoc: b,
+		st:      st,
+	}, nil
+}
+
+func (doc NFTContentDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+	parsedKey, err := cstate.ParseStateKey(doc.st.Key(), state.NFTPrefix, 5)
+	if err != nil {
+		return nil, err
+	}
+
+	contentID, err := strconv.ParseUint(parsedKey[3], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	m["contract"] = parsedKey[1]
+	m["content_id"] = contentID
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type NFTContentChunkDoc struct {
+	mongodbst.BaseDoc
+	st base.State
+}
+
+func NewNFTContentChunkDoc(st base.State, enc encoder.Encoder) (*NFTContentChunkDoc, error) {
+	if _, err := state.StateContentChunkValue(st); err != nil {
+		return nil, err
+	}
+	b, err := mongodbst.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NFTContentChunkDoc{
+		BaseDoc: b,
+		st:      st,
+	}, nil
+}
+
+func (doc NFTContentChunkDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+	parsedKey, err := cstate.ParseStateKey(doc.st.Key(), state.NFTPrefix, 5)
+	if err != nil {
+		return nil, err
+	}
+
+	contentID, err := strconv.ParseUint(parsedKey[3], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := strconv.ParseUint(parsedKey[4], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	m["contract"] = parsedKey[1]
+	m["content_id"] = contentID
+	m["index"] = index
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
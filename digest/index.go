@@ -33,6 +33,25 @@ var nftIndexModels = []mongo.IndexModel{
 		Options: options.Index().
 			SetName(cdigest.IndexPrefix + "nft_facthash"),
 	},
+	{
+		Keys: bson.D{
+			bson.E{Key: "contract", Value: 1},
+			bson.E{Key: "series_id", Value: 1},
+			bson.E{Key: "nft_idx", Value: 1},
+			bson.E{Key: "height", Value: -1},
+		},
+		Options: options.Index().
+			SetName(cdigest.IndexPrefix + "nft_contract_series_idx_height"),
+	},
+	{
+		Keys: bson.D{
+			bson.E{Key: "contract", Value: 1},
+			bson.E{Key: "traits.key", Value: 1},
+			bson.E{Key: "traits.value", Value: 1},
+		},
+		Options: options.Index().
+			SetName(cdigest.IndexPrefix + "nft_contract_traits"),
+	},
 }
 
 var nftOperatorIndexModels = []mongo.IndexModel{
@@ -46,10 +65,116 @@ var nftOperatorIndexModels = []mongo.IndexModel{
 	},
 }
 
+var nftDenylistIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "contract", Value: 1},
+			bson.E{Key: "address", Value: 1},
+			bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName(cdigest.IndexPrefix + "nft_denylist_contract_address_height"),
+	},
+}
+
+var nftClawbackIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "contract", Value: 1},
+			bson.E{Key: "nft_idx", Value: 1},
+			bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName(cdigest.IndexPrefix + "nft_clawback_contract_idx_height"),
+	},
+}
+
+var nftRevealIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "contract", Value: 1},
+			bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName(cdigest.IndexPrefix + "nft_reveal_contract_height"),
+	},
+}
+
+var nftPendingIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "contract", Value: 1},
+			bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName(cdigest.IndexPrefix + "nft_pending_policy_contract_height"),
+	},
+}
+
+var nftOwnershipIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "contract", Value: 1},
+			bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName(cdigest.IndexPrefix + "nft_ownership_contract_height"),
+	},
+}
+
+var nftDynamicIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "contract", Value: 1},
+			bson.E{Key: "nft_idx", Value: 1},
+			bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName(cdigest.IndexPrefix + "nft_dynamic_contract_idx_height"),
+	},
+}
+
+var nftSeriesIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "contract", Value: 1},
+			bson.E{Key: "series_id", Value: 1},
+			bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName(cdigest.IndexPrefix + "nft_series_contract_id_height"),
+	},
+}
+
+var nftContentIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "contract", Value: 1},
+			bson.E{Key: "content_id", Value: 1},
+			bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName(cdigest.IndexPrefix + "nft_content_contract_id_height"),
+	},
+}
+
+var nftContentChunkIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "contract", Value: 1},
+			bson.E{Key: "content_id", Value: 1},
+			bson.E{Key: "index", Value: 1},
+			bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName(cdigest.IndexPrefix + "nft_content_chunk_contract_id_index_height"),
+	},
+}
+
 var DefaultIndexes = cdigest.DefaultIndexes
 
 func init() {
 	DefaultIndexes[DefaultColNameNFTCollection] = nftCollectionIndexModels
 	DefaultIndexes[DefaultColNameNFT] = nftIndexModels
 	DefaultIndexes[DefaultColNameNFTOperator] = nftOperatorIndexModels
+	DefaultIndexes[DefaultColNameNFTDenylist] = nftDenylistIndexModels
+	DefaultIndexes[DefaultColNameNFTClawback] = nftClawbackIndexModels
+	DefaultIndexes[DefaultColNameNFTReveal] = nftRevealIndexModels
+	DefaultIndexes[DefaultColNameNFTDynamic] = nftDynamicIndexModels
+	DefaultIndexes[DefaultColNameNFTSeries] = nftSeriesIndexModels
+	DefaultIndexes[DefaultColNameNFTPending] = nftPendingIndexModels
+	DefaultIndexes[DefaultColNameNFTOwnership] = nftOwnershipIndexModels
+	DefaultIndexes[DefaultColNameNFTContent] = nftContentIndexModels
+	DefaultIndexes[DefaultColNameNFTChunk] = nftContentChunkIndexModels
 }
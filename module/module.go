@@ -42,6 +42,16 @@ func (Module) Register(reg *modulekit.Registry) error {
 		modulekit.APIRoute{Path: modapi.HandlerPathNFTs, Methods: []string{"GET"}},
 		modulekit.APIRoute{Path: modapi.HandlerPathNFTAllApproved, Methods: []string{"GET"}},
 		modulekit.APIRoute{Path: modapi.HandlerPathNFT, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: modapi.HandlerPathNFTDenylist, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: modapi.HandlerPathNFTClawbacks, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: modapi.HandlerPathNFTReveal, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: modapi.HandlerPathNFTDynamic, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: modapi.HandlerPathNFTContent, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: modapi.HandlerPathNFTMember, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: modapi.HandlerPathNFTSeries, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: modapi.HandlerPathNFTRoyalty, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: modapi.HandlerPathNFTPending, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: modapi.HandlerPathNFTOwnerships, Methods: []string{"GET"}},
 	); err != nil {
 		return err
 	}
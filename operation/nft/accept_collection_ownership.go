@@ -0,0 +1,154 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	AcceptCollectionOwnershipFactHint = hint.MustNewHint("mitum-nft-accept-collection-ownership-operation-fact-v0.0.1")
+	AcceptCollectionOwnershipHint     = hint.MustNewHint("mitum-nft-accept-collection-ownership-operation-v0.0.1")
+)
+
+type AcceptCollectionOwnershipFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	currency ctypes.CurrencyID
+}
+
+func NewAcceptCollectionOwnershipFact(
+	token []byte,
+	sender, contract base.Address,
+	currency ctypes.CurrencyID,
+) AcceptCollectionOwnershipFact {
+	bf := base.NewBaseFact(AcceptCollectionOwnershipFactHint, token)
+
+	fact := AcceptCollectionOwnershipFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact AcceptCollectionOwnershipFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact AcceptCollectionOwnershipFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact AcceptCollectionOwnershipFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AcceptCollectionOwnershipFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact AcceptCollectionOwnershipFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact AcceptCollectionOwnershipFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact AcceptCollectionOwnershipFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact AcceptCollectionOwnershipFact) Currency() ctypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact AcceptCollectionOwnershipFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+func (fact AcceptCollectionOwnershipFact) FeeBase() map[ctypes.CurrencyID][]common.Big {
+	required := make(map[ctypes.CurrencyID][]common.Big)
+	required[fact.Currency()] = []common.Big{common.ZeroBig}
+
+	return required
+}
+
+func (fact AcceptCollectionOwnershipFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact AcceptCollectionOwnershipFact) FeeItemCount() (uint, bool) {
+	return extras.ZeroItem, extras.HasNoItem
+}
+
+func (fact AcceptCollectionOwnershipFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact AcceptCollectionOwnershipFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact AcceptCollectionOwnershipFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact AcceptCollectionOwnershipFact) DupKey() (map[ctypes.DuplicationKeyType][]string, error) {
+	r := make(map[ctypes.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fact.sender.String()}
+	r[extras.DuplicationKeyTypeContractStatus] = []string{fact.contract.String()}
+
+	return r, nil
+}
+
+// AcceptCollectionOwnership accepts the pending ownership transfer of the
+// collection of the contract account by the new owner.
+type AcceptCollectionOwnership struct {
+	extras.ExtendedOperation
+}
+
+func NewAcceptCollectionOwnership(fact AcceptCollectionOwnershipFact) (AcceptCollectionOwnership, error) {
+	return AcceptCollectionOwnership{
+		ExtendedOperation: extras.NewExtendedOperation(AcceptCollectionOwnershipHint, fact),
+	}, nil
+}
@@ -0,0 +1,164 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+var acceptCollectionOwnershipProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AcceptCollectionOwnershipProcessor)
+	},
+}
+
+func (AcceptCollectionOwnership) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type AcceptCollectionOwnershipProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewAcceptCollectionOwnershipProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new AcceptCollectionOwnershipProcessor")
+
+		nopp := acceptCollectionOwnershipProcessorPool.Get()
+		opp, ok := nopp.(*AcceptCollectionOwnershipProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected AcceptCollectionOwnershipProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *AcceptCollectionOwnershipProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(AcceptCollectionOwnershipFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", AcceptCollectionOwnershipFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	st, err := cstate.ExistsState(state.NFTStateKey(fact.Contract(), state.CollectionKey), "design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service state for contract account %v", fact.Contract())), nil
+	}
+
+	design, err := state.EffectiveCollectionValue(st, opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service state value for contract account %v", fact.Contract())), nil
+	}
+
+	if !design.Active() {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service in contract account %v has already been deactivated ", fact.Contract())), nil
+	}
+
+	ownership, err := pendingOwnership(fact.Contract(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("ownership of contract account %v: %v", fact.Contract(), err)), nil
+	}
+
+	if ownership == nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+				Errorf("pending ownership transfer of contract account %v", fact.Contract())), nil
+	}
+
+	if !ownership.To().Equal(fact.Sender()) {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("sender %v is not the new owner of contract account %v", fact.Sender(), fact.Contract())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *AcceptCollectionOwnershipProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, _ := op.Fact().(AcceptCollectionOwnershipFact)
+
+	st, err := cstate.ExistsState(state.NFTStateKey(fact.Contract(), state.CollectionKey), "design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection design not found, %v: %w", fact.Contract(), err), nil
+	}
+
+	design, err := state.EffectiveCollectionValue(st, opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection design value not found, %v: %w", fact.Contract(), err), nil
+	}
+
+	ownership, err := pendingOwnership(fact.Contract(), getStateFunc)
+	switch {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("ownership not found, %v: %w", fact.Contract(), err), nil
+	case ownership == nil:
+		return nil, base.NewBaseOperationProcessReasonError("pending ownership not found, %v", fact.Contract()), nil
+	}
+
+	de := types.NewDesign(
+		design.Contract(), fact.Sender(), design.Active(), design.Paused(), design.Count(), design.Policy(),
+	)
+
+	return []base.StateMergeValue{
+		cstate.NewStateMergeValue(state.NFTStateKey(fact.Contract(), state.CollectionKey), state.NewCollectionStateValue(de)),
+		cstate.NewStateMergeValue(
+			state.StateKeyOwnership(fact.Contract()),
+			state.NewOwnershipStateValue(ownership.From(), fact.Sender(), true),
+		),
+	}, nil, nil
+}
+
+func (opp *AcceptCollectionOwnershipProcessor) Close() error {
+	acceptCollectionOwnershipProcessorPool.Put(opp)
+
+	return nil
+}
@@ -1,7 +1,6 @@
 package nft
 
 import (
-	"fmt"
 	"strconv"
 
 	"github.com/imfact-labs/currency-model/common"
@@ -20,8 +19,6 @@ var (
 	AddSignatureHint     = hint.MustNewHint("mitum-nft-add-signature-operation-v0.0.1")
 )
 
-var MaxAddSignatureItems = 100
-
 type AddSignatureFact struct {
 	base.BaseFact
 	sender base.Address
@@ -47,8 +44,6 @@ func (fact AddSignatureFact) IsValid(b []byte) error {
 
 	if l := len(fact.items); l < 1 {
 		return common.ErrFactInvalid.Wrap(common.ErrArrayLen.Wrap(errors.Errorf("empty items for AddSignatureFact")))
-	} else if l > MaxAddSignatureItems {
-		return common.ErrArrayLen.Wrap(errors.Errorf("items over allowed, %d > %d", l, MaxAddSignatureItems))
 	}
 
 	if err := fact.sender.IsValid(nil); err != nil {
@@ -73,7 +68,7 @@ func (fact AddSignatureFact) IsValid(b []byte) error {
 					errors.Errorf("nft idx %v in contract account %v", item.NFT(), item.contract)))
 		}
 
-		founds[nid] = struct{}{}
+		founds[item.contract.String()+"-"+nid] = struct{}{}
 	}
 
 	if err := common.IsValidOperationFact(fact, b); err != nil {
@@ -170,7 +165,7 @@ func (fact AddSignatureFact) DupKey() (map[types.DuplicationKeyType][]string, er
 	r[extras.DuplicationKeyTypeSender] = []string{fact.sender.String()}
 	for _, item := range fact.items {
 		r[processor.DuplicationTypeContractNFT] = append(
-			r[processor.DuplicationTypeContractNFT], fmt.Sprintf("%s:%v", item.contract.String(), item.nftIdx))
+			r[processor.DuplicationTypeContractNFT], processor.DuplicationKeyNFT(item.contract, item.nftIdx))
 	}
 
 	return r, nil
@@ -36,6 +36,8 @@ type AddSignatureItemProcessor struct {
 	h      util.Hash
 	sender base.Address
 	item   AddSignatureItem
+	height base.Height
+	params types.Params
 }
 
 func (ipp *AddSignatureItemProcessor) PreProcess(
@@ -56,7 +58,7 @@ func (ipp *AddSignatureItemProcessor) PreProcess(
 		return e.Wrap(common.ErrServiceNF.Errorf("nft service state for contract account %v: %v", it.Contract(), err))
 	}
 
-	design, err := state.StateCollectionValue(st)
+	design, err := state.EffectiveCollectionValue(st, ipp.height, getStateFunc)
 	if err != nil {
 		return e.Wrap(common.ErrServiceNF.Errorf("nft service state value for contract account %v: %v", it.Contract(), err))
 
@@ -84,6 +86,11 @@ func (ipp *AddSignatureItemProcessor) PreProcess(
 				errors.Errorf("burned nft idx %v in contract account %v", nid, ipp.item.Contract())))
 	}
 
+	if err := ipp.params.CheckSigners(nv.Creators()); err != nil {
+		return e.Wrap(common.ErrValOOR.Wrap(
+			errors.Errorf("creators of nft idx %v in contract account %v: %v", nid, ipp.item.Contract(), err)))
+	}
+
 	if nv.Creators().IsSignedByAddress(ipp.sender) {
 		return e.Wrap(errors.Errorf("already signed nft idx %v by creator %v", nv.ID(), ipp.sender))
 	}
@@ -98,12 +105,12 @@ func (ipp *AddSignatureItemProcessor) Process(
 
 	st, err := cstate.ExistsState(state.StateKeyNFT(ipp.item.Contract(), nid), "nft", getStateFunc)
 	if err != nil {
-		return nil, errors.Errorf("nft not found, %v: %w", nid, err)
+		return nil, errors.Wrapf(err, "nft not found, %v", nid)
 	}
 
 	nv, err := state.StateNFTValue(st)
 	if err != nil {
-		return nil, errors.Errorf("nft value not found, %v: %w", nid, err)
+		return nil, errors.Wrapf(err, "nft value not found, %v", nid)
 	}
 
 	signers := nv.Creators()
@@ -120,13 +127,14 @@ func (ipp *AddSignatureItemProcessor) Process(
 
 	sns := &signers
 	if err := sns.SetSigner(signer); err != nil {
-		return nil, errors.Errorf("failed to set signer for signers, %v: %w", signer, err)
+		return nil, errors.Wrapf(err, "failed to set signer for signers, %v", signer)
 	}
 
-	n := types.NewNFT(nv.ID(), nv.Active(), nv.Owner(), nv.NFTHash(), nv.URI(), nv.Approved(), *sns)
+	n := types.NewNFT(nv.ID(), nv.Active(), nv.Owner(), nv.NFTHash(), nv.URI(), nv.Approved(), *sns).
+		WithOptionalFields(*nv)
 
 	if err := n.IsValid(nil); err != nil {
-		return nil, errors.Errorf("invalid nft, %v: %w", n.ID(), err)
+		return nil, errors.Wrapf(err, "invalid nft, %v", n.ID())
 	}
 
 	sts := make([]base.StateMergeValue, 1)
@@ -140,6 +148,8 @@ func (ipp *AddSignatureItemProcessor) Close() {
 	ipp.h = nil
 	ipp.sender = nil
 	ipp.item = AddSignatureItem{}
+	ipp.height = 0
+	ipp.params = types.Params{}
 	AddSignatureItemProcessorPool.Put(ipp)
 
 	return
@@ -179,34 +189,49 @@ func NewSignProcessor() ctypes.GetNewProcessor {
 func (opp *AddSignatureProcessor) PreProcess(
 	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
 ) (context.Context, base.OperationProcessReasonError, error) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
 	fact, ok := op.Fact().(AddSignatureFact)
 	if !ok {
-		return ctx, base.NewBaseOperationProcessReasonError(
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
 			common.ErrMPreProcess.
 				Wrap(common.ErrMTypeMismatch).
 				Errorf("expected %T, not %T", AddSignatureFact{}, op.Fact())), nil
 	}
 
 	if err := fact.IsValid(nil); err != nil {
-		return ctx, base.NewBaseOperationProcessReasonError(
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
 			common.ErrMPreProcess.
 				Errorf("%v", err)), nil
 	}
 
+	params, rErr := currentParams(getStateFunc)
+	if rErr != nil {
+		return ctx, rErr, nil
+	}
+
+	if err := params.CheckAddSignatureItems(len(fact.Items())); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValOOR).
+				Errorf("%v", err)), nil
+	}
+
 	for _, item := range fact.Items() {
 		ip := AddSignatureItemProcessorPool.Get()
 		ipc, ok := ip.(*AddSignatureItemProcessor)
 		if !ok {
-			return nil, base.NewBaseOperationProcessReasonError(
+			return nil, base.NewBaseOperationProcessReasonError("%s",
 				common.ErrMTypeMismatch.Errorf("expected AddSignatureItemProcessor, not %T", ip)), nil
 		}
 
 		ipc.h = op.Hash()
 		ipc.sender = fact.Sender()
 		ipc.item = item
+		ipc.height = opp.Height()
+		ipc.params = params
 
 		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
-			return nil, base.NewBaseOperationProcessReasonError(
+			return nil, base.NewBaseOperationProcessReasonError("%s",
 				common.ErrMPreProcess.Errorf("%v", err),
 			), nil
 		}
@@ -221,6 +246,8 @@ func (opp *AddSignatureProcessor) Process( // nolint:dupl
 	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
 	[]base.StateMergeValue, base.OperationProcessReasonError, error,
 ) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
 	e := util.StringError("failed to process AddSignature")
 
 	fact, _ := op.Fact().(AddSignatureFact)
@@ -236,6 +263,7 @@ func (opp *AddSignatureProcessor) Process( // nolint:dupl
 		ipc.h = op.Hash()
 		ipc.sender = fact.Sender()
 		ipc.item = item
+		ipc.height = opp.Height()
 
 		s, err := ipc.Process(ctx, op, getStateFunc)
 		if err != nil {
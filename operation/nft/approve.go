@@ -1,7 +1,6 @@
 package nft
 
 import (
-	"fmt"
 	"strconv"
 
 	"github.com/imfact-labs/currency-model/common"
@@ -15,8 +14,6 @@ import (
 	"github.com/pkg/errors"
 )
 
-var MaxApproveItems = 100
-
 var (
 	ApproveFactHint = hint.MustNewHint("mitum-nft-approve-operation-fact-v0.0.1")
 	ApproveHint     = hint.MustNewHint("mitum-nft-approve-operation-v0.0.1")
@@ -48,9 +45,6 @@ func (fact ApproveFact) IsValid(b []byte) error {
 
 	if n := len(fact.items); n < 1 {
 		return common.ErrFactInvalid.Wrap(common.ErrArrayLen.Wrap(errors.Errorf("empty items")))
-	} else if n > int(MaxApproveItems) {
-		return common.ErrFactInvalid.Wrap(
-			common.ErrArrayLen.Wrap(errors.Errorf("items, %d over max, %d", n, MaxApproveItems)))
 	}
 
 	if err := fact.sender.IsValid(nil); err != nil {
@@ -74,7 +68,7 @@ func (fact ApproveFact) IsValid(b []byte) error {
 			return common.ErrFactInvalid.Wrap(common.ErrDupVal.Wrap(errors.Errorf("nft idx %v in contract account %v", item.NFTIdx(), item.contract)))
 		}
 
-		founds[n] = struct{}{}
+		founds[item.contract.String()+"-"+n] = struct{}{}
 	}
 
 	if err := common.IsValidOperationFact(fact, b); err != nil {
@@ -176,7 +170,7 @@ func (fact ApproveFact) DupKey() (map[types.DuplicationKeyType][]string, error)
 	r[extras.DuplicationKeyTypeSender] = []string{fact.sender.String()}
 	for _, item := range fact.items {
 		r[processor.DuplicationTypeContractNFT] = append(
-			r[processor.DuplicationTypeContractNFT], fmt.Sprintf("%s:%v", item.contract.String(), item.nftIdx))
+			r[processor.DuplicationTypeContractNFT], processor.DuplicationKeyNFT(item.contract, item.nftIdx))
 	}
 
 	return r, nil
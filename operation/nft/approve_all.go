@@ -1,8 +1,6 @@
 package nft
 
 import (
-	"fmt"
-
 	"github.com/imfact-labs/currency-model/common"
 	"github.com/imfact-labs/currency-model/operation/extras"
 	"github.com/imfact-labs/currency-model/types"
@@ -177,9 +175,15 @@ func (fact ApproveAllFact) ActiveContract() []base.Address {
 func (fact ApproveAllFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
 	r := make(map[types.DuplicationKeyType][]string)
 	r[extras.DuplicationKeyTypeSender] = []string{fact.sender.String()}
+	founds := map[string]struct{}{}
 	for _, item := range fact.items {
-		r[processor.DuplicationTypeNFTApprove] = append(
-			r[processor.DuplicationTypeNFTApprove], fmt.Sprintf("%s:%s", item.contract.String(), fact.sender.String()))
+		k := processor.DuplicationKeyOperators(item.contract, fact.sender)
+		if _, found := founds[k]; found {
+			continue
+		}
+		founds[k] = struct{}{}
+
+		r[processor.DuplicationTypeNFTApprove] = append(r[processor.DuplicationTypeNFTApprove], k)
 	}
 
 	return r, nil
@@ -33,10 +33,11 @@ func (ApproveAll) Process(
 }
 
 type DelegateItemProcessor struct {
-	h      util.Hash
-	sender base.Address
-	box    *types.AllApprovedBook
-	item   ApproveAllItem
+	h           util.Hash
+	sender      base.Address
+	box         *types.AllApprovedBook
+	item        ApproveAllItem
+	maxApproved uint64
 }
 
 func (ipp *DelegateItemProcessor) PreProcess(
@@ -54,6 +55,12 @@ func (ipp *DelegateItemProcessor) PreProcess(
 			errors.Errorf("%v: approved %v is contract account", cErr, ipp.item.Approved())))
 	}
 
+	if ipp.item.Mode() == ApproveAllAllow {
+		if err := checkNotDenied(ipp.item.Contract(), getStateFunc, ipp.item.Approved()); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
 	return nil
 }
 
@@ -76,6 +83,10 @@ func (ipp *DelegateItemProcessor) Process(
 
 	switch ipp.item.Mode() {
 	case ApproveAllAllow:
+		if l := uint64(len(ipp.box.AllApproved())); !ipp.box.Exists(ipp.item.Approved()) && l >= ipp.maxApproved {
+			return nil, errors.Errorf("all approved over max, %d, %v", ipp.maxApproved, ipp.item.Approved())
+		}
+
 		if err := ipp.box.Append(ipp.item.Approved()); err != nil {
 			return nil, err
 		}
@@ -97,6 +108,7 @@ func (ipp *DelegateItemProcessor) Close() {
 	ipp.sender = nil
 	ipp.item = ApproveAllItem{}
 	ipp.box = nil
+	ipp.maxApproved = 0
 
 	delegateItemProcessorPool.Put(ipp)
 
@@ -137,16 +149,18 @@ func NewDelegateProcessor() ctypes.GetNewProcessor {
 func (opp *DelegateProcessor) PreProcess(
 	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
 ) (context.Context, base.OperationProcessReasonError, error) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
 	fact, ok := op.Fact().(ApproveAllFact)
 	if !ok {
-		return ctx, base.NewBaseOperationProcessReasonError(
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
 			common.ErrMPreProcess.
 				Wrap(common.ErrMTypeMismatch).
 				Errorf("expected %T, not %T", ApproveAllFact{}, op.Fact())), nil
 	}
 
 	if err := fact.IsValid(nil); err != nil {
-		return ctx, base.NewBaseOperationProcessReasonError(
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
 			common.ErrMPreProcess.
 				Errorf("%v", err)), nil
 	}
@@ -155,20 +169,20 @@ func (opp *DelegateProcessor) PreProcess(
 		st, err := cstate.ExistsState(
 			state.NFTStateKey(item.Contract(), state.CollectionKey), "design", getStateFunc)
 		if err != nil {
-			return ctx, base.NewBaseOperationProcessReasonError(
+			return ctx, base.NewBaseOperationProcessReasonError("%s",
 				common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
 					Errorf("nft service state for contract account, %v: %v", item.Contract(), err)), nil
 		}
 
-		design, err := state.StateCollectionValue(st)
+		design, err := state.EffectiveCollectionValue(st, opp.Height(), getStateFunc)
 		if err != nil {
-			return ctx, base.NewBaseOperationProcessReasonError(
+			return ctx, base.NewBaseOperationProcessReasonError("%s",
 				common.ErrMPreProcess.Wrap(common.ErrMStateInvalid).
 					Errorf("nft service state value for contract account, %v: %v", item.Contract(), err)), nil
 		}
 
 		if !design.Active() {
-			return ctx, base.NewBaseOperationProcessReasonError(
+			return ctx, base.NewBaseOperationProcessReasonError("%s",
 				common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
 					Errorf("nft service state for contract account %v has already been deactivated",
 						item.Contract())), nil
@@ -179,7 +193,7 @@ func (opp *DelegateProcessor) PreProcess(
 		ip := delegateItemProcessorPool.Get()
 		ipc, ok := ip.(*DelegateItemProcessor)
 		if !ok {
-			return nil, base.NewBaseOperationProcessReasonError(
+			return nil, base.NewBaseOperationProcessReasonError("%s",
 				common.ErrMTypeMismatch.Errorf("expected DelegateItemProcessor, not %T", ip)), nil
 		}
 
@@ -189,7 +203,7 @@ func (opp *DelegateProcessor) PreProcess(
 		ipc.box = nil
 
 		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
-			return nil, base.NewBaseOperationProcessReasonError(
+			return nil, base.NewBaseOperationProcessReasonError("%s",
 				common.ErrMPreProcess.Errorf("%v", err),
 			), nil
 		}
@@ -204,9 +218,17 @@ func (opp *DelegateProcessor) Process(
 	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
 	[]base.StateMergeValue, base.OperationProcessReasonError, error,
 ) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
 	e := util.StringError("failed to process Delegate")
 
 	fact, _ := op.Fact().(ApproveAllFact)
+
+	params, err := state.CurrentParams(getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft params not found; %w", err), nil
+	}
+
 	boxes := map[string]*types.AllApprovedBook{}
 	for _, item := range fact.Items() {
 		ak := state.StateKeyOperators(item.contract, fact.Sender())
@@ -244,6 +266,7 @@ func (opp *DelegateProcessor) Process(
 		ipc.sender = fact.Sender()
 		ipc.item = item
 		ipc.box = boxes[state.StateKeyOperators(item.contract, fact.Sender())]
+		ipc.maxApproved = params.MaxAllApproved()
 
 		s, err := ipc.Process(ctx, op, getStateFunc)
 		if err != nil {
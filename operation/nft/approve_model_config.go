@@ -0,0 +1,178 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	ApproveModelConfigFactHint = hint.MustNewHint("mitum-nft-approve-model-config-operation-fact-v0.0.1")
+	ApproveModelConfigHint     = hint.MustNewHint("mitum-nft-approve-model-config-operation-v0.0.1")
+)
+
+type ApproveModelConfigFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	proposalID uint64
+	proposal   util.Hash
+	currency   ctypes.CurrencyID
+}
+
+func NewApproveModelConfigFact(
+	token []byte,
+	sender, contract base.Address,
+	proposalID uint64,
+	proposal util.Hash,
+	currency ctypes.CurrencyID,
+) ApproveModelConfigFact {
+	bf := base.NewBaseFact(ApproveModelConfigFactHint, token)
+
+	fact := ApproveModelConfigFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		proposalID: proposalID,
+		proposal:   proposal,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ApproveModelConfigFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.proposal,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if fact.proposalID < 1 {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrValOOR.Wrap(errors.Errorf("proposal id under one, %d", fact.proposalID)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact ApproveModelConfigFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ApproveModelConfigFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ApproveModelConfigFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		util.Uint64ToBytes(fact.proposalID),
+		fact.proposal.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact ApproveModelConfigFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ApproveModelConfigFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ApproveModelConfigFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact ApproveModelConfigFact) ProposalID() uint64 {
+	return fact.proposalID
+}
+
+// ProposalHash is the digest of the approved proposal.
+func (fact ApproveModelConfigFact) ProposalHash() util.Hash {
+	return fact.proposal
+}
+
+func (fact ApproveModelConfigFact) Currency() ctypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact ApproveModelConfigFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+func (fact ApproveModelConfigFact) FeeBase() map[ctypes.CurrencyID][]common.Big {
+	required := make(map[ctypes.CurrencyID][]common.Big)
+	required[fact.Currency()] = []common.Big{common.ZeroBig}
+
+	return required
+}
+
+func (fact ApproveModelConfigFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact ApproveModelConfigFact) FeeItemCount() (uint, bool) {
+	return extras.ZeroItem, extras.HasNoItem
+}
+
+func (fact ApproveModelConfigFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact ApproveModelConfigFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact ApproveModelConfigFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact ApproveModelConfigFact) DupKey() (map[ctypes.DuplicationKeyType][]string, error) {
+	r := make(map[ctypes.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fact.sender.String()}
+	r[extras.DuplicationKeyTypeContractStatus] = []string{fact.contract.String()}
+
+	return r, nil
+}
+
+// ApproveModelConfig approves a pending config proposal of the collection of
+// the contract account by a collection admin. The approval names the digest of
+// the proposal, so it can not approve another proposal with the same id.
+type ApproveModelConfig struct {
+	extras.ExtendedOperation
+}
+
+func NewApproveModelConfig(fact ApproveModelConfigFact) (ApproveModelConfig, error) {
+	return ApproveModelConfig{
+		ExtendedOperation: extras.NewExtendedOperation(ApproveModelConfigHint, fact),
+	}, nil
+}
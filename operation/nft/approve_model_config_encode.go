@@ -0,0 +1,34 @@
+package nft
+
+import (
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+)
+
+func (fact *ApproveModelConfigFact) unpack(
+	enc encoder.Encoder,
+	sd, ct string,
+	id uint64,
+	ph string,
+	cid string,
+) error {
+	fact.currency = ctypes.CurrencyID(cid)
+	fact.proposalID = id
+	fact.proposal = valuehash.NewBytesFromString(ph)
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	contract, err := base.DecodeAddress(ct, enc)
+	if err != nil {
+		return err
+	}
+	fact.contract = contract
+
+	return nil
+}
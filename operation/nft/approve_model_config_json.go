@@ -0,0 +1,79 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type ApproveModelConfigFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender     base.Address      `json:"sender"`
+	Contract   base.Address      `json:"contract"`
+	ProposalID uint64            `json:"proposal_id"`
+	Proposal   util.Hash         `json:"proposal_hash"`
+	Currency   ctypes.CurrencyID `json:"currency"`
+}
+
+func (fact ApproveModelConfigFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ApproveModelConfigFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		ProposalID:            fact.proposalID,
+		Proposal:              fact.proposal,
+		Currency:              fact.currency,
+	})
+}
+
+type ApproveModelConfigFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender     string `json:"sender"`
+	Contract   string `json:"contract"`
+	ProposalID uint64 `json:"proposal_id"`
+	Proposal   string `json:"proposal_hash"`
+	Currency   string `json:"currency"`
+}
+
+func (fact *ApproveModelConfigFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u ApproveModelConfigFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.ProposalID, u.Proposal, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op ApproveModelConfig) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationMarshaler{
+		BaseOperationJSONMarshaler:           op.BaseOperation.JSONMarshaler(),
+		BaseOperationExtensionsJSONMarshaler: op.BaseOperationExtensions.JSONMarshaler(),
+	})
+}
+
+func (op *ApproveModelConfig) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	var ueo extras.BaseOperationExtensions
+	if err := ueo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperationExtensions = &ueo
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+var approveModelConfigProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ApproveModelConfigProcessor)
+	},
+}
+
+func (ApproveModelConfig) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type ApproveModelConfigProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewApproveModelConfigProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new ApproveModelConfigProcessor")
+
+		nopp := approveModelConfigProcessorPool.Get()
+		opp, ok := nopp.(*ApproveModelConfigProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected ApproveModelConfigProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ApproveModelConfigProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(ApproveModelConfigFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", ApproveModelConfigFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if _, _, rErr := loadAdminPolicy(fact.Contract(), fact.Sender(), opp.Height(), getStateFunc); rErr != nil {
+		return ctx, rErr, nil
+	}
+
+	proposals, err := pendingProposals(fact.Contract(), opp.Height(), getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("proposals of contract account %v: %v", fact.Contract(), err)), nil
+	}
+
+	i := findProposal(proposals, fact.ProposalID())
+	if i < 0 {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+				Errorf("pending proposal %d in contract account %v", fact.ProposalID(), fact.Contract())), nil
+	}
+
+	if h := proposals[i].Digest(); !h.Equal(fact.ProposalHash()) {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("proposal %d in contract account %v is not the approved proposal, %v != %v",
+					fact.ProposalID(), fact.Contract(), fact.ProposalHash(), h)), nil
+	}
+
+	if proposals[i].IsApprovedBy(fact.Sender()) {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("proposal %d already approved by %v", fact.ProposalID(), fact.Sender())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ApproveModelConfigProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, _ := op.Fact().(ApproveModelConfigFact)
+
+	st, err := cstate.ExistsState(state.NFTStateKey(fact.Contract(), state.CollectionKey), "design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection design not found, %v: %w", fact.Contract(), err), nil
+	}
+
+	design, err := state.EffectiveCollectionValue(st, opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection design value not found, %v: %w", fact.Contract(), err), nil
+	}
+
+	policy, ok := design.Policy().(types.CollectionPolicy)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"expected %T, not %T", types.CollectionPolicy{}, design.Policy()), nil
+	}
+
+	proposals, err := pendingProposals(fact.Contract(), opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("proposals not found, %v: %w", fact.Contract(), err), nil
+	}
+
+	i := findProposal(proposals, fact.ProposalID())
+	if i < 0 {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"pending proposal %d not found, %v", fact.ProposalID(), fact.Contract()), nil
+	}
+
+	if h := proposals[i].Digest(); !h.Equal(fact.ProposalHash()) {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"proposal %d is not the approved proposal, %v: %v != %v",
+			fact.ProposalID(), fact.Contract(), fact.ProposalHash(), h), nil
+	}
+
+	proposal := proposals[i].WithApproval(fact.Sender())
+	if policy.AdminRule().Approved(proposal.Approvals()) {
+		remains := append(proposals[:i:i], proposals[i+1:]...)
+
+		sts, err := applyProposal(*design, policy, proposal, remains, opp.Height(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("pending policy not found, %v: %w", fact.Contract(), err), nil
+		}
+
+		return sts, nil, nil
+	}
+
+	proposals[i] = proposal
+
+	return []base.StateMergeValue{
+		cstate.NewStateMergeValue(
+			state.StateKeyProposals(fact.Contract()), state.NewProposalsStateValue(proposals)),
+	}, nil, nil
+}
+
+func (opp *ApproveModelConfigProcessor) Close() error {
+	approveModelConfigProcessorPool.Put(opp)
+
+	return nil
+}
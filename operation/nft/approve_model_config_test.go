@@ -0,0 +1,77 @@
+package nft
+
+import (
+	"strings"
+	"testing"
+
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+func TestApproveModelConfigProposalHash(t *testing.T) {
+	g := newTestStateGetter()
+
+	proposer, _ := g.newAccount(t)
+	approver, priv := g.newAccount(t)
+
+	policy := newTestCollectionPolicy().WithAdminRule(
+		types.NewAdminRule([]base.Address{proposer, approver}, 2))
+	contract := g.newCollection(t, proposer, policy)
+
+	stale := types.NewConfigProposal(
+		1, proposer, types.NewCollectionPolicy("stale", 0, "https://example.com", nil),
+		base.Height(10), []base.Address{proposer})
+	proposal := types.NewConfigProposal(
+		1, proposer, types.NewCollectionPolicy("proposed", 0, "https://example.com", nil),
+		base.Height(10), []base.Address{proposer})
+
+	g.set(state.StateKeyProposals(contract), state.NewProposalsStateValue([]types.ConfigProposal{proposal}))
+
+	approve := func(p types.ConfigProposal) ([]base.StateMergeValue, error) {
+		op, err := NewApproveModelConfig(NewApproveModelConfigFact(
+			[]byte("token"), approver, contract, p.ID(), p.Digest(), ctypes.CurrencyID("MCC")))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := op.Sign(priv, testNetworkID); err != nil {
+			t.Fatal(err)
+		}
+
+		return processTestOperation(t, NewApproveModelConfigProcessor(), op, g.GetStateFunc)
+	}
+
+	t.Run("stale proposal", func(t *testing.T) {
+		_, err := approve(stale)
+		switch {
+		case err == nil:
+			t.Fatal("expected error")
+		case !strings.Contains(err.Error(), "is not the approved proposal"):
+			t.Fatalf("unexpected error, %v", err)
+		}
+	})
+
+	t.Run("proposal", func(t *testing.T) {
+		sts, err := approve(proposal)
+		if err != nil {
+			t.Fatal(err)
+		}
+		g.apply(sts)
+
+		st, _, err := g.GetStateFunc(state.NFTStateKey(contract, state.CollectionKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		design, err := state.StateCollectionValue(st)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if name := design.Policy().(types.CollectionPolicy).Name(); name != "proposed" {
+			t.Fatalf("expected proposed policy, not %q", name)
+		}
+	})
+}
@@ -37,6 +37,7 @@ type ApproveItemProcessor struct {
 	h      util.Hash
 	sender base.Address
 	item   ApproveItem
+	height base.Height
 }
 
 func (ipp *ApproveItemProcessor) PreProcess(
@@ -52,7 +53,7 @@ func (ipp *ApproveItemProcessor) PreProcess(
 				errors.Errorf("nft service state for contract account %v", ipp.item.Contract())))
 	}
 
-	design, err := state.StateCollectionValue(st)
+	design, err := state.EffectiveCollectionValue(st, ipp.height, getStateFunc)
 	if err != nil {
 		return e.Wrap(
 			common.ErrServiceNF.Wrap(
@@ -71,6 +72,10 @@ func (ipp *ApproveItemProcessor) PreProcess(
 			errors.Errorf("%v: approved %v is contract account", cErr, ipp.item.Approved())))
 	}
 
+	if err := checkNotDenied(ipp.item.Contract(), getStateFunc, ipp.item.Approved()); err != nil {
+		return e.Wrap(err)
+	}
+
 	st, err = cstate.ExistsState(state.StateKeyNFT(ipp.item.Contract(), ipp.item.nftIdx), "nft", getStateFunc)
 	if err != nil {
 		return e.Wrap(common.ErrStateNF.Wrap(
@@ -155,7 +160,8 @@ func (ipp *ApproveItemProcessor) Process(
 		return nil, util.ErrNotFound.Errorf("nft value, %v: %v", nid, err)
 	}
 
-	n := types.NewNFT(nv.ID(), nv.Active(), nv.Owner(), nv.NFTHash(), nv.URI(), ipp.item.Approved(), nv.Creators())
+	n := types.NewNFT(nv.ID(), nv.Active(), nv.Owner(), nv.NFTHash(), nv.URI(), ipp.item.Approved(), nv.Creators()).
+		WithOptionalFields(*nv)
 	if err := n.IsValid(nil); err != nil {
 		return nil, err
 	}
@@ -169,6 +175,7 @@ func (ipp *ApproveItemProcessor) Close() {
 	ipp.h = nil
 	ipp.sender = nil
 	ipp.item = ApproveItem{}
+	ipp.height = 0
 
 	approveItemProcessorPool.Put(ipp)
 
@@ -209,34 +216,48 @@ func NewApproveProcessor() ctypes.GetNewProcessor {
 func (opp *ApproveProcessor) PreProcess(
 	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
 ) (context.Context, base.OperationProcessReasonError, error) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
 	fact, ok := op.Fact().(ApproveFact)
 	if !ok {
-		return ctx, base.NewBaseOperationProcessReasonError(
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
 			common.ErrMPreProcess.
 				Wrap(common.ErrMTypeMismatch).
 				Errorf("expected %T, not %T", ApproveFact{}, op.Fact())), nil
 	}
 
 	if err := fact.IsValid(nil); err != nil {
-		return ctx, base.NewBaseOperationProcessReasonError(
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
 			common.ErrMPreProcess.
 				Errorf("%v", err)), nil
 	}
 
+	params, rErr := currentParams(getStateFunc)
+	if rErr != nil {
+		return ctx, rErr, nil
+	}
+
+	if err := params.CheckApproveItems(len(fact.Items())); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValOOR).
+				Errorf("%v", err)), nil
+	}
+
 	for _, item := range fact.Items() {
 		ip := approveItemProcessorPool.Get()
 		ipc, ok := ip.(*ApproveItemProcessor)
 		if !ok {
-			return nil, base.NewBaseOperationProcessReasonError(
+			return nil, base.NewBaseOperationProcessReasonError("%s",
 				common.ErrMTypeMismatch.Errorf("expected ApproveItemProcessor, not %T", ip)), nil
 		}
 
 		ipc.h = op.Hash()
 		ipc.sender = fact.Sender()
 		ipc.item = item
+		ipc.height = opp.Height()
 
 		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
-			return nil, base.NewBaseOperationProcessReasonError(
+			return nil, base.NewBaseOperationProcessReasonError("%s",
 				common.ErrMPreProcess.Errorf("%v", err),
 			), nil
 		}
@@ -251,6 +272,8 @@ func (opp *ApproveProcessor) Process(
 	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
 	[]base.StateMergeValue, base.OperationProcessReasonError, error,
 ) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
 	e := util.StringError("failed to process Approve")
 
 	fact, _ := op.Fact().(ApproveFact)
@@ -266,6 +289,7 @@ func (opp *ApproveProcessor) Process(
 		ipc.h = op.Hash()
 		ipc.sender = fact.Sender()
 		ipc.item = item
+		ipc.height = opp.Height()
 
 		s, err := ipc.Process(ctx, op, getStateFunc)
 		if err != nil {
@@ -0,0 +1,190 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	CommitRevealFactHint = hint.MustNewHint("mitum-nft-commit-reveal-operation-fact-v0.0.1")
+	CommitRevealHint     = hint.MustNewHint("mitum-nft-commit-reveal-operation-v0.0.1")
+)
+
+type CommitRevealFact struct {
+	base.BaseFact
+	sender       base.Address
+	contract     base.Address
+	preRevealURI types.URI
+	commitment   util.Hash
+	supply       uint64
+	currency     ctypes.CurrencyID
+}
+
+func NewCommitRevealFact(
+	token []byte,
+	sender, contract base.Address,
+	preRevealURI types.URI,
+	commitment util.Hash,
+	supply uint64,
+	currency ctypes.CurrencyID,
+) CommitRevealFact {
+	bf := base.NewBaseFact(CommitRevealFactHint, token)
+
+	fact := CommitRevealFact{
+		BaseFact:     bf,
+		sender:       sender,
+		contract:     contract,
+		preRevealURI: preRevealURI,
+		commitment:   commitment,
+		supply:       supply,
+		currency:     currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CommitRevealFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.preRevealURI,
+		fact.commitment,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if fact.preRevealURI == "" {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("empty pre-reveal uri")))
+	}
+
+	if fact.supply < 1 || fact.supply > types.MaxCount {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(
+			errors.Errorf("reveal supply out of range, %d, 1 <= supply <= %d", fact.supply, types.MaxCount)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact CommitRevealFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact CommitRevealFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CommitRevealFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.preRevealURI.Bytes(),
+		fact.commitment.Bytes(),
+		util.Uint64ToBytes(fact.supply),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact CommitRevealFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact CommitRevealFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CommitRevealFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact CommitRevealFact) PreRevealURI() types.URI {
+	return fact.preRevealURI
+}
+
+func (fact CommitRevealFact) Commitment() util.Hash {
+	return fact.commitment
+}
+
+func (fact CommitRevealFact) Supply() uint64 {
+	return fact.supply
+}
+
+func (fact CommitRevealFact) Currency() ctypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact CommitRevealFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+func (fact CommitRevealFact) FeeBase() map[ctypes.CurrencyID][]common.Big {
+	required := make(map[ctypes.CurrencyID][]common.Big)
+	required[fact.Currency()] = []common.Big{common.ZeroBig}
+
+	return required
+}
+
+func (fact CommitRevealFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact CommitRevealFact) FeeItemCount() (uint, bool) {
+	return extras.ZeroItem, extras.HasNoItem
+}
+
+func (fact CommitRevealFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact CommitRevealFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact CommitRevealFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact CommitRevealFact) DupKey() (map[ctypes.DuplicationKeyType][]string, error) {
+	r := make(map[ctypes.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fact.sender.String()}
+	r[extras.DuplicationKeyTypeContractStatus] = []string{fact.contract.String()}
+
+	return r, nil
+}
+
+// CommitReveal registers the pre-reveal uri of a collection and the
+// commitment over the ordered list of its final uris.
+type CommitReveal struct {
+	extras.ExtendedOperation
+}
+
+func NewCommitReveal(fact CommitRevealFact) (CommitReveal, error) {
+	return CommitReveal{
+		ExtendedOperation: extras.NewExtendedOperation(CommitRevealHint, fact),
+	}, nil
+}
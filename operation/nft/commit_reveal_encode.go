@@ -0,0 +1,35 @@
+package nft
+
+import (
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+func (fact *CommitRevealFact) unpack(
+	enc encoder.Encoder,
+	sd, ct, pru, cm string,
+	supply uint64,
+	cid string,
+) error {
+	fact.currency = ctypes.CurrencyID(cid)
+	fact.preRevealURI = types.URI(pru)
+	fact.commitment = valuehash.NewBytesFromString(cm)
+	fact.supply = supply
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	contract, err := base.DecodeAddress(ct, enc)
+	if err != nil {
+		return err
+	}
+	fact.contract = contract
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+type CommitRevealFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender       base.Address      `json:"sender"`
+	Contract     base.Address      `json:"contract"`
+	PreRevealURI types.URI         `json:"pre_reveal_uri"`
+	Commitment   util.Hash         `json:"commitment"`
+	Supply       uint64            `json:"supply"`
+	Currency     ctypes.CurrencyID `json:"currency"`
+}
+
+func (fact CommitRevealFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CommitRevealFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		PreRevealURI:          fact.preRevealURI,
+		Commitment:            fact.commitment,
+		Supply:                fact.supply,
+		Currency:              fact.currency,
+	})
+}
+
+type CommitRevealFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender       string `json:"sender"`
+	Contract     string `json:"contract"`
+	PreRevealURI string `json:"pre_reveal_uri"`
+	Commitment   string `json:"commitment"`
+	Supply       uint64 `json:"supply"`
+	Currency     string `json:"currency"`
+}
+
+func (fact *CommitRevealFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u CommitRevealFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.PreRevealURI, u.Commitment, u.Supply, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op CommitReveal) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationMarshaler{
+		BaseOperationJSONMarshaler:           op.BaseOperation.JSONMarshaler(),
+		BaseOperationExtensionsJSONMarshaler: op.BaseOperationExtensions.JSONMarshaler(),
+	})
+}
+
+func (op *CommitReveal) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	var ueo extras.BaseOperationExtensions
+	if err := ueo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperationExtensions = &ueo
+
+	return nil
+}
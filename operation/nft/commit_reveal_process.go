@@ -0,0 +1,165 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+var commitRevealProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CommitRevealProcessor)
+	},
+}
+
+func (CommitReveal) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type CommitRevealProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewCommitRevealProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new CommitRevealProcessor")
+
+		nopp := commitRevealProcessorPool.Get()
+		opp, ok := nopp.(*CommitRevealProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected CommitRevealProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *CommitRevealProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(CommitRevealFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", CommitRevealFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	params, rErr := currentParams(getStateFunc)
+	if rErr != nil {
+		return ctx, rErr, nil
+	}
+
+	if err := params.CheckURIs(fact.PreRevealURI()); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	st, err := cstate.ExistsState(state.NFTStateKey(fact.Contract(), state.CollectionKey), "design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service state for contract account %v", fact.Contract())), nil
+	}
+
+	design, err := state.EffectiveCollectionValue(st, opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service state value for contract account %v", fact.Contract())), nil
+	}
+
+	if !design.Active() {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service in contract account %v has already been deactivated ", fact.Contract())), nil
+	}
+
+	if err := checkCollectionOwner(*design, fact.Sender(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("%v", err)), nil
+	}
+
+	if _, found, err := getStateFunc(state.NFTStateKey(fact.Contract(), state.RevealKey)); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+				Errorf("reveal state for contract account %v: %v", fact.Contract(), err)), nil
+	} else if found {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("reveal commitment of nft service in contract account %v already exists", fact.Contract())), nil
+	}
+
+	if design.Count() > fact.Supply() {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValOOR).
+				Errorf("reveal supply %v is less than minted nfts %v in contract account %v",
+					fact.Supply(), design.Count(), fact.Contract())), nil
+	}
+
+	if err := checkURIRule(params, designURIRule(*design), fact.PreRevealURI()); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("pre-reveal uri for contract account %v: %v", fact.Contract(), err)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *CommitRevealProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, _ := op.Fact().(CommitRevealFact)
+
+	reveal := types.NewReveal(fact.PreRevealURI(), fact.Commitment(), fact.Supply(), "", 0, false)
+	if err := reveal.IsValid(nil); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid reveal, %v: %w", fact.Contract(), err), nil
+	}
+
+	return []base.StateMergeValue{
+		cstate.NewStateMergeValue(
+			state.NFTStateKey(fact.Contract(), state.RevealKey), state.NewRevealStateValue(reveal)),
+	}, nil, nil
+}
+
+func (opp *CommitRevealProcessor) Close() error {
+	commitRevealProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+// loadAdminPolicy loads the active collection of the contract and checks the
+// sender is one of its admins.
+func loadAdminPolicy(
+	contract, sender base.Address, height base.Height, getStateFunc base.GetStateFunc,
+) (*types.Design, types.CollectionPolicy, base.OperationProcessReasonError) {
+	st, err := cstate.ExistsState(state.NFTStateKey(contract, state.CollectionKey), "design", getStateFunc)
+	if err != nil {
+		return nil, types.CollectionPolicy{}, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service state for contract account %v", contract))
+	}
+
+	design, err := state.EffectiveCollectionValue(st, height, getStateFunc)
+	if err != nil {
+		return nil, types.CollectionPolicy{}, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service state value for contract account %v", contract))
+	}
+
+	if !design.Active() {
+		return nil, types.CollectionPolicy{}, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service in contract account %v has already been deactivated ", contract))
+	}
+
+	policy, ok := design.Policy().(types.CollectionPolicy)
+	if !ok {
+		return nil, types.CollectionPolicy{}, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", types.CollectionPolicy{}, design.Policy()))
+	}
+
+	if !policy.AdminRule().IsAdmin(sender) {
+		return nil, types.CollectionPolicy{}, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("sender %v is not admin of collection in contract account %v", sender, contract))
+	}
+
+	return design, policy, nil
+}
+
+// pendingProposals loads the config proposals of the contract not expired at
+// height.
+func pendingProposals(
+	contract base.Address, height base.Height, getStateFunc base.GetStateFunc,
+) ([]types.ConfigProposal, error) {
+	st, found, err := getStateFunc(state.StateKeyProposals(contract))
+	switch {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, nil
+	}
+
+	proposals, err := state.StateProposalsValue(st)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.PendingConfigProposals(proposals, height), nil
+}
+
+func findProposal(proposals []types.ConfigProposal, id uint64) int {
+	for i, p := range proposals {
+		if p.ID() == id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// applyProposal returns the states of the collection with the policy of the
+// approved proposal and of the remaining proposals. With the min update delay
+// of the current policy, the policy is scheduled to take effect after the
+// delay instead.
+func applyProposal(
+	design types.Design,
+	current types.CollectionPolicy,
+	p types.ConfigProposal,
+	proposals []types.ConfigProposal,
+	height base.Height,
+	getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	policy := p.Policy().KeepFixed(current)
+
+	sts := []base.StateMergeValue{
+		cstate.NewStateMergeValue(
+			state.StateKeyProposals(design.Contract()), state.NewProposalsStateValue(proposals)),
+	}
+
+	if delay := current.MinUpdateDelay(); delay > 0 {
+		return append(sts,
+			cstate.NewStateMergeValue(
+				state.NFTStateKey(design.Contract(), state.CollectionKey), state.NewCollectionStateValue(design)),
+			cstate.NewStateMergeValue(
+				state.StateKeyPendingPolicy(design.Contract()),
+				state.NewPendingPolicyStateValue(policy, height+base.Height(delay))),
+		), nil
+	}
+
+	de := types.NewDesign(
+		design.Contract(), design.Creator(), design.Active(), design.Paused(), design.Count(), policy,
+	)
+	sts = append(sts, cstate.NewStateMergeValue(
+		state.NFTStateKey(design.Contract(), state.CollectionKey), state.NewCollectionStateValue(de)))
+
+	smv, err := clearPendingPolicy(design.Contract(), getStateFunc)
+	if err != nil {
+		return nil, err
+	} else if smv != nil {
+		sts = append(sts, smv)
+	}
+
+	return sts, nil
+}
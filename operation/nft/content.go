@@ -0,0 +1,111 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+// checkContentWriter checks the collection of the contract is active and the
+// sender is the collection owner or a whitelisted minter.
+func checkContentWriter(
+	contract, sender base.Address, height base.Height, getStateFunc base.GetStateFunc,
+) base.OperationProcessReasonError {
+	st, err := cstate.ExistsState(state.NFTStateKey(contract, state.CollectionKey), "design", getStateFunc)
+	if err != nil {
+		return base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service state for contract account %v", contract))
+	}
+
+	design, err := state.EffectiveCollectionValue(st, height, getStateFunc)
+	if err != nil {
+		return base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service state value for contract account %v", contract))
+	}
+
+	if !design.Active() {
+		return base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service in contract account %v has already been deactivated ", contract))
+	}
+
+	policy, ok := design.Policy().(types.CollectionPolicy)
+	if !ok {
+		return base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", types.CollectionPolicy{}, design.Policy()))
+	}
+
+	switch ok, err := isCollectionOwner(contract, sender, getStateFunc); {
+	case err != nil:
+		return base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).Errorf("%v", err))
+	case ok:
+		return nil
+	}
+
+	for _, a := range policy.Whitelist() {
+		if a.Equal(sender) {
+			return nil
+		}
+	}
+
+	return base.NewBaseOperationProcessReasonError("%s",
+		common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+			Errorf("sender %v is neither the collection owner nor in the minter whitelist of contract account %v",
+				sender, contract))
+}
+
+// loadContentChunks returns the chunks 0 to chunks-1 of the on-chain content.
+func loadContentChunks(
+	contract base.Address, id, chunks uint64, getStateFunc base.GetStateFunc,
+) ([][]byte, error) {
+	bs := make([][]byte, chunks)
+
+	for i := uint64(0); i < chunks; i++ {
+		st, err := cstate.ExistsState(state.StateKeyContentChunk(contract, id, i), "content chunk", getStateFunc)
+		if err != nil {
+			return nil, errors.Errorf("chunk %d of content %d not found", i, id)
+		}
+
+		b, err := state.StateContentChunkValue(st)
+		if err != nil {
+			return nil, err
+		}
+
+		bs[i] = b
+	}
+
+	return bs, nil
+}
+
+// checkOnChainURIs checks the on-chain uris refer to the finalized content of
+// the contract. Other uris are ignored.
+func checkOnChainURIs(contract base.Address, getStateFunc base.GetStateFunc, uris ...types.URI) error {
+	for _, uri := range uris {
+		if !types.IsOnChainURI(uri) {
+			continue
+		}
+
+		c, id, err := types.ParseOnChainURI(uri)
+		if err != nil {
+			return err
+		}
+
+		if c != contract.String() {
+			return errors.Errorf("on-chain uri of other contract account, %v", uri)
+		}
+
+		if _, err := cstate.ExistsState(state.StateKeyContent(contract, id), "content", getStateFunc); err != nil {
+			return errors.Errorf("content of on-chain uri not finalized, %v", uri)
+		}
+	}
+
+	return nil
+}
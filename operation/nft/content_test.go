@@ -0,0 +1,121 @@
+package nft
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+func TestStoreAndFinalizeContent(t *testing.T) {
+	g := newTestStateGetter()
+
+	sender, priv := g.newAccount(t)
+	other, otherPriv := g.newAccount(t)
+	contract := g.newCollection(t, sender, newTestCollectionPolicy())
+
+	chunks := [][]byte{[]byte("on-chain "), []byte("content")}
+
+	store := func(priv base.Privatekey, sender base.Address, index uint64) ([]base.StateMergeValue, error) {
+		op, err := NewStoreContent(NewStoreContentFact(
+			[]byte("token"), sender, contract, 1, index, chunks[index], "MCC"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := op.Sign(priv, testNetworkID); err != nil {
+			t.Fatal(err)
+		}
+
+		return processTestOperation(t, NewStoreContentProcessor(), op, g.GetStateFunc)
+	}
+
+	finalize := func(n uint64, h util.Hash) ([]base.StateMergeValue, error) {
+		op, err := NewFinalizeContent(NewFinalizeContentFact(
+			[]byte("token"), sender, contract, 1, n, h, "text/plain", "MCC"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := op.Sign(priv, testNetworkID); err != nil {
+			t.Fatal(err)
+		}
+
+		return processTestOperation(t, NewFinalizeContentProcessor(), op, g.GetStateFunc)
+	}
+
+	mint := func() error {
+		op, err := NewMint(NewMintFact([]byte("token"), sender, []MintItem{NewMintItem(
+			contract, sender, "hash", types.OnChainURI(contract, 1), types.NewSigners(nil), nil, 0, 0, nil, "MCC",
+		)}))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := op.Sign(priv, testNetworkID); err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = processTestOperation(t, NewMintProcessor(), op, g.GetStateFunc)
+
+		return err
+	}
+
+	expectErr := func(err error, expected string) {
+		t.Helper()
+
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Fatalf("expected %q, not %v", expected, err)
+		}
+	}
+
+	_, err := store(otherPriv, other, 0)
+	expectErr(err, "neither the collection owner nor in the minter whitelist")
+
+	for i := range chunks {
+		sts, err := store(priv, sender, uint64(i))
+		if err != nil {
+			t.Fatalf("store chunk %d: %v", i, err)
+		}
+
+		g.apply(sts)
+	}
+
+	expectErr(mint(), "content of on-chain uri not finalized")
+
+	digest := types.ContentDigest(chunks...)
+
+	_, err = finalize(3, types.ContentDigest(append(chunks, []byte("more"))...))
+	expectErr(err, "chunk 2 of content 1 not found")
+
+	_, err = finalize(2, types.ContentDigest(chunks[0]))
+	expectErr(err, "content hash not matched")
+
+	sts, err := finalize(2, digest)
+	if err != nil {
+		t.Fatalf("finalize: %v", err)
+	}
+
+	v, ok := sts[0].Value().(state.ContentStateValue)
+	switch {
+	case !ok:
+		t.Fatalf("expected %T, not %T", state.ContentStateValue{}, sts[0].Value())
+	case v.Content.Size() != uint64(len(chunks[0])+len(chunks[1])), !v.Content.Hash().Equal(digest):
+		t.Fatalf("wrong content info, %d %v", v.Content.Size(), v.Content.Hash())
+	}
+
+	g.apply(sts)
+
+	if err := mint(); err != nil {
+		t.Fatalf("mint nft of finalized content: %v", err)
+	}
+
+	_, err = store(priv, sender, 0)
+	expectErr(err, "already finalized")
+
+	_, err = finalize(2, digest)
+	expectErr(err, "already finalized")
+}
@@ -0,0 +1,227 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/imfact-labs/nft-model/operation/processor"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	CreateSeriesFactHint = hint.MustNewHint("mitum-nft-create-series-operation-fact-v0.0.1")
+	CreateSeriesHint     = hint.MustNewHint("mitum-nft-create-series-operation-v0.0.1")
+)
+
+type CreateSeriesFact struct {
+	base.BaseFact
+	sender    base.Address
+	contract  base.Address
+	seriesID  uint64
+	name      types.CollectionName
+	uri       types.URI
+	maxSupply uint64
+	royalty   types.PaymentParameter
+	minters   []base.Address
+	currency  ctypes.CurrencyID
+}
+
+func NewCreateSeriesFact(
+	token []byte,
+	sender, contract base.Address,
+	seriesID uint64,
+	name types.CollectionName,
+	uri types.URI,
+	maxSupply uint64,
+	royalty types.PaymentParameter,
+	minters []base.Address,
+	currency ctypes.CurrencyID,
+) CreateSeriesFact {
+	bf := base.NewBaseFact(CreateSeriesFactHint, token)
+
+	fact := CreateSeriesFact{
+		BaseFact:  bf,
+		sender:    sender,
+		contract:  contract,
+		seriesID:  seriesID,
+		name:      name,
+		uri:       uri,
+		maxSupply: maxSupply,
+		royalty:   royalty,
+		minters:   minters,
+		currency:  currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CreateSeriesFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if err := fact.Series().IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	for _, minter := range fact.minters {
+		if minter.Equal(fact.contract) {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("series minter %v is same with contract account", minter)))
+		}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact CreateSeriesFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact CreateSeriesFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CreateSeriesFact) Bytes() []byte {
+	ms := make([][]byte, len(fact.minters))
+	for i, minter := range fact.minters {
+		ms[i] = minter.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		util.Uint64ToBytes(fact.seriesID),
+		fact.name.Bytes(),
+		fact.uri.Bytes(),
+		util.Uint64ToBytes(fact.maxSupply),
+		fact.royalty.Bytes(),
+		util.ConcatBytesSlice(ms...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact CreateSeriesFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact CreateSeriesFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CreateSeriesFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact CreateSeriesFact) SeriesID() uint64 {
+	return fact.seriesID
+}
+
+func (fact CreateSeriesFact) Name() types.CollectionName {
+	return fact.name
+}
+
+func (fact CreateSeriesFact) URI() types.URI {
+	return fact.uri
+}
+
+func (fact CreateSeriesFact) MaxSupply() uint64 {
+	return fact.maxSupply
+}
+
+func (fact CreateSeriesFact) Royalty() types.PaymentParameter {
+	return fact.royalty
+}
+
+func (fact CreateSeriesFact) Minters() []base.Address {
+	return fact.minters
+}
+
+// Series returns the series created by the fact, with no nft minted.
+func (fact CreateSeriesFact) Series() types.Series {
+	return types.NewSeries(fact.seriesID, fact.name, fact.uri, fact.maxSupply, fact.royalty, fact.minters, 0)
+}
+
+func (fact CreateSeriesFact) Currency() ctypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact CreateSeriesFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.minters)+1)
+	as[0] = fact.sender
+	copy(as[1:], fact.minters)
+
+	return as, nil
+}
+
+func (fact CreateSeriesFact) FeeBase() map[ctypes.CurrencyID][]common.Big {
+	required := make(map[ctypes.CurrencyID][]common.Big)
+	required[fact.Currency()] = []common.Big{common.ZeroBig}
+
+	return required
+}
+
+func (fact CreateSeriesFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact CreateSeriesFact) FeeItemCount() (uint, bool) {
+	return extras.ZeroItem, extras.HasNoItem
+}
+
+func (fact CreateSeriesFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact CreateSeriesFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact CreateSeriesFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact CreateSeriesFact) DupKey() (map[ctypes.DuplicationKeyType][]string, error) {
+	r := make(map[ctypes.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fact.sender.String()}
+	r[processor.DuplicationTypeSeries] = []string{processor.DuplicationKeySeries(fact.contract, fact.seriesID)}
+
+	return r, nil
+}
+
+// CreateSeries adds a series to the collection of the contract account. The
+// series id is chosen by the owner and can not be reused.
+type CreateSeries struct {
+	extras.ExtendedOperation
+}
+
+func NewCreateSeries(fact CreateSeriesFact) (CreateSeries, error) {
+	return CreateSeries{
+		ExtendedOperation: extras.NewExtendedOperation(CreateSeriesHint, fact),
+	}, nil
+}
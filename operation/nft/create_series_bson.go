@@ -0,0 +1,98 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func (fact CreateSeriesFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":      fact.Hint().String(),
+			"hash":       fact.BaseFact.Hash().String(),
+			"token":      fact.BaseFact.Token(),
+			"sender":     fact.sender,
+			"contract":   fact.contract,
+			"series_id":  fact.seriesID,
+			"name":       fact.name,
+			"uri":        fact.uri,
+			"max_supply": fact.maxSupply,
+			"royalty":    fact.royalty,
+			"minters":    fact.minters,
+			"currency":   fact.currency,
+		})
+}
+
+type CreateSeriesFactBSONUnmarshaler struct {
+	Hint      string   `bson:"_hint"`
+	Sender    string   `bson:"sender"`
+	Contract  string   `bson:"contract"`
+	SeriesID  uint64   `bson:"series_id"`
+	Name      string   `bson:"name"`
+	Uri       string   `bson:"uri"`
+	MaxSupply uint64   `bson:"max_supply"`
+	Royalty   uint     `bson:"royalty"`
+	Minters   []string `bson:"minters"`
+	Currency  string   `bson:"currency"`
+}
+
+func (fact *CreateSeriesFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	var u common.BaseFactBSONUnmarshaler
+
+	err := enc.Unmarshal(b, &u)
+	if err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *fact)
+	}
+
+	fact.BaseFact.SetHash(valuehash.NewBytesFromString(u.Hash))
+	fact.BaseFact.SetToken(u.Token)
+
+	var uf CreateSeriesFactBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *fact)
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *fact)
+	}
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+
+	if err := fact.unpack(enc, uf.Sender, uf.Contract, uf.SeriesID, uf.Name, uf.Uri, uf.MaxSupply, uf.Royalty, uf.Minters, uf.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *fact)
+	}
+
+	return nil
+}
+
+func (op CreateSeries) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": op.Hint().String(),
+			"hash":  op.Hash().String(),
+			"fact":  op.Fact(),
+			"signs": op.Signs(),
+		})
+}
+
+func (op *CreateSeries) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeBSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	var ueo extras.BaseOperationExtensions
+	if err := ueo.DecodeBSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *op)
+	}
+
+	op.BaseOperationExtensions = &ueo
+
+	return nil
+}
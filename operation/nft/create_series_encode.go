@@ -0,0 +1,50 @@
+package nft
+
+import (
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+func (fact *CreateSeriesFact) unpack(
+	enc encoder.Encoder,
+	sd, ct string,
+	id uint64,
+	nm, uri string,
+	supply uint64,
+	ry uint,
+	bms []string,
+	cid string,
+) error {
+	fact.currency = ctypes.CurrencyID(cid)
+	fact.seriesID = id
+	fact.name = types.CollectionName(nm)
+	fact.uri = types.URI(uri)
+	fact.maxSupply = supply
+	fact.royalty = types.PaymentParameter(ry)
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	contract, err := base.DecodeAddress(ct, enc)
+	if err != nil {
+		return err
+	}
+	fact.contract = contract
+
+	minters := make([]base.Address, len(bms))
+	for i, bm := range bms {
+		minter, err := base.DecodeAddress(bm, enc)
+		if err != nil {
+			return err
+		}
+		minters[i] = minter
+	}
+	fact.minters = minters
+
+	return nil
+}
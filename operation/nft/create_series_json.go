@@ -0,0 +1,92 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+type CreateSeriesFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender    base.Address           `json:"sender"`
+	Contract  base.Address           `json:"contract"`
+	SeriesID  uint64                 `json:"series_id"`
+	Name      types.CollectionName   `json:"name"`
+	Uri       types.URI              `json:"uri"`
+	MaxSupply uint64                 `json:"max_supply"`
+	Royalty   types.PaymentParameter `json:"royalty"`
+	Minters   []base.Address         `json:"minters"`
+	Currency  ctypes.CurrencyID      `json:"currency"`
+}
+
+func (fact CreateSeriesFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CreateSeriesFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		SeriesID:              fact.seriesID,
+		Name:                  fact.name,
+		Uri:                   fact.uri,
+		MaxSupply:             fact.maxSupply,
+		Royalty:               fact.royalty,
+		Minters:               fact.minters,
+		Currency:              fact.currency,
+	})
+}
+
+type CreateSeriesFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender    string   `json:"sender"`
+	Contract  string   `json:"contract"`
+	SeriesID  uint64   `json:"series_id"`
+	Name      string   `json:"name"`
+	Uri       string   `json:"uri"`
+	MaxSupply uint64   `json:"max_supply"`
+	Royalty   uint     `json:"royalty"`
+	Minters   []string `json:"minters"`
+	Currency  string   `json:"currency"`
+}
+
+func (fact *CreateSeriesFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u CreateSeriesFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.SeriesID, u.Name, u.Uri, u.MaxSupply, u.Royalty, u.Minters, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op CreateSeries) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationMarshaler{
+		BaseOperationJSONMarshaler:           op.BaseOperation.JSONMarshaler(),
+		BaseOperationExtensionsJSONMarshaler: op.BaseOperationExtensions.JSONMarshaler(),
+	})
+}
+
+func (op *CreateSeries) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	var ueo extras.BaseOperationExtensions
+	if err := ueo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperationExtensions = &ueo
+
+	return nil
+}
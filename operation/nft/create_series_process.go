@@ -0,0 +1,179 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/pkg/errors"
+)
+
+var createSeriesProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CreateSeriesProcessor)
+	},
+}
+
+func (CreateSeries) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type CreateSeriesProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewCreateSeriesProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new CreateSeriesProcessor")
+
+		nopp := createSeriesProcessorPool.Get()
+		opp, ok := nopp.(*CreateSeriesProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected CreateSeriesProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *CreateSeriesProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(CreateSeriesFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", CreateSeriesFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	params, rErr := currentParams(getStateFunc)
+	if rErr != nil {
+		return ctx, rErr, nil
+	}
+
+	if err := params.CheckWhitelist(len(fact.Minters())); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	if err := params.CheckURIs(fact.URI()); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	st, err := cstate.ExistsState(state.NFTStateKey(fact.Contract(), state.CollectionKey), "design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service state for contract account %v", fact.Contract())), nil
+	}
+
+	design, err := state.EffectiveCollectionValue(st, opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service state value for contract account %v", fact.Contract())), nil
+	}
+
+	if !design.Active() {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service in contract account %v has already been deactivated ", fact.Contract())), nil
+	}
+
+	if err := checkCollectionOwner(*design, fact.Sender(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("%v", err)), nil
+	}
+
+	if err := checkURIRule(params, designURIRule(*design), fact.URI()); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("series uri for contract account %v: %v", fact.Contract(), err)), nil
+	}
+
+	if found, _ := cstate.CheckNotExistsState(
+		state.StateKeySeries(fact.Contract(), fact.SeriesID()), getStateFunc); found {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMStateE).
+				Errorf("series %v already exists in contract account %v", fact.SeriesID(), fact.Contract())), nil
+	}
+
+	for _, minter := range fact.Minters() {
+		if _, _, _, cErr := cstate.ExistsCAccount(minter, "series minter", true, false, getStateFunc); cErr != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+					Errorf("%v: series minter %v is contract account", cErr, minter)), nil
+		}
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *CreateSeriesProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, _ := op.Fact().(CreateSeriesFact)
+
+	var sts []base.StateMergeValue
+
+	for _, minter := range fact.Minters() {
+		smv, err := cstate.CreateNotExistAccount(minter, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		} else if smv != nil {
+			sts = append(sts, smv)
+		}
+	}
+
+	series := fact.Series()
+	if err := series.IsValid(nil); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid series, %v: %w", fact.SeriesID(), err), nil
+	}
+
+	sts = append(sts, cstate.NewStateMergeValue(
+		state.StateKeySeries(fact.Contract(), fact.SeriesID()), state.NewSeriesStateValue(series)))
+
+	return sts, nil, nil
+}
+
+func (opp *CreateSeriesProcessor) Close() error {
+	createSeriesProcessorPool.Put(opp)
+
+	return nil
+}
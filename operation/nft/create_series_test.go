@@ -0,0 +1,124 @@
+package nft
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+func TestCreateSeries(t *testing.T) {
+	policy := newTestCollectionPolicy().WithURIRule(types.NewURIRule([]string{"https"}, nil))
+
+	cases := []struct {
+		name     string
+		owner    bool
+		exists   bool
+		uri      types.URI
+		expected string
+	}{
+		{"create", true, false, "https://example.com/series", ""},
+		{"not owner", false, false, "https://example.com/series", "not the collection owner"},
+		{"exists", true, true, "https://example.com/series", "already exists"},
+		{"uri out of collection rule", true, false, "ipfs://series", "not allowed"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			g := newTestStateGetter()
+
+			owner, ownerPriv := g.newAccount(t)
+			other, otherPriv := g.newAccount(t)
+			contract := g.newCollection(t, owner, policy)
+
+			if c.exists {
+				g.set(state.StateKeySeries(contract, 1),
+					state.NewSeriesStateValue(types.NewSeries(1, "series", "", 0, 0, nil, 0)))
+			}
+
+			sender, priv := owner, ownerPriv
+			if !c.owner {
+				sender, priv = other, otherPriv
+			}
+
+			op, err := NewCreateSeries(NewCreateSeriesFact(
+				[]byte("token"), sender, contract, 1, "series", c.uri, 10, 0, nil, "MCC"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := op.Sign(priv, testNetworkID); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = processTestOperation(t, NewCreateSeriesProcessor(), op, g.GetStateFunc)
+
+			switch {
+			case c.expected == "" && err != nil:
+				t.Fatalf("create series: %v", err)
+			case c.expected != "" && (err == nil || !strings.Contains(err.Error(), c.expected)):
+				t.Fatalf("expected %q, not %v", c.expected, err)
+			}
+		})
+	}
+}
+
+func TestMintSeries(t *testing.T) {
+	g := newTestStateGetter()
+
+	owner, _ := g.newAccount(t)
+	minter, minterPriv := g.newAccount(t)
+	other, otherPriv := g.newAccount(t)
+	contract := g.newCollection(t, owner, types.NewCollectionPolicy("collection", 0, "", []base.Address{owner}))
+
+	g.set(state.StateKeySeries(contract, 1),
+		state.NewSeriesStateValue(types.NewSeries(1, "series", "", 1, 0, []base.Address{minter}, 0)))
+
+	mint := func(sender base.Address, priv base.Privatekey, series uint64) ([]base.StateMergeValue, error) {
+		op, err := NewMint(NewMintFact([]byte("token"), sender, []MintItem{NewMintItem(
+			contract, sender, "hash", "https://example.com/1", types.NewSigners(nil), nil, series, 0, nil, "MCC",
+		)}))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := op.Sign(priv, testNetworkID); err != nil {
+			t.Fatal(err)
+		}
+
+		return processTestOperation(t, NewMintProcessor(), op, g.GetStateFunc)
+	}
+
+	expectErr := func(err error, expected string) {
+		t.Helper()
+
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Fatalf("expected %q, not %v", expected, err)
+		}
+	}
+
+	_, err := mint(other, otherPriv, 1)
+	expectErr(err, "neither the owner nor a minter of series")
+
+	_, err = mint(minter, minterPriv, 2)
+	expectErr(err, "series 2 in contract account")
+
+	_, err = mint(minter, minterPriv, 0)
+	expectErr(err, "neither the owner nor in the minter whitelist")
+
+	sts, err := mint(minter, minterPriv, 1)
+	if err != nil {
+		t.Fatalf("mint in series by series minter: %v", err)
+	}
+
+	if n := mintedNFT(t, sts); n.Series() != 1 {
+		t.Fatalf("expected nft in series 1, not %v", n.Series())
+	}
+
+	g.apply(sts)
+
+	_, err = mint(minter, minterPriv, 1)
+	expectErr(err, "reached max supply")
+}
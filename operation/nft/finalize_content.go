@@ -0,0 +1,198 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/imfact-labs/nft-model/operation/processor"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	FinalizeContentFactHint = hint.MustNewHint("mitum-nft-finalize-content-operation-fact-v0.0.1")
+	FinalizeContentHint     = hint.MustNewHint("mitum-nft-finalize-content-operation-v0.0.1")
+)
+
+type FinalizeContentFact struct {
+	base.BaseFact
+	sender      base.Address
+	contract    base.Address
+	contentID   uint64
+	chunks      uint64
+	hash        util.Hash
+	contentType string
+	currency    ctypes.CurrencyID
+}
+
+func NewFinalizeContentFact(
+	token []byte,
+	sender, contract base.Address,
+	contentID, chunks uint64,
+	hash util.Hash,
+	contentType string,
+	currency ctypes.CurrencyID,
+) FinalizeContentFact {
+	bf := base.NewBaseFact(FinalizeContentFactHint, token)
+
+	fact := FinalizeContentFact{
+		BaseFact:    bf,
+		sender:      sender,
+		contract:    contract,
+		contentID:   contentID,
+		chunks:      chunks,
+		hash:        hash,
+		contentType: contentType,
+		currency:    currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact FinalizeContentFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.hash,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if fact.chunks < 1 || fact.chunks > types.MaxContentChunks {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(
+			errors.Errorf("content chunks out of range, %d, 1 <= chunks <= %d", fact.chunks, types.MaxContentChunks)))
+	}
+
+	if err := types.IsValidContentType(fact.contentType); err != nil {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(err))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact FinalizeContentFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact FinalizeContentFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact FinalizeContentFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		util.Uint64ToBytes(fact.contentID),
+		util.Uint64ToBytes(fact.chunks),
+		fact.hash.Bytes(),
+		[]byte(fact.contentType),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact FinalizeContentFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact FinalizeContentFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact FinalizeContentFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact FinalizeContentFact) ContentID() uint64 {
+	return fact.contentID
+}
+
+func (fact FinalizeContentFact) Chunks() uint64 {
+	return fact.chunks
+}
+
+func (fact FinalizeContentFact) ContentHash() util.Hash {
+	return fact.hash
+}
+
+func (fact FinalizeContentFact) ContentType() string {
+	return fact.contentType
+}
+
+func (fact FinalizeContentFact) Currency() ctypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact FinalizeContentFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+func (fact FinalizeContentFact) FeeBase() map[ctypes.CurrencyID][]common.Big {
+	required := make(map[ctypes.CurrencyID][]common.Big)
+	required[fact.Currency()] = []common.Big{common.ZeroBig}
+
+	return required
+}
+
+func (fact FinalizeContentFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact FinalizeContentFact) FeeItemCount() (uint, bool) {
+	return extras.ZeroItem, extras.HasNoItem
+}
+
+func (fact FinalizeContentFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact FinalizeContentFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact FinalizeContentFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact FinalizeContentFact) DupKey() (map[ctypes.DuplicationKeyType][]string, error) {
+	r := make(map[ctypes.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fact.sender.String()}
+	r[processor.DuplicationTypeContent] = []string{processor.DuplicationKeyContent(fact.contract, fact.contentID)}
+
+	return r, nil
+}
+
+// FinalizeContent records the chunk count, hash and content type of an
+// on-chain content. The chunks must be stored and match the hash; after that
+// the content is immutable and can be referred by onchain://<contract>/<id>.
+type FinalizeContent struct {
+	extras.ExtendedOperation
+}
+
+func NewFinalizeContent(fact FinalizeContentFact) (FinalizeContent, error) {
+	return FinalizeContent{
+		ExtendedOperation: extras.NewExtendedOperation(FinalizeContentHint, fact),
+	}, nil
+}
@@ -0,0 +1,35 @@
+package nft
+
+import (
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+)
+
+func (fact *FinalizeContentFact) unpack(
+	enc encoder.Encoder,
+	sd, ct string,
+	id, chunks uint64,
+	hs, mt, cid string,
+) error {
+	fact.currency = ctypes.CurrencyID(cid)
+	fact.contentID = id
+	fact.chunks = chunks
+	fact.hash = valuehash.NewBytesFromString(hs)
+	fact.contentType = mt
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	contract, err := base.DecodeAddress(ct, enc)
+	if err != nil {
+		return err
+	}
+	fact.contract = contract
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type FinalizeContentFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender      base.Address      `json:"sender"`
+	Contract    base.Address      `json:"contract"`
+	ContentID   uint64            `json:"content_id"`
+	Chunks      uint64            `json:"chunks"`
+	Hash        util.Hash         `json:"content_hash"`
+	ContentType string            `json:"content_type"`
+	Currency    ctypes.CurrencyID `json:"currency"`
+}
+
+func (fact FinalizeContentFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(FinalizeContentFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		ContentID:             fact.contentID,
+		Chunks:                fact.chunks,
+		Hash:                  fact.hash,
+		ContentType:           fact.contentType,
+		Currency:              fact.currency,
+	})
+}
+
+type FinalizeContentFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender      string `json:"sender"`
+	Contract    string `json:"contract"`
+	ContentID   uint64 `json:"content_id"`
+	Chunks      uint64 `json:"chunks"`
+	Hash        string `json:"content_hash"`
+	ContentType string `json:"content_type"`
+	Currency    string `json:"currency"`
+}
+
+func (fact *FinalizeContentFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u FinalizeContentFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.ContentID, u.Chunks, u.Hash, u.ContentType, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op FinalizeContent) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationMarshaler{
+		BaseOperationJSONMarshaler:           op.BaseOperation.JSONMarshaler(),
+		BaseOperationExtensionsJSONMarshaler: op.BaseOperationExtensions.JSONMarshaler(),
+	})
+}
+
+func (op *FinalizeContent) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	var ueo extras.BaseOperationExtensions
+	if err := ueo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperationExtensions = &ueo
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+var finalizeContentProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(FinalizeContentProcessor)
+	},
+}
+
+func (FinalizeContent) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type FinalizeContentProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewFinalizeContentProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new FinalizeContentProcessor")
+
+		nopp := finalizeContentProcessorPool.Get()
+		opp, ok := nopp.(*FinalizeContentProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected FinalizeContentProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *FinalizeContentProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(FinalizeContentFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", FinalizeContentFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := checkContentWriter(fact.Contract(), fact.Sender(), opp.Height(), getStateFunc); err != nil {
+		return ctx, err, nil
+	}
+
+	switch _, found, err := getStateFunc(state.StateKeyContent(fact.Contract(), fact.ContentID())); {
+	case err != nil:
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	case found:
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("content %v of contract account %v already finalized", fact.ContentID(), fact.Contract())), nil
+	}
+
+	chunks, err := loadContentChunks(fact.Contract(), fact.ContentID(), fact.Chunks(), getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+				Errorf("%v", err)), nil
+	}
+
+	if h := types.ContentDigest(chunks...); !h.Equal(fact.ContentHash()) {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("content hash not matched, %v != %v", fact.ContentHash(), h)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *FinalizeContentProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, _ := op.Fact().(FinalizeContentFact)
+
+	switch _, found, err := getStateFunc(state.StateKeyContent(fact.Contract(), fact.ContentID())); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to get content, %v: %w", fact.ContentID(), err), nil
+	case found:
+		return nil, base.NewBaseOperationProcessReasonError("content already finalized, %v", fact.ContentID()), nil
+	}
+
+	chunks, err := loadContentChunks(fact.Contract(), fact.ContentID(), fact.Chunks(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to load content, %v: %w", fact.ContentID(), err), nil
+	}
+
+	if h := types.ContentDigest(chunks...); !h.Equal(fact.ContentHash()) {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"content hash not matched, %v != %v", fact.ContentHash(), h), nil
+	}
+
+	var size uint64
+	for _, b := range chunks {
+		size += uint64(len(b))
+	}
+
+	info := types.NewContentInfo(fact.Chunks(), size, fact.ContentHash(), fact.ContentType())
+	if err := info.IsValid(nil); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid content info, %v: %w", fact.ContentID(), err), nil
+	}
+
+	return []base.StateMergeValue{
+		cstate.NewStateMergeValue(
+			state.StateKeyContent(fact.Contract(), fact.ContentID()),
+			state.NewContentStateValue(info),
+		),
+	}, nil, nil
+}
+
+func (opp *FinalizeContentProcessor) Close() error {
+	finalizeContentProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,214 @@
+package nft
+
+import (
+	"strings"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/imfact-labs/nft-model/operation/processor"
+	"github.com/pkg/errors"
+)
+
+var (
+	ForceTransferFactHint = hint.MustNewHint("mitum-nft-force-transfer-operation-fact-v0.0.1")
+	ForceTransferHint     = hint.MustNewHint("mitum-nft-force-transfer-operation-v0.0.1")
+)
+
+var MaxForceTransferReasonLength = 300
+
+type ForceTransferReason string
+
+func (r ForceTransferReason) IsValid([]byte) error {
+	if strings.TrimSpace(string(r)) == "" {
+		return common.ErrValueInvalid.Wrap(errors.Errorf("empty force transfer reason"))
+	}
+
+	if l := len(r); l > MaxForceTransferReasonLength {
+		return common.ErrValOOR.Wrap(
+			errors.Errorf("force transfer reason length over max, %d > %d", l, MaxForceTransferReasonLength))
+	}
+
+	return nil
+}
+
+func (r ForceTransferReason) Bytes() []byte {
+	return []byte(r)
+}
+
+func (r ForceTransferReason) String() string {
+	return string(r)
+}
+
+type ForceTransferFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	nftIdx   uint64
+	receiver base.Address
+	reason   ForceTransferReason
+	currency ctypes.CurrencyID
+}
+
+func NewForceTransferFact(
+	token []byte,
+	sender, contract base.Address,
+	nftIdx uint64,
+	receiver base.Address,
+	reason ForceTransferReason,
+	currency ctypes.CurrencyID,
+) ForceTransferFact {
+	bf := base.NewBaseFact(ForceTransferFactHint, token)
+
+	fact := ForceTransferFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		nftIdx:   nftIdx,
+		receiver: receiver,
+		reason:   reason,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ForceTransferFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.receiver,
+		fact.reason,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if fact.receiver.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("receiver %v is same with contract", fact.receiver)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact ForceTransferFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ForceTransferFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ForceTransferFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		util.Uint64ToBytes(fact.nftIdx),
+		fact.receiver.Bytes(),
+		fact.reason.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact ForceTransferFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ForceTransferFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ForceTransferFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact ForceTransferFact) NFT() uint64 {
+	return fact.nftIdx
+}
+
+func (fact ForceTransferFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact ForceTransferFact) Reason() ForceTransferReason {
+	return fact.reason
+}
+
+func (fact ForceTransferFact) Currency() ctypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact ForceTransferFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.receiver
+	return as, nil
+}
+
+func (fact ForceTransferFact) FeeBase() map[ctypes.CurrencyID][]common.Big {
+	required := make(map[ctypes.CurrencyID][]common.Big)
+	required[fact.Currency()] = []common.Big{common.ZeroBig}
+
+	return required
+}
+
+func (fact ForceTransferFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact ForceTransferFact) FeeItemCount() (uint, bool) {
+	return extras.ZeroItem, extras.HasNoItem
+}
+
+func (fact ForceTransferFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact ForceTransferFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact ForceTransferFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact ForceTransferFact) DupKey() (map[ctypes.DuplicationKeyType][]string, error) {
+	r := make(map[ctypes.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fact.sender.String()}
+	r[processor.DuplicationTypeContractNFT] = []string{processor.DuplicationKeyNFT(fact.contract, fact.nftIdx)}
+
+	return r, nil
+}
+
+// ForceTransfer moves a nft to the receiver without the consent of its owner.
+// It is allowed only for collections registered with clawback enabled.
+type ForceTransfer struct {
+	extras.ExtendedOperation
+}
+
+func NewForceTransfer(fact ForceTransferFact) (ForceTransfer, error) {
+	return ForceTransfer{
+		ExtendedOperation: extras.NewExtendedOperation(ForceTransferHint, fact),
+	}, nil
+}
@@ -0,0 +1,38 @@
+package nft
+
+import (
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+func (fact *ForceTransferFact) unpack(
+	enc encoder.Encoder,
+	sd, ct string,
+	nid uint64,
+	rc, rs, cid string,
+) error {
+	fact.currency = ctypes.CurrencyID(cid)
+	fact.nftIdx = nid
+	fact.reason = ForceTransferReason(rs)
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	contract, err := base.DecodeAddress(ct, enc)
+	if err != nil {
+		return err
+	}
+	fact.contract = contract
+
+	receiver, err := base.DecodeAddress(rc, enc)
+	if err != nil {
+		return err
+	}
+	fact.receiver = receiver
+
+	return nil
+}
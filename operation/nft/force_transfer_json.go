@@ -0,0 +1,82 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type ForceTransferFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address        `json:"sender"`
+	Contract base.Address        `json:"contract"`
+	NFTIdx   uint64              `json:"nft_idx"`
+	Receiver base.Address        `json:"receiver"`
+	Reason   ForceTransferReason `json:"reason"`
+	Currency ctypes.CurrencyID   `json:"currency"`
+}
+
+func (fact ForceTransferFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ForceTransferFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		NFTIdx:                fact.nftIdx,
+		Receiver:              fact.receiver,
+		Reason:                fact.reason,
+		Currency:              fact.currency,
+	})
+}
+
+type ForceTransferFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string `json:"sender"`
+	Contract string `json:"contract"`
+	NFTIdx   uint64 `json:"nft_idx"`
+	Receiver string `json:"receiver"`
+	Reason   string `json:"reason"`
+	Currency string `json:"currency"`
+}
+
+func (fact *ForceTransferFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u ForceTransferFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.NFTIdx, u.Receiver, u.Reason, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op ForceTransfer) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationMarshaler{
+		BaseOperationJSONMarshaler:           op.BaseOperation.JSONMarshaler(),
+		BaseOperationExtensionsJSONMarshaler: op.BaseOperationExtensions.JSONMarshaler(),
+	})
+}
+
+func (op *ForceTransfer) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	var ueo extras.BaseOperationExtensions
+	if err := ueo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperationExtensions = &ueo
+
+	return nil
+}
@@ -0,0 +1,209 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+var forceTransferProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ForceTransferProcessor)
+	},
+}
+
+func (ForceTransfer) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type ForceTransferProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewForceTransferProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new ForceTransferProcessor")
+
+		nopp := forceTransferProcessorPool.Get()
+		opp, ok := nopp.(*ForceTransferProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected ForceTransferProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ForceTransferProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(ForceTransferFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", ForceTransferFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if _, _, _, cErr := cstate.ExistsCAccount(
+		fact.Receiver(), "receiver", true, false, getStateFunc); cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v: receiver %v is contract account", cErr, fact.Receiver())), nil
+	}
+
+	st, err := cstate.ExistsState(state.NFTStateKey(fact.Contract(), state.CollectionKey), "design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service state for contract account %v", fact.Contract())), nil
+	}
+
+	design, err := state.EffectiveCollectionValue(st, opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service state value for contract account %v", fact.Contract())), nil
+	}
+
+	if !design.Active() {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service in contract account %v has already been deactivated ", fact.Contract())), nil
+	}
+
+	if err := checkCollectionOwner(*design, fact.Sender(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("%v", err)), nil
+	}
+
+	policy, ok := design.Policy().(types.CollectionPolicy)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", types.CollectionPolicy{}, design.Policy())), nil
+	}
+
+	if !policy.ClawbackEnabled() {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("clawback is not enabled for nft service in contract account %v", fact.Contract())), nil
+	}
+
+	st, err = cstate.ExistsState(state.StateKeyNFT(fact.Contract(), fact.NFT()), "nft", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+				Errorf("nft idx %v in contract account %v", fact.NFT(), fact.Contract())), nil
+	}
+
+	nv, err := state.StateNFTValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("nft idx %v in contract account %v", fact.NFT(), fact.Contract())), nil
+	}
+
+	if !nv.Active() {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("burned nft idx %v in contract account %v", fact.NFT(), fact.Contract())), nil
+	}
+
+	if fact.Receiver().Equal(nv.Owner()) {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("receiver %v is same with nft owner", fact.Receiver())), nil
+	}
+
+	if err := checkNotDenied(fact.Contract(), getStateFunc, fact.Receiver()); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ForceTransferProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, _ := op.Fact().(ForceTransferFact)
+
+	var sts []base.StateMergeValue
+
+	smv, err := cstate.CreateNotExistAccount(fact.Receiver(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	} else if smv != nil {
+		sts = append(sts, smv)
+	}
+
+	st, err := cstate.ExistsState(state.StateKeyNFT(fact.Contract(), fact.NFT()), "nft", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft not found, %v: %w", fact.NFT(), err), nil
+	}
+
+	nv, err := state.StateNFTValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft value not found, %v: %w", fact.NFT(), err), nil
+	}
+
+	n := types.NewNFT(fact.NFT(), nv.Active(), fact.Receiver(), nv.NFTHash(), nv.URI(), fact.Receiver(), nv.Creators()).
+		WithOptionalFields(*nv)
+	if err := n.IsValid(nil); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid nft, %v: %w", fact.NFT(), err), nil
+	}
+
+	sts = append(sts,
+		cstate.NewStateMergeValue(state.StateKeyNFT(fact.Contract(), fact.NFT()), state.NewNFTStateValue(n)),
+		cstate.NewStateMergeValue(
+			state.StateKeyClawback(fact.Contract(), fact.NFT(), op.Hash()),
+			state.NewClawbackStateValue(nv.Owner(), fact.Receiver(), fact.Reason().String()),
+		),
+	)
+
+	return sts, nil, nil
+}
+
+func (opp *ForceTransferProcessor) Close() error {
+	forceTransferProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package nft
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+func TestForceTransferClawbackRecords(t *testing.T) {
+	g := newTestStateGetter()
+
+	sender, priv := g.newAccount(t)
+	owner, _ := g.newAccount(t)
+	receiver, _ := g.newAccount(t)
+	contract := g.newCollection(t, sender, newTestCollectionPolicy().WithClawback(true))
+
+	g.setNFT(contract, types.NewNFT(0, true, owner, "hash", "https://example.com/1", owner, types.NewSigners(nil)))
+
+	forceTransfer := func(token string, from, to base.Address) base.Operation {
+		op, err := NewForceTransfer(NewForceTransferFact([]byte(token), sender, contract, 0, to, "court order", "MCC"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := op.Sign(priv, testNetworkID); err != nil {
+			t.Fatal(err)
+		}
+
+		sts, err := processTestOperation(t, NewForceTransferProcessor(), op, g.GetStateFunc)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var found bool
+		for _, st := range sts {
+			g.set(st.Key(), st.Value())
+
+			v, ok := st.Value().(state.ClawbackStateValue)
+			if !ok {
+				continue
+			}
+
+			found = true
+
+			switch {
+			case st.Key() != state.StateKeyClawback(contract, 0, op.Hash()):
+				t.Fatalf("clawback record not keyed by operation, %v", st.Key())
+			case !v.From().Equal(from) || !v.To().Equal(to):
+				t.Fatalf("clawback record from %v to %v, expected from %v to %v", v.From(), v.To(), from, to)
+			}
+		}
+
+		if !found {
+			t.Fatal("clawback record not found")
+		}
+
+		return op
+	}
+
+	first := forceTransfer("token0", owner, receiver)
+	second := forceTransfer("token1", receiver, owner)
+
+	for _, op := range []base.Operation{first, second} {
+		if _, found, err := g.Get(state.StateKeyClawback(contract, 0, op.Hash())); err != nil || !found {
+			t.Fatalf("clawback record of %v not kept: %v", op.Hash(), err)
+		}
+	}
+}
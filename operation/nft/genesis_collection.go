@@ -0,0 +1,254 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	GenesisCollectionFactHint = hint.MustNewHint("mitum-nft-genesis-collection-operation-fact-v0.0.1")
+	GenesisCollectionHint     = hint.MustNewHint("mitum-nft-genesis-collection-operation-v0.0.1")
+)
+
+// GenesisCollectionFact registers a collection with its contract account and
+// mints nfts with explicit idxes and owners in the genesis block.
+type GenesisCollectionFact struct {
+	base.BaseFact
+	contract base.Address
+	creator  base.Address
+	policy   types.CollectionPolicy
+	items    []GenesisNFTItem
+}
+
+func NewGenesisCollectionFact(
+	token []byte,
+	contract, creator base.Address,
+	policy types.CollectionPolicy,
+	items []GenesisNFTItem,
+) GenesisCollectionFact {
+	fact := GenesisCollectionFact{
+		BaseFact: base.NewBaseFact(GenesisCollectionFactHint, token),
+		contract: contract,
+		creator:  creator,
+		policy:   policy,
+		items:    items,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact GenesisCollectionFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact GenesisCollectionFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.contract.Bytes(),
+		fact.creator.Bytes(),
+		fact.policy.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact GenesisCollectionFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.contract, fact.creator, fact.policy); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.creator.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("creator %v is same with contract account", fact.creator)))
+	}
+
+	if len(fact.items) > 0 && fact.policy.MintMode().IsRandom() {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrValueInvalid.Wrap(errors.Errorf("nfts with explicit idxes in random mint mode")))
+	}
+
+	supply := fact.policy.MaxSupply()
+	founds := map[uint64]struct{}{}
+	for _, it := range fact.items {
+		if err := it.IsValid(nil); err != nil {
+			return common.ErrFactInvalid.Wrap(err)
+		}
+
+		if _, found := founds[it.Idx()]; found {
+			return common.ErrFactInvalid.Wrap(common.ErrDupVal.Wrap(errors.Errorf("nft idx %v", it.Idx())))
+		}
+		founds[it.Idx()] = struct{}{}
+
+		if supply > 0 && it.Idx() >= supply {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrValOOR.Wrap(errors.Errorf("nft idx %v over max supply %v", it.Idx(), supply)))
+		}
+
+		for _, a := range it.Addresses() {
+			if a.Equal(fact.contract) {
+				return common.ErrFactInvalid.Wrap(
+					common.ErrSelfTarget.Wrap(errors.Errorf("account %v of nft %v is same with contract account", a, it.Idx())))
+			}
+		}
+
+		if it.URI() == "" && fact.policy.BaseURI() == "" {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrValueInvalid.Wrap(errors.Errorf("empty uri of nft %v without base uri", it.Idx())))
+		}
+
+		if err := fact.policy.IsValidNFTHash(it.NFTHash()); err != nil {
+			return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(err))
+		}
+
+		if mr := fact.policy.MaxRoyalty(); it.Royalty() > mr {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrValOOR.Wrap(errors.Errorf("royalty %v of nft %v over max royalty %v", it.Royalty(), it.Idx(), mr)))
+		}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact GenesisCollectionFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact GenesisCollectionFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact GenesisCollectionFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact GenesisCollectionFact) Creator() base.Address {
+	return fact.creator
+}
+
+func (fact GenesisCollectionFact) Policy() types.CollectionPolicy {
+	return fact.policy
+}
+
+func (fact GenesisCollectionFact) Items() []GenesisNFTItem {
+	return fact.items
+}
+
+// Addresses returns the accounts of the creator, the policy and the nfts,
+// without duplicates.
+func (fact GenesisCollectionFact) Addresses() []base.Address {
+	as, _ := fact.policy.Addresses()
+	as = append([]base.Address{fact.creator}, as...)
+
+	for _, it := range fact.items {
+		as = append(as, it.Addresses()...)
+	}
+
+	founds := map[string]struct{}{}
+
+	var addresses []base.Address
+	for _, a := range as {
+		if _, found := founds[a.String()]; found {
+			continue
+		}
+		founds[a.String()] = struct{}{}
+
+		addresses = append(addresses, a)
+	}
+
+	return addresses
+}
+
+// CheckParams checks the policy and the nfts against the nft params and the uri
+// rule of the collection or, without it, the default uri rule of the params.
+// The genesis operations can not read the params state, so the genesis block
+// generator checks them with the params of the genesis design.
+func (fact GenesisCollectionFact) CheckParams(params types.Params) error {
+	if err := params.CheckPolicy(fact.policy); err != nil {
+		return err
+	}
+
+	p := fact.policy
+	if err := checkURIRule(params, p.URIRule(), p.URI(), p.BaseURI(), p.ExternalURL(), p.ContractURI()); err != nil {
+		return err
+	}
+
+	for _, it := range fact.items {
+		if err := checkURIRule(params, p.URIRule(), it.URI()); err != nil {
+			return err
+		}
+
+		if err := params.CheckURIs(it.URI()); err != nil {
+			return err
+		}
+
+		if err := params.CheckNFTHash(it.NFTHash()); err != nil {
+			return err
+		}
+
+		if err := params.CheckSigners(it.Creators()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LastNFTIndex returns the value of LastNFTIndexStateValue after the genesis
+// nfts; sequential mints continue after the highest genesis idx and explicit
+// mints count the genesis nfts.
+func (fact GenesisCollectionFact) LastNFTIndex() uint64 {
+	if fact.policy.MintMode().IsExplicit() {
+		return uint64(len(fact.items))
+	}
+
+	var last uint64
+	for _, it := range fact.items {
+		if it.Idx()+1 > last {
+			last = it.Idx() + 1
+		}
+	}
+
+	return last
+}
+
+type GenesisCollection struct {
+	common.BaseOperation
+}
+
+func NewGenesisCollection(fact GenesisCollectionFact) GenesisCollection {
+	return GenesisCollection{BaseOperation: common.NewBaseOperation(GenesisCollectionHint, fact)}
+}
+
+func (op GenesisCollection) IsValid(networkID []byte) error {
+	if err := op.BaseOperation.IsValid(networkID); err != nil {
+		return err
+	}
+
+	if len(op.Signs()) != 1 {
+		return util.ErrInvalid.Errorf("Genesis collection should be signed only by genesis node key")
+	}
+
+	if _, ok := op.Fact().(GenesisCollectionFact); !ok {
+		return errors.Errorf("expected GenesisCollectionFact, not %T", op.Fact())
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+func (fact *GenesisCollectionFact) unpack(
+	enc encoder.Encoder,
+	ca, cr string,
+	bpo, bits []byte,
+) error {
+	switch a, err := base.DecodeAddress(ca, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.contract = a
+	}
+
+	switch a, err := base.DecodeAddress(cr, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.creator = a
+	}
+
+	if hinter, err := enc.Decode(bpo); err != nil {
+		return err
+	} else if policy, ok := hinter.(types.CollectionPolicy); !ok {
+		return common.ErrTypeMismatch.Wrap(errors.Errorf("expected CollectionPolicy, not %T", hinter))
+	} else {
+		fact.policy = policy
+	}
+
+	hits, err := enc.DecodeSlice(bits)
+	if err != nil {
+		return err
+	}
+
+	items := make([]GenesisNFTItem, len(hits))
+	for i, hinter := range hits {
+		item, ok := hinter.(GenesisNFTItem)
+		if !ok {
+			return common.ErrTypeMismatch.Wrap(errors.Errorf("expected GenesisNFTItem, not %T", hinter))
+		}
+
+		items[i] = item
+	}
+	fact.items = items
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+var GenesisNFTItemHint = hint.MustNewHint("mitum-nft-genesis-nft-item-v0.0.1")
+
+// GenesisNFTItem is a nft minted with an explicit idx and owner at genesis.
+type GenesisNFTItem struct {
+	hint.BaseHinter
+	idx             uint64
+	owner           base.Address
+	hash            types.NFTHash
+	uri             types.URI
+	creators        types.Signers
+	attrs           types.Attributes
+	royalty         types.PaymentParameter
+	royaltyReceiver base.Address
+}
+
+func NewGenesisNFTItem(
+	idx uint64,
+	owner base.Address,
+	hash types.NFTHash,
+	uri types.URI,
+	creators types.Signers,
+	attributes types.Attributes,
+	royalty types.PaymentParameter,
+	royaltyReceiver base.Address,
+) GenesisNFTItem {
+	return GenesisNFTItem{
+		BaseHinter:      hint.NewBaseHinter(GenesisNFTItemHint),
+		idx:             idx,
+		owner:           owner,
+		hash:            hash,
+		uri:             uri,
+		creators:        creators,
+		attrs:           attributes,
+		royalty:         royalty,
+		royaltyReceiver: royaltyReceiver,
+	}
+}
+
+func (it GenesisNFTItem) Bytes() []byte {
+	var rb []byte
+	if it.royalty != 0 {
+		rb = it.royalty.Bytes()
+	}
+
+	var rrb []byte
+	if it.royaltyReceiver != nil {
+		rrb = it.royaltyReceiver.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		util.Uint64ToBytes(it.idx),
+		it.owner.Bytes(),
+		it.hash.Bytes(),
+		it.uri.Bytes(),
+		it.creators.Bytes(),
+		it.attrs.Bytes(),
+		types.OptionalBytes(rb, rrb),
+	)
+}
+
+func (it GenesisNFTItem) IsValid([]byte) error {
+	if it.idx >= types.MaxNFTIndex {
+		return common.ErrValOOR.Wrap(errors.Errorf("nft idx %v over max nft index %v", it.idx, types.MaxNFTIndex))
+	}
+
+	for _, signer := range it.creators.Signers() {
+		if signer.Signed() {
+			return common.ErrValueInvalid.Wrap(errors.Errorf("creator %v should not be signed at the time of minting", signer.Address()))
+		}
+	}
+
+	if it.royaltyReceiver != nil {
+		if err := it.royaltyReceiver.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	return util.CheckIsValiders(
+		nil,
+		false,
+		it.BaseHinter,
+		it.owner,
+		it.hash,
+		it.uri,
+		it.creators,
+		it.attrs,
+		it.royalty,
+	)
+}
+
+func (it GenesisNFTItem) Idx() uint64 {
+	return it.idx
+}
+
+func (it GenesisNFTItem) Owner() base.Address {
+	return it.owner
+}
+
+func (it GenesisNFTItem) NFTHash() types.NFTHash {
+	return it.hash
+}
+
+func (it GenesisNFTItem) URI() types.URI {
+	return it.uri
+}
+
+func (it GenesisNFTItem) Creators() types.Signers {
+	return it.creators
+}
+
+func (it GenesisNFTItem) Attributes() types.Attributes {
+	return it.attrs
+}
+
+func (it GenesisNFTItem) Royalty() types.PaymentParameter {
+	return it.royalty
+}
+
+func (it GenesisNFTItem) RoyaltyReceiver() base.Address {
+	return it.royaltyReceiver
+}
+
+func (it GenesisNFTItem) Addresses() []base.Address {
+	as := []base.Address{it.owner}
+	as = append(as, it.creators.Addresses()...)
+	if it.royaltyReceiver != nil {
+		as = append(as, it.royaltyReceiver)
+	}
+
+	return as
+}
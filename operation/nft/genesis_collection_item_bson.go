@@ -0,0 +1,63 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/nft-model/types"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func (it GenesisNFTItem) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"_hint":    it.Hint().String(),
+		"nft_idx":  it.idx,
+		"owner":    it.owner,
+		"hash":     it.hash,
+		"uri":      it.uri,
+		"creators": it.creators,
+	}
+
+	if len(it.attrs) > 0 {
+		m["attributes"] = it.attrs
+	}
+
+	if it.royalty != 0 {
+		m["royalty"] = it.royalty
+	}
+
+	if it.royaltyReceiver != nil {
+		m["royalty_receiver"] = it.royaltyReceiver
+	}
+
+	return bsonenc.Marshal(m)
+}
+
+type GenesisNFTItemBSONUnmarshaler struct {
+	Hint            string           `bson:"_hint"`
+	Idx             uint64           `bson:"nft_idx"`
+	Owner           string           `bson:"owner"`
+	Hash            string           `bson:"hash"`
+	Uri             string           `bson:"uri"`
+	Creators        bson.Raw         `bson:"creators"`
+	Attrs           types.Attributes `bson:"attributes,omitempty"`
+	Royalty         uint             `bson:"royalty,omitempty"`
+	RoyaltyReceiver string           `bson:"royalty_receiver,omitempty"`
+}
+
+func (it *GenesisNFTItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	var u GenesisNFTItemBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *it)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *it)
+	}
+
+	if err := it.unpack(enc, ht, u.Idx, u.Owner, u.Hash, u.Uri, u.Creators, u.Attrs, u.Royalty, u.RoyaltyReceiver); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *it)
+	}
+	return nil
+}
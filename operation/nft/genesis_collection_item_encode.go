@@ -0,0 +1,52 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+func (it *GenesisNFTItem) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	idx uint64,
+	ow, hs, uri string,
+	bcr []byte,
+	attrs types.Attributes,
+	ry uint,
+	rr string,
+) error {
+	it.BaseHinter = hint.NewBaseHinter(ht)
+	it.idx = idx
+	it.hash = types.NFTHash(hs)
+	it.uri = types.URI(uri)
+	it.attrs = attrs
+	it.royalty = types.PaymentParameter(ry)
+
+	switch a, err := base.DecodeAddress(ow, enc); {
+	case err != nil:
+		return err
+	default:
+		it.owner = a
+	}
+
+	switch a, err := base.DecodeAddress(rr, enc); {
+	case err != nil:
+		return err
+	default:
+		it.royaltyReceiver = a
+	}
+
+	if hinter, err := enc.Decode(bcr); err != nil {
+		return err
+	} else if creators, ok := hinter.(types.Signers); !ok {
+		return common.ErrTypeMismatch.Wrap(errors.Errorf("expected Signers, not %T", hinter))
+	} else {
+		it.creators = creators
+	}
+
+	return nil
+}
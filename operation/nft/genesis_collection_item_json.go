@@ -0,0 +1,63 @@
+package nft
+
+import (
+	"encoding/json"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+type GenesisNFTItemJSONMarshaler struct {
+	hint.BaseHinter
+	Idx             uint64                 `json:"nft_idx"`
+	Owner           base.Address           `json:"owner"`
+	Hash            types.NFTHash          `json:"hash"`
+	Uri             types.URI              `json:"uri"`
+	Creators        types.Signers          `json:"creators"`
+	Attrs           types.Attributes       `json:"attributes,omitempty"`
+	Royalty         types.PaymentParameter `json:"royalty,omitempty"`
+	RoyaltyReceiver base.Address           `json:"royalty_receiver,omitempty"`
+}
+
+func (it GenesisNFTItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(GenesisNFTItemJSONMarshaler{
+		BaseHinter:      it.BaseHinter,
+		Idx:             it.idx,
+		Owner:           it.owner,
+		Hash:            it.hash,
+		Uri:             it.uri,
+		Creators:        it.creators,
+		Attrs:           it.attrs,
+		Royalty:         it.royalty,
+		RoyaltyReceiver: it.royaltyReceiver,
+	})
+}
+
+type GenesisNFTItemJSONUnmarshaler struct {
+	Hint            hint.Hint        `json:"_hint"`
+	Idx             uint64           `json:"nft_idx"`
+	Owner           string           `json:"owner"`
+	Hash            string           `json:"hash"`
+	Uri             string           `json:"uri"`
+	Creators        json.RawMessage  `json:"creators"`
+	Attrs           types.Attributes `json:"attributes"`
+	Royalty         uint             `json:"royalty"`
+	RoyaltyReceiver string           `json:"royalty_receiver"`
+}
+
+func (it *GenesisNFTItem) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u GenesisNFTItemJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *it)
+	}
+
+	if err := it.unpack(enc, u.Hint, u.Idx, u.Owner, u.Hash, u.Uri, u.Creators, u.Attrs, u.Royalty, u.RoyaltyReceiver); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *it)
+	}
+
+	return nil
+}
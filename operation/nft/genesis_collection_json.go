@@ -0,0 +1,56 @@
+package nft
+
+import (
+	"encoding/json"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+type GenesisCollectionFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Contract base.Address           `json:"contract"`
+	Creator  base.Address           `json:"creator"`
+	Policy   types.CollectionPolicy `json:"policy"`
+	Items    []GenesisNFTItem       `json:"nfts"`
+}
+
+func (fact GenesisCollectionFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(GenesisCollectionFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Contract:              fact.contract,
+		Creator:               fact.creator,
+		Policy:                fact.policy,
+		Items:                 fact.items,
+	})
+}
+
+type GenesisCollectionFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Contract string          `json:"contract"`
+	Creator  string          `json:"creator"`
+	Policy   json.RawMessage `json:"policy"`
+	Items    json.RawMessage `json:"nfts"`
+}
+
+func (fact *GenesisCollectionFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u GenesisCollectionFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Contract, u.Creator, u.Policy, u.Items); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op GenesisCollection) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(op.BaseOperation)
+}
@@ -0,0 +1,106 @@
+package nft
+
+import (
+	"context"
+
+	cstate "github.com/imfact-labs/currency-model/state"
+	ccstate "github.com/imfact-labs/currency-model/state/currency"
+	statee "github.com/imfact-labs/currency-model/state/extension"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+func (op GenesisCollection) PreProcess(
+	ctx context.Context, _ base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	return ctx, nil, nil
+}
+
+// Process creates the contract account of the collection and writes the same
+// collection, last index and nft states as RegisterModel and Mint. The
+// accounts of the creator, the policy and the nfts are created like Mint and
+// RegisterModel do. The genesis block generator processes the genesis
+// collections before the other genesis operations, so the accounts created by
+// the other genesis operations, like the genesis account, replace them.
+func (op GenesisCollection) Process(
+	_ context.Context, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, ok := op.Fact().(GenesisCollectionFact)
+	if !ok {
+		return nil, nil, errors.Errorf("expected %T, not %T", GenesisCollectionFact{}, op.Fact())
+	}
+
+	for _, k := range []string{
+		ccstate.AccountStateKey(fact.contract),
+		statee.StateKeyContractAccount(fact.contract),
+		state.NFTStateKey(fact.contract, state.CollectionKey),
+		state.NFTStateKey(fact.contract, state.LastIDXKey),
+	} {
+		if _, err := cstate.NotExistsState(k, "genesis collection", getStateFunc); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	ks, err := ctypes.NewContractAccountKeys()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	acc, err := ctypes.NewAccount(fact.contract, ks)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to create contract account, %v: %w", fact.contract, err), nil
+	}
+
+	ca := ctypes.NewContractAccountStatus(fact.creator, nil)
+	ca.SetActive(true)
+	h := RegisterModelHint
+	ca.SetRegisterOperation(&h)
+
+	design := types.NewDesign(fact.contract, fact.creator, true, false, uint64(len(fact.items)), fact.policy)
+	if err := design.IsValid(nil); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid collection design, %v: %w", fact.contract, err), nil
+	}
+
+	var sts []base.StateMergeValue
+	for _, a := range fact.Addresses() {
+		smv, err := cstate.CreateNotExistAccount(a, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		} else if smv != nil {
+			sts = append(sts, smv)
+		}
+	}
+
+	sts = append(sts,
+		cstate.NewStateMergeValue(ccstate.AccountStateKey(fact.contract), ccstate.NewAccountStateValue(acc)),
+		cstate.NewStateMergeValue(statee.StateKeyContractAccount(fact.contract), statee.NewContractAccountStateValue(ca)),
+		cstate.NewStateMergeValue(
+			state.NFTStateKey(fact.contract, state.CollectionKey), state.NewCollectionStateValue(design)),
+		cstate.NewStateMergeValue(
+			state.NFTStateKey(fact.contract, state.LastIDXKey), state.NewLastNFTIndexStateValue(fact.LastNFTIndex())),
+	)
+
+	// the first membership period is included in the mint.
+	var expires base.Height
+	if rule := fact.policy.MembershipRule(); rule.IsMembership() {
+		expires = rule.Extend(0, base.GenesisHeight, 1)
+	}
+
+	for _, it := range fact.items {
+		n := types.NewNFT(it.Idx(), true, it.Owner(), it.NFTHash(), it.URI(), it.Owner(), it.Creators()).
+			WithAttributes(it.Attributes()).
+			WithExpiry(expires).
+			WithRoyalty(it.Royalty(), it.RoyaltyReceiver())
+		if err := n.IsValid(nil); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("invalid nft, %v: %w", it.Idx(), err), nil
+		}
+
+		sts = append(sts, cstate.NewStateMergeValue(state.StateKeyNFT(fact.contract, it.Idx()), state.NewNFTStateValue(n)))
+	}
+
+	return sts, nil, nil
+}
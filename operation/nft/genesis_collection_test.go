@@ -0,0 +1,105 @@
+package nft
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ccstate "github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+func TestGenesisCollectionAccounts(t *testing.T) {
+	g := newTestStateGetter()
+
+	creator, _ := g.newAccount(t)
+	owner := newTestAddress(t)
+	signer := newTestAddress(t)
+	contract := newTestAddress(t)
+
+	items := []GenesisNFTItem{
+		NewGenesisNFTItem(0, owner, "hash", "https://example.com/0",
+			types.NewSigners([]types.Signer{types.NewSigner(signer, 100, false)}), nil, 0, nil),
+		NewGenesisNFTItem(1, owner, "hash", "https://example.com/1", types.NewSigners(nil), nil, 0, nil),
+	}
+
+	fact := NewGenesisCollectionFact(testNetworkID, contract, creator, newTestCollectionPolicy(), items)
+	if err := fact.IsValid(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	sts, rErr, err := NewGenesisCollection(fact).Process(context.Background(), g.GetStateFunc)
+	switch {
+	case err != nil:
+		t.Fatal(err)
+	case rErr != nil:
+		t.Fatal(rErr)
+	}
+
+	accounts := map[string]int{}
+	var nfts int
+	for _, st := range sts {
+		switch st.Value().(type) {
+		case ccstate.AccountStateValue:
+			accounts[st.Key()]++
+		case state.NFTStateValue:
+			nfts++
+		}
+	}
+
+	// the existing account of the creator is not created again.
+	for _, a := range []base.Address{owner, signer, contract} {
+		if accounts[ccstate.AccountStateKey(a)] != 1 {
+			t.Fatalf("account %v not created once, %v", a, accounts)
+		}
+	}
+
+	if n := len(accounts); n != 3 {
+		t.Fatalf("expected 3 accounts, not %d", n)
+	}
+
+	if nfts != len(items) {
+		t.Fatalf("expected %d nfts, not %d", len(items), nfts)
+	}
+}
+
+func TestGenesisCollectionCheckParams(t *testing.T) {
+	creator, owner, contract := newTestAddress(t), newTestAddress(t), newTestAddress(t)
+
+	params := types.DefaultParams().WithURIRule(types.NewURIRule([]string{"ipfs"}, nil))
+
+	cases := []struct {
+		name     string
+		rule     types.URIRule
+		uri      types.URI
+		expected string
+	}{
+		{"default rule", types.URIRule{}, "ipfs://nft/0", ""},
+		{"out of default rule", types.URIRule{}, "ar://nft/0", "not allowed"},
+		{"collection rule", types.NewURIRule([]string{"ar", "https"}, nil), "ar://nft/0", ""},
+		{"out of collection rule", types.NewURIRule([]string{"ar", "https"}, nil), "ipfs://nft/0", "not allowed"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			policy := types.NewCollectionPolicy("collection", 0, "", nil).WithURIRule(c.rule)
+			items := []GenesisNFTItem{NewGenesisNFTItem(0, owner, "hash", c.uri, types.NewSigners(nil), nil, 0, nil)}
+
+			fact := NewGenesisCollectionFact(testNetworkID, contract, creator, policy, items)
+			if err := fact.IsValid(nil); err != nil {
+				t.Fatal(err)
+			}
+
+			err := fact.CheckParams(params)
+			switch {
+			case c.expected == "" && err != nil:
+				t.Fatal(err)
+			case c.expected == "":
+			case err == nil || !strings.Contains(err.Error(), c.expected):
+				t.Fatalf("expected %q, not %v", c.expected, err)
+			}
+		})
+	}
+}
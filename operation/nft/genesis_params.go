@@ -0,0 +1,91 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	GenesisParamsFactHint = hint.MustNewHint("mitum-nft-genesis-params-operation-fact-v0.0.1")
+	GenesisParamsHint     = hint.MustNewHint("mitum-nft-genesis-params-operation-v0.0.1")
+)
+
+// GenesisParamsFact sets the chain-level nft params in the genesis block.
+type GenesisParamsFact struct {
+	base.BaseFact
+	params types.Params
+}
+
+func NewGenesisParamsFact(token []byte, params types.Params) GenesisParamsFact {
+	fact := GenesisParamsFact{
+		BaseFact: base.NewBaseFact(GenesisParamsFactHint, token),
+		params:   params,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact GenesisParamsFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact GenesisParamsFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.params.Bytes(),
+	)
+}
+
+func (fact GenesisParamsFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false, fact.BaseHinter, fact.params); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact GenesisParamsFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact GenesisParamsFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact GenesisParamsFact) Params() types.Params {
+	return fact.params
+}
+
+type GenesisParams struct {
+	common.BaseOperation
+}
+
+func NewGenesisParams(fact GenesisParamsFact) GenesisParams {
+	return GenesisParams{BaseOperation: common.NewBaseOperation(GenesisParamsHint, fact)}
+}
+
+func (op GenesisParams) IsValid(networkID []byte) error {
+	if err := op.BaseOperation.IsValid(networkID); err != nil {
+		return err
+	}
+
+	if len(op.Signs()) != 1 {
+		return util.ErrInvalid.Errorf("Genesis params should be signed only by genesis node key")
+	}
+
+	if _, ok := op.Fact().(GenesisParamsFact); !ok {
+		return errors.Errorf("expected GenesisParamsFact, not %T", op.Fact())
+	}
+
+	return nil
+}
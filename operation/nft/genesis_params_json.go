@@ -0,0 +1,47 @@
+package nft
+
+import (
+	"encoding/json"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+type GenesisParamsFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Params types.Params `json:"params"`
+}
+
+func (fact GenesisParamsFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(GenesisParamsFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Params:                fact.params,
+	})
+}
+
+type GenesisParamsFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Params json.RawMessage `json:"params"`
+}
+
+func (fact *GenesisParamsFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u GenesisParamsFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Params); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op GenesisParams) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(op.BaseOperation)
+}
@@ -0,0 +1,34 @@
+package nft
+
+import (
+	"context"
+
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/pkg/errors"
+)
+
+func (op GenesisParams) PreProcess(
+	ctx context.Context, _ base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	return ctx, nil, nil
+}
+
+func (op GenesisParams) Process(
+	_ context.Context, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, ok := op.Fact().(GenesisParamsFact)
+	if !ok {
+		return nil, nil, errors.Errorf("expected %T, not %T", GenesisParamsFact{}, op.Fact())
+	}
+
+	if _, err := cstate.NotExistsState(state.StateKeyParams, "genesis params", getStateFunc); err != nil {
+		return nil, nil, err
+	}
+
+	return []base.StateMergeValue{
+		cstate.NewStateMergeValue(state.StateKeyParams, state.NewParamsStateValue(fact.Params())),
+	}, nil, nil
+}
@@ -0,0 +1,211 @@
+package nft
+
+import (
+	"context"
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/test"
+	ccstate "github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/state/extension"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+var testNetworkID = base.NetworkID("network_id")
+
+func newTestAddress(t testing.TB) base.Address {
+	t.Helper()
+
+	k, err := ctypes.NewBaseAccountKey(base.NewMPrivatekey().Publickey(), 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := ctypes.NewBaseAccountKeys([]ctypes.AccountKey{k}, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := ctypes.NewAddressFromKeys(keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return a
+}
+
+// testStateGetter keeps the states of the processor tests and counts the
+// states read.
+type testStateGetter struct {
+	*test.MockStateGetter
+	reads int
+}
+
+func newTestStateGetter() *testStateGetter {
+	return &testStateGetter{MockStateGetter: test.NewMockStateGetter()}
+}
+
+func (g *testStateGetter) GetStateFunc(key string) (base.State, bool, error) {
+	g.reads++
+
+	return g.Get(key)
+}
+
+func (g *testStateGetter) set(key string, v base.StateValue) {
+	g.Set(key, common.NewBaseState(base.Height(1), key, v, nil, []util.Hash{}))
+}
+
+// apply sets the values of the states processed by an operation.
+func (g *testStateGetter) apply(sts []base.StateMergeValue) {
+	for i := range sts {
+		g.set(sts[i].Key(), sts[i].Value())
+	}
+}
+
+func (g *testStateGetter) setAccount(t testing.TB, keys ctypes.AccountKeys) base.Address {
+	t.Helper()
+
+	a, err := ctypes.NewAddressFromKeys(keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ac, err := ctypes.NewAccount(a, keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g.set(ccstate.AccountStateKey(a), ccstate.NewAccountStateValue(ac))
+
+	return a
+}
+
+// newAccount sets the account of a new key and returns its address and
+// private key.
+func (g *testStateGetter) newAccount(t testing.TB) (base.Address, base.Privatekey) {
+	t.Helper()
+
+	priv := base.NewMPrivatekey()
+
+	k, err := ctypes.NewBaseAccountKey(priv.Publickey(), 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := ctypes.NewBaseAccountKeys([]ctypes.AccountKey{k}, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return g.setAccount(t, keys), priv
+}
+
+// newCollection sets an active contract account owned by owner with the
+// collection of policy.
+func (g *testStateGetter) newCollection(t testing.TB, owner base.Address, policy types.CollectionPolicy) base.Address {
+	t.Helper()
+
+	a := g.newContractAccount(t, owner, true)
+
+	g.set(state.NFTStateKey(a, state.CollectionKey),
+		state.NewCollectionStateValue(types.NewDesign(a, owner, true, false, 0, policy)))
+	g.set(state.NFTStateKey(a, state.LastIDXKey), state.NewLastNFTIndexStateValue(0))
+
+	return a
+}
+
+// newContractAccount sets a contract account owned by owner without a
+// collection.
+func (g *testStateGetter) newContractAccount(t testing.TB, owner base.Address, active bool) base.Address {
+	t.Helper()
+
+	k, err := ctypes.NewBaseAccountKey(base.NewMPrivatekey().Publickey(), 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := ctypes.NewBaseAccountKeys([]ctypes.AccountKey{k}, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := ctypes.NewAddressFromKeys(keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ckeys, err := ctypes.NewContractAccountKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ac, err := ctypes.NewAccount(a, ckeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	status := ctypes.NewContractAccountStatus(owner, nil)
+	status.SetActive(active)
+
+	g.set(ccstate.AccountStateKey(a), ccstate.NewAccountStateValue(ac))
+	g.set(extension.StateKeyContractAccount(a), extension.NewContractAccountStateValue(status))
+
+	return a
+}
+
+func (g *testStateGetter) setNFT(contract base.Address, n types.NFT) {
+	g.set(state.StateKeyNFT(contract, n.ID()), state.NewNFTStateValue(n))
+}
+
+func newTestCollectionPolicy() types.CollectionPolicy {
+	return types.NewCollectionPolicy("collection", 0, "https://example.com", nil)
+}
+
+// processTestOperation runs PreProcess and Process of op with the new
+// processor of getNewProcessor.
+func processTestOperation(
+	t testing.TB, getNewProcessor ctypes.GetNewProcessor, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	t.Helper()
+
+	run := func() (base.OperationProcessor, error) {
+		return getNewProcessor(base.Height(2), getStateFunc, nil, nil)
+	}
+
+	opp, err := run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, rErr, err := opp.PreProcess(context.Background(), op, getStateFunc)
+	_ = opp.Close()
+
+	switch {
+	case err != nil:
+		t.Fatal(err)
+	case rErr != nil:
+		return nil, rErr
+	}
+
+	opp, err = run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = opp.Close()
+	}()
+
+	sts, rErr, err := opp.Process(context.Background(), op, getStateFunc)
+	switch {
+	case err != nil:
+		t.Fatal(err)
+	case rErr != nil:
+		return nil, rErr
+	}
+
+	return sts, nil
+}
@@ -8,11 +8,10 @@ import (
 	"github.com/imfact-labs/mitum2/util"
 	"github.com/imfact-labs/mitum2/util/hint"
 	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/imfact-labs/nft-model/state"
 	"github.com/pkg/errors"
 )
 
-var MaxMintItems = 100
-
 var (
 	MintFactHint = hint.MustNewHint("mitum-nft-mint-operation-fact-v0.0.1")
 	MintHint     = hint.MustNewHint("mitum-nft-mint-operation-v0.0.1")
@@ -45,10 +44,9 @@ func (fact MintFact) IsValid(b []byte) error {
 
 	if l := len(fact.items); l < 1 {
 		return common.ErrArrayLen.Wrap(errors.Errorf("empty items for MintFact"))
-	} else if l > int(MaxMintItems) {
-		return common.ErrFactInvalid.Wrap(common.ErrArrayLen.Wrap(errors.Errorf("items over allowed, %d > %d", l, MaxMintItems)))
 	}
 
+	founds := map[string]struct{}{}
 	for _, item := range fact.items {
 		if err := item.IsValid(nil); err != nil {
 			return common.ErrFactInvalid.Wrap(err)
@@ -57,6 +55,15 @@ func (fact MintFact) IsValid(b []byte) error {
 			return common.ErrFactInvalid.Wrap(
 				common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
 		}
+
+		if idx, explicit := item.Idx(); explicit {
+			k := state.StateKeyNFT(item.contract, idx)
+			if _, found := founds[k]; found {
+				return common.ErrFactInvalid.Wrap(
+					common.ErrDupVal.Wrap(errors.Errorf("nft idx %v of contract account %v", idx, item.contract)))
+			}
+			founds[k] = struct{}{}
+		}
 	}
 
 	if err := common.IsValidOperationFact(fact, b); err != nil {
@@ -158,7 +165,17 @@ func (fact MintFact) ActiveContract() []base.Address {
 func (fact MintFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
 	r := make(map[types.DuplicationKeyType][]string)
 	r[extras.DuplicationKeyTypeSender] = []string{fact.sender.String()}
+
+	// the contract key also serializes the nft idx allocation of the
+	// collection in a proposal; it is added once for the items of the same
+	// collection.
+	founds := map[string]struct{}{}
 	for _, item := range fact.items {
+		if _, found := founds[item.contract.String()]; found {
+			continue
+		}
+		founds[item.contract.String()] = struct{}{}
+
 		r[extras.DuplicationKeyTypeContractStatus] = append(
 			r[extras.DuplicationKeyTypeContractStatus], item.contract.String())
 	}
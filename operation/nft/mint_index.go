@@ -0,0 +1,143 @@
+package nft
+
+import (
+	"encoding/binary"
+
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+// mintIndexAllocator assigns nft idxes of one collection while a Mint
+// operation is processed.
+//
+// In random mint mode the not-yet-minted idxes form a pool of maxSupply
+// slots, shuffled lazily like Fisher-Yates: a draw picks a slot among the
+// remaining ones, takes its idx and moves the idx of the last remaining slot
+// into it. Only moved slots are stored, so each draw writes at most one
+// state. The draw uses the operation hash, the block height and the draw
+// count as entropy, so every node assigns the same idx.
+//
+// The draw is not resistant to manipulation: the sender chooses the
+// operation hash through the token and can grind it to steer the draw
+// toward an idx. Random mode only spreads idxes over the supply and must not
+// be relied on for a fair draw.
+//
+// In explicit mint mode the idx carried by the MintItem is used.
+type mintIndexAllocator struct {
+	contract base.Address
+	mode     types.MintMode
+	supply   uint64
+	next     uint64
+	entropy  []byte
+	slots    map[uint64]uint64
+}
+
+func newMintIndexAllocator(
+	contract base.Address,
+	policy types.CollectionPolicy,
+	last uint64,
+	opHash util.Hash,
+	height base.Height,
+) *mintIndexAllocator {
+	return &mintIndexAllocator{
+		contract: contract,
+		mode:     policy.MintMode(),
+		supply:   policy.MaxSupply(),
+		next:     last,
+		entropy:  util.ConcatBytesSlice(opHash.Bytes(), height.Bytes(), contract.Bytes()),
+		slots:    map[uint64]uint64{},
+	}
+}
+
+// Next returns the value of LastNFTIndexStateValue after the allocated
+// idxes; in random and explicit mode it is the number of allocated idxes.
+func (a *mintIndexAllocator) Next() uint64 {
+	return a.next
+}
+
+func (a *mintIndexAllocator) Allocate(item MintItem, getStateFunc base.GetStateFunc) (uint64, error) {
+	if a.supply > 0 && a.next >= a.supply {
+		return 0, errors.Errorf("max supply %d of contract account %v reached", a.supply, a.contract)
+	}
+
+	switch idx, explicit := item.Idx(); {
+	case a.mode.IsExplicit() && !explicit:
+		return 0, errors.Errorf("nft idx required in %v mint mode of contract account %v", a.mode, a.contract)
+	case explicit && !a.mode.IsExplicit():
+		return 0, errors.Errorf("nft idx not allowed in %v mint mode of contract account %v", a.mode, a.contract)
+	case explicit && a.supply > 0 && idx >= a.supply:
+		return 0, errors.Errorf("nft idx %d over max supply %d of contract account %v", idx, a.supply, a.contract)
+	case explicit:
+		a.next++
+
+		return idx, nil
+	}
+
+	if !a.mode.IsRandom() {
+		idx := a.next
+		a.next++
+
+		return idx, nil
+	}
+
+	remaining := a.supply - a.next
+	h := valuehash.NewSHA256(util.ConcatBytesSlice(a.entropy, util.Uint64ToBytes(a.next)))
+	slot := binary.BigEndian.Uint64(h.Bytes()[:8]) % remaining
+
+	idx, err := a.slot(slot, getStateFunc)
+	if err != nil {
+		return 0, err
+	}
+
+	last, err := a.slot(remaining-1, getStateFunc)
+	if err != nil {
+		return 0, err
+	}
+
+	a.slots[slot] = last
+	delete(a.slots, remaining-1)
+	a.next++
+
+	return idx, nil
+}
+
+// States returns the pool slots moved by the allocations.
+func (a *mintIndexAllocator) States() []base.StateMergeValue {
+	if !a.mode.IsRandom() {
+		return nil
+	}
+
+	remaining := a.supply - a.next
+
+	var sts []base.StateMergeValue
+	for slot, idx := range a.slots {
+		if slot >= remaining {
+			continue
+		}
+
+		sts = append(sts, cstate.NewStateMergeValue(
+			state.StateKeyMintPool(a.contract, slot), state.NewMintPoolStateValue(idx)))
+	}
+
+	return sts
+}
+
+func (a *mintIndexAllocator) slot(slot uint64, getStateFunc base.GetStateFunc) (uint64, error) {
+	if idx, found := a.slots[slot]; found {
+		return idx, nil
+	}
+
+	switch st, found, err := getStateFunc(state.StateKeyMintPool(a.contract, slot)); {
+	case err != nil:
+		return 0, err
+	case !found:
+		return slot, nil
+	default:
+		return state.StateMintPoolValue(st)
+	}
+}
@@ -0,0 +1,205 @@
+package nft
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+func TestMintExplicitIdx(t *testing.T) {
+	cases := []struct {
+		name     string
+		mode     types.MintMode
+		idx      bool
+		expected string
+	}{
+		{"sequential", types.MintModeSequential, false, ""},
+		{"idx in sequential", types.MintModeSequential, true, "nft idx not allowed"},
+		{"idx in random", types.MintModeRandom, true, "nft idx not allowed"},
+		{"explicit", types.MintModeExplicit, true, ""},
+		{"no idx in explicit", types.MintModeExplicit, false, "nft idx required"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			g := newTestStateGetter()
+
+			sender, priv := g.newAccount(t)
+			receiver, _ := g.newAccount(t)
+			contract := g.newCollection(t, sender, newTestCollectionPolicy().WithMintMode(c.mode, 100))
+
+			item := NewMintItem(
+				contract, receiver, "hash", "https://example.com/1", types.NewSigners(nil), nil, 0, 0, nil, "MCC",
+			)
+			if c.idx {
+				item = item.WithIdx(7)
+			}
+
+			op, err := NewMint(NewMintFact([]byte("token"), sender, []MintItem{item}))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := op.Sign(priv, testNetworkID); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = processTestOperation(t, NewMintProcessor(), op, g.GetStateFunc)
+
+			switch {
+			case c.expected == "" && err != nil:
+				t.Fatalf("mint: %v", err)
+			case c.expected != "" && (err == nil || !strings.Contains(err.Error(), c.expected)):
+				t.Fatalf("expected %q, not %v", c.expected, err)
+			}
+		})
+	}
+}
+
+func TestMintExplicitIdxPreserved(t *testing.T) {
+	g := newTestStateGetter()
+
+	sender, priv := g.newAccount(t)
+	explicit := g.newCollection(t, sender, newTestCollectionPolicy().WithMintMode(types.MintModeExplicit, 10))
+
+	mint := func(idx uint64) ([]base.StateMergeValue, error) {
+		item := NewMintItem(
+			explicit, sender, "hash", "https://example.com/nft", types.NewSigners(nil), nil, 0, 0, nil, "MCC",
+		).WithIdx(idx)
+
+		op, err := NewMint(NewMintFact([]byte("token"), sender, []MintItem{item}))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := op.Sign(priv, testNetworkID); err != nil {
+			t.Fatal(err)
+		}
+
+		sts, err := processTestOperation(t, NewMintProcessor(), op, g.GetStateFunc)
+		g.apply(sts)
+
+		return sts, err
+	}
+
+	t.Run("explicit idx", func(t *testing.T) {
+		sts, err := mint(7)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if n := mintedNFT(t, sts); n.ID() != 7 {
+			t.Fatalf("expected nft idx 7, not %d", n.ID())
+		}
+
+		st, _, err := g.GetStateFunc(state.NFTStateKey(explicit, state.LastIDXKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		switch last, err := state.StateLastNFTIndexValue(st); {
+		case err != nil:
+			t.Fatal(err)
+		case last != 1:
+			t.Fatalf("expected 1 minted nft, not %d", last)
+		}
+	})
+
+	cases := []struct {
+		name     string
+		idx      uint64
+		expected string
+	}{
+		{"minted idx", 7, "already exists"},
+		{"over max supply", 10, "over max supply"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := mint(c.idx)
+			if err == nil || !strings.Contains(err.Error(), c.expected) {
+				t.Fatalf("expected %q, not %v", c.expected, err)
+			}
+		})
+	}
+}
+
+func TestMintRandomIdx(t *testing.T) {
+	const supply = 5
+
+	g := newTestStateGetter()
+
+	sender, priv := g.newAccount(t)
+	receiver, _ := g.newAccount(t)
+	contract := g.newCollection(t, sender, newTestCollectionPolicy().WithMintMode(types.MintModeRandom, supply))
+
+	newOp := func(token string, n int) Mint {
+		items := make([]MintItem, n)
+		for i := range items {
+			items[i] = NewMintItem(
+				contract, receiver, "hash", "https://example.com/1", types.NewSigners(nil), nil, 0, 0, nil, "MCC",
+			)
+		}
+
+		op, err := NewMint(NewMintFact([]byte(token), sender, items))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := op.Sign(priv, testNetworkID); err != nil {
+			t.Fatal(err)
+		}
+
+		return op
+	}
+
+	minted := func(sts []base.StateMergeValue) []uint64 {
+		var idxes []uint64
+		for i := range sts {
+			if v, ok := sts[i].Value().(state.NFTStateValue); ok {
+				idxes = append(idxes, v.NFT.ID())
+			}
+		}
+
+		return idxes
+	}
+
+	first := newOp("first", 3)
+
+	sts, err := processTestOperation(t, NewMintProcessor(), first, g.GetStateFunc)
+	if err != nil {
+		t.Fatalf("mint: %v", err)
+	}
+
+	again, err := processTestOperation(t, NewMintProcessor(), first, g.GetStateFunc)
+	switch {
+	case err != nil:
+		t.Fatalf("mint again: %v", err)
+	case !slices.Equal(minted(sts), minted(again)):
+		t.Fatalf("different idxes of same operation, %v != %v", minted(sts), minted(again))
+	}
+
+	g.apply(sts)
+
+	rest, err := processTestOperation(t, NewMintProcessor(), newOp("rest", 2), g.GetStateFunc)
+	if err != nil {
+		t.Fatalf("mint rest: %v", err)
+	}
+
+	g.apply(rest)
+
+	idxes := append(minted(sts), minted(rest)...)
+	slices.Sort(idxes)
+
+	if !slices.Equal(idxes, []uint64{0, 1, 2, 3, 4}) {
+		t.Fatalf("expected all idxes below max supply once, not %v", idxes)
+	}
+
+	if _, err := processTestOperation(t, NewMintProcessor(), newOp("over", 1), g.GetStateFunc); err == nil || !strings.Contains(err.Error(), "max supply") {
+		t.Fatalf("mint over max supply: %v", err)
+	}
+}
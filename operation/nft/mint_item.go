@@ -20,12 +20,18 @@ var MintItemHint = hint.MustNewHint("mitum-nft-mint-item-v0.0.1")
 
 type MintItem struct {
 	hint.BaseHinter
-	contract base.Address
-	receiver base.Address
-	hash     types.NFTHash
-	uri      types.URI
-	creators types.Signers
-	currency ctypes.CurrencyID
+	contract        base.Address
+	receiver        base.Address
+	hash            types.NFTHash
+	uri             types.URI
+	creators        types.Signers
+	attrs           types.Attributes
+	series          uint64
+	royalty         types.PaymentParameter
+	royaltyReceiver base.Address
+	currency        ctypes.CurrencyID
+	idx             uint64
+	explicit        bool
 }
 
 func NewMintItem(
@@ -34,20 +40,57 @@ func NewMintItem(
 	hash types.NFTHash,
 	uri types.URI,
 	creators types.Signers,
+	attributes types.Attributes,
+	series uint64,
+	royalty types.PaymentParameter,
+	royaltyReceiver base.Address,
 	currency ctypes.CurrencyID,
 ) MintItem {
 	return MintItem{
-		BaseHinter: hint.NewBaseHinter(MintItemHint),
-		contract:   contract,
-		receiver:   receiver,
-		hash:       hash,
-		uri:        uri,
-		creators:   creators,
-		currency:   currency,
+		BaseHinter:      hint.NewBaseHinter(MintItemHint),
+		contract:        contract,
+		receiver:        receiver,
+		hash:            hash,
+		uri:             uri,
+		creators:        creators,
+		attrs:           attributes,
+		series:          series,
+		royalty:         royalty,
+		royaltyReceiver: royaltyReceiver,
+		currency:        currency,
 	}
 }
 
+// WithIdx returns the item carrying the nft idx to mint; it is used by
+// collections in explicit mint mode.
+func (it MintItem) WithIdx(idx uint64) MintItem {
+	it.idx = idx
+	it.explicit = true
+
+	return it
+}
+
 func (it MintItem) Bytes() []byte {
+	var ib []byte
+	if it.explicit {
+		ib = util.Uint64ToBytes(it.idx)
+	}
+
+	var sb []byte
+	if it.series != 0 {
+		sb = util.Uint64ToBytes(it.series)
+	}
+
+	var rb []byte
+	if it.royalty != 0 {
+		rb = it.royalty.Bytes()
+	}
+
+	var rrb []byte
+	if it.royaltyReceiver != nil {
+		rrb = it.royaltyReceiver.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		it.contract.Bytes(),
 		it.receiver.Bytes(),
@@ -55,10 +98,16 @@ func (it MintItem) Bytes() []byte {
 		it.uri.Bytes(),
 		it.creators.Bytes(),
 		it.currency.Bytes(),
+		it.attrs.Bytes(),
+		types.OptionalBytes(sb, rb, rrb, ib),
 	)
 }
 
 func (it MintItem) IsValid([]byte) error {
+	if it.explicit && it.idx >= types.MaxNFTIndex {
+		return common.ErrValOOR.Wrap(errors.Errorf("nft idx %v over max nft index %v", it.idx, types.MaxNFTIndex))
+	}
+
 	if it.receiver.Equal(it.contract) {
 		return common.ErrSelfTarget.Wrap(errors.Errorf("receiver %v is same with contract account", it.receiver))
 	}
@@ -74,6 +123,16 @@ func (it MintItem) IsValid([]byte) error {
 		}
 	}
 
+	if it.royaltyReceiver != nil {
+		if err := it.royaltyReceiver.IsValid(nil); err != nil {
+			return err
+		}
+
+		if it.royaltyReceiver.Equal(it.contract) {
+			return common.ErrSelfTarget.Wrap(errors.Errorf("royalty receiver %v is same with contract account", it.royaltyReceiver))
+		}
+	}
+
 	return util.CheckIsValiders(
 		nil,
 		false,
@@ -83,6 +142,8 @@ func (it MintItem) IsValid([]byte) error {
 		it.hash,
 		it.uri,
 		it.creators,
+		it.attrs,
+		it.royalty,
 		it.currency,
 	)
 }
@@ -107,10 +168,40 @@ func (it MintItem) Creators() types.Signers {
 	return it.creators
 }
 
+func (it MintItem) Attributes() types.Attributes {
+	return it.attrs
+}
+
+// Series returns the id of the series to mint into; zero mints into no
+// series.
+func (it MintItem) Series() uint64 {
+	return it.series
+}
+
+// Royalty returns the royalty of the nft overriding the collection royalty;
+// zero keeps the royalty of the series or collection.
+func (it MintItem) Royalty() types.PaymentParameter {
+	return it.royalty
+}
+
+// RoyaltyReceiver returns the account receiving the royalties of the nft; nil
+// shares them among the creators.
+func (it MintItem) RoyaltyReceiver() base.Address {
+	return it.royaltyReceiver
+}
+
+// Idx returns the explicit nft idx of the item and whether it is given.
+func (it MintItem) Idx() (uint64, bool) {
+	return it.idx, it.explicit
+}
+
 func (it MintItem) Addresses() ([]base.Address, error) {
 	as := []base.Address{}
 	as = append(as, it.receiver)
 	as = append(as, it.creators.Addresses()...)
+	if it.royaltyReceiver != nil {
+		as = append(as, it.royaltyReceiver)
+	}
 
 	return as, nil
 }
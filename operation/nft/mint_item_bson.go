@@ -4,31 +4,57 @@ import (
 	"github.com/imfact-labs/currency-model/common"
 	"github.com/imfact-labs/currency-model/utils/bsonenc"
 	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/nft-model/types"
 	"go.mongodb.org/mongo-driver/v2/bson"
 )
 
 func (it MintItem) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bson.M{
-			"_hint":    it.Hint().String(),
-			"contract": it.contract,
-			"receiver": it.receiver,
-			"hash":     it.hash,
-			"uri":      it.uri,
-			"creators": it.creators,
-			"currency": it.currency,
-		},
-	)
+	m := bson.M{
+		"_hint":    it.Hint().String(),
+		"contract": it.contract,
+		"receiver": it.receiver,
+		"hash":     it.hash,
+		"uri":      it.uri,
+		"creators": it.creators,
+		"currency": it.currency,
+	}
+
+	if len(it.attrs) > 0 {
+		m["attributes"] = it.attrs
+	}
+
+	if it.series != 0 {
+		m["series_id"] = it.series
+	}
+
+	if it.royalty != 0 {
+		m["royalty"] = it.royalty
+	}
+
+	if it.royaltyReceiver != nil {
+		m["royalty_receiver"] = it.royaltyReceiver
+	}
+
+	if it.explicit {
+		m["nft_idx"] = it.idx
+	}
+
+	return bsonenc.Marshal(m)
 }
 
 type MintItemBSONUnmarshaler struct {
-	Hint     string   `bson:"_hint"`
-	Contract string   `bson:"contract"`
-	Receiver string   `bson:"receiver"`
-	Hash     string   `bson:"hash"`
-	Uri      string   `bson:"uri"`
-	Creators bson.Raw `bson:"creators"`
-	Currency string   `bson:"currency"`
+	Hint            string           `bson:"_hint"`
+	Contract        string           `bson:"contract"`
+	Receiver        string           `bson:"receiver"`
+	Hash            string           `bson:"hash"`
+	Uri             string           `bson:"uri"`
+	Creators        bson.Raw         `bson:"creators"`
+	Currency        string           `bson:"currency"`
+	Attrs           types.Attributes `bson:"attributes,omitempty"`
+	Series          uint64           `bson:"series_id,omitempty"`
+	Royalty         uint             `bson:"royalty,omitempty"`
+	RoyaltyReceiver string           `bson:"royalty_receiver,omitempty"`
+	Idx             *uint64          `bson:"nft_idx,omitempty"`
 }
 
 func (it *MintItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -42,7 +68,7 @@ func (it *MintItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return common.DecorateError(err, common.ErrDecodeBson, *it)
 	}
 
-	if err := it.unpack(enc, ht, u.Contract, u.Receiver, u.Hash, u.Uri, u.Creators, u.Currency); err != nil {
+	if err := it.unpack(enc, ht, u.Contract, u.Receiver, u.Hash, u.Uri, u.Creators, u.Attrs, u.Series, u.Royalty, u.RoyaltyReceiver, u.Currency, u.Idx); err != nil {
 		return common.DecorateError(err, common.ErrDecodeBson, *it)
 	}
 	return nil
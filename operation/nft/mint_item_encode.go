@@ -15,11 +15,19 @@ func (it *MintItem) unpack(
 	ht hint.Hint,
 	ca, ra, hs, uri string,
 	bcr []byte,
+	attrs types.Attributes,
+	series uint64,
+	ry uint,
+	rr string,
 	cid string,
+	idx *uint64,
 ) error {
 	it.BaseHinter = hint.NewBaseHinter(ht)
 	it.hash = types.NFTHash(hs)
 	it.uri = types.URI(uri)
+	it.attrs = attrs
+	it.series = series
+	it.royalty = types.PaymentParameter(ry)
 
 	switch a, err := base.DecodeAddress(ca, enc); {
 	case err != nil:
@@ -35,6 +43,13 @@ func (it *MintItem) unpack(
 		it.receiver = a
 	}
 
+	switch a, err := base.DecodeAddress(rr, enc); {
+	case err != nil:
+		return err
+	default:
+		it.royaltyReceiver = a
+	}
+
 	if hinter, err := enc.Decode(bcr); err != nil {
 		return err
 	} else if creators, ok := hinter.(types.Signers); !ok {
@@ -45,5 +60,10 @@ func (it *MintItem) unpack(
 
 	it.currency = ctypes.CurrencyID(cid)
 
+	if idx != nil {
+		it.idx = *idx
+		it.explicit = true
+	}
+
 	return nil
 }
@@ -14,34 +14,54 @@ import (
 
 type MintItemJSONMarshaler struct {
 	hint.BaseHinter
-	Contract base.Address      `json:"contract"`
-	Receiver base.Address      `json:"receiver"`
-	Hash     types.NFTHash     `json:"hash"`
-	Uri      types.URI         `json:"uri"`
-	Creators types.Signers     `json:"creators"`
-	Currency ctypes.CurrencyID `json:"currency"`
+	Contract        base.Address           `json:"contract"`
+	Receiver        base.Address           `json:"receiver"`
+	Hash            types.NFTHash          `json:"hash"`
+	Uri             types.URI              `json:"uri"`
+	Creators        types.Signers          `json:"creators"`
+	Currency        ctypes.CurrencyID      `json:"currency"`
+	Attrs           types.Attributes       `json:"attributes,omitempty"`
+	Series          uint64                 `json:"series_id,omitempty"`
+	Royalty         types.PaymentParameter `json:"royalty,omitempty"`
+	RoyaltyReceiver base.Address           `json:"royalty_receiver,omitempty"`
+	Idx             *uint64                `json:"nft_idx,omitempty"`
 }
 
 func (it MintItem) MarshalJSON() ([]byte, error) {
+	var idx *uint64
+	if it.explicit {
+		idx = &it.idx
+	}
+
 	return util.MarshalJSON(MintItemJSONMarshaler{
-		BaseHinter: it.BaseHinter,
-		Contract:   it.contract,
-		Receiver:   it.receiver,
-		Hash:       it.hash,
-		Uri:        it.uri,
-		Creators:   it.creators,
-		Currency:   it.currency,
+		BaseHinter:      it.BaseHinter,
+		Contract:        it.contract,
+		Receiver:        it.receiver,
+		Hash:            it.hash,
+		Uri:             it.uri,
+		Creators:        it.creators,
+		Currency:        it.currency,
+		Attrs:           it.attrs,
+		Series:          it.series,
+		Royalty:         it.royalty,
+		RoyaltyReceiver: it.royaltyReceiver,
+		Idx:             idx,
 	})
 }
 
 type MintItemJSONUnmarshaler struct {
-	Hint     hint.Hint       `json:"_hint"`
-	Contract string          `json:"contract"`
-	Receiver string          `json:"receiver"`
-	Hash     string          `json:"hash"`
-	Uri      string          `json:"uri"`
-	Creators json.RawMessage `json:"creators"`
-	Currency string          `json:"currency"`
+	Hint            hint.Hint        `json:"_hint"`
+	Contract        string           `json:"contract"`
+	Receiver        string           `json:"receiver"`
+	Hash            string           `json:"hash"`
+	Uri             string           `json:"uri"`
+	Creators        json.RawMessage  `json:"creators"`
+	Currency        string           `json:"currency"`
+	Attrs           types.Attributes `json:"attributes"`
+	Series          uint64           `json:"series_id"`
+	Royalty         uint             `json:"royalty"`
+	RoyaltyReceiver string           `json:"royalty_receiver"`
+	Idx             *uint64          `json:"nft_idx"`
 }
 
 func (it *MintItem) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -50,7 +70,7 @@ func (it *MintItem) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		return common.DecorateError(err, common.ErrDecodeJson, *it)
 	}
 
-	if err := it.unpack(enc, u.Hint, u.Contract, u.Receiver, u.Hash, u.Uri, u.Creators, u.Currency); err != nil {
+	if err := it.unpack(enc, u.Hint, u.Contract, u.Receiver, u.Hash, u.Uri, u.Creators, u.Attrs, u.Series, u.Royalty, u.RoyaltyReceiver, u.Currency, u.Idx); err != nil {
 		return common.DecorateError(err, common.ErrDecodeJson, *it)
 	}
 
@@ -0,0 +1,68 @@
+package nft
+
+import (
+	"testing"
+
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+type testMintItems struct {
+	sender, contract, receiver ctypes.Address
+}
+
+func newTestMintItems(t *testing.T) testMintItems {
+	t.Helper()
+
+	return testMintItems{
+		sender:   newTestAddress(t).(ctypes.Address),
+		contract: newTestAddress(t).(ctypes.Address),
+		receiver: newTestAddress(t).(ctypes.Address),
+	}
+}
+
+func (ti testMintItems) item(series uint64, royalty types.PaymentParameter) MintItem {
+	return NewMintItem(
+		ti.contract, ti.receiver, types.NFTHash("hash"), types.URI("https://example.com/1"),
+		types.NewSigners(nil), nil, series, royalty, nil, ctypes.CurrencyID("MCC"),
+	)
+}
+
+func (ti testMintItems) factHash(items ...MintItem) string {
+	return NewMintFact([]byte("token"), ti.sender, items).Hash().String()
+}
+
+func TestMintItemBytesSeries(t *testing.T) {
+	ti := newTestMintItems(t)
+
+	if ti.factHash(ti.item(0, 0)) == ti.factHash(ti.item(5, 0)) {
+		t.Fatal("same fact hash with and without series")
+	}
+}
+
+func TestMintItemBytesRoyalty(t *testing.T) {
+	ti := newTestMintItems(t)
+
+	if ti.factHash(ti.item(5, 0)) == ti.factHash(ti.item(0, 5)) {
+		t.Fatal("same fact hash of series and royalty")
+	}
+}
+
+func TestMintItemBytesIdx(t *testing.T) {
+	ti := newTestMintItems(t)
+
+	hashes := map[string]string{
+		"series":  ti.factHash(ti.item(5, 0)),
+		"royalty": ti.factHash(ti.item(0, 5)),
+		"idx":     ti.factHash(ti.item(0, 0).WithIdx(5)),
+	}
+
+	founds := map[string]string{}
+	for name, h := range hashes {
+		if other, found := founds[h]; found {
+			t.Fatalf("same fact hash of %s and %s, %v", name, other, h)
+		}
+
+		founds[h] = name
+	}
+}
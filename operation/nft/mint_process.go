@@ -6,7 +6,6 @@ import (
 
 	"github.com/imfact-labs/currency-model/common"
 	cstate "github.com/imfact-labs/currency-model/state"
-	cestate "github.com/imfact-labs/currency-model/state/extension"
 	ctypes "github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
@@ -34,11 +33,12 @@ func (Mint) Process(
 }
 
 type MintItemProcessor struct {
-	h      util.Hash
-	sender base.Address
-	item   MintItem
-	idx    uint64
-	ns     map[string]base.StateMergeValue
+	h       util.Hash
+	sender  base.Address
+	item    MintItem
+	idx     uint64
+	expires base.Height
+	ns      map[string]base.StateMergeValue
 }
 
 func (ipp *MintItemProcessor) PreProcess(
@@ -56,6 +56,10 @@ func (ipp *MintItemProcessor) PreProcess(
 			errors.Errorf("%v: receiver %v is contract account", cErr, ipp.item.Receiver())))
 	}
 
+	if err := checkNotDenied(ipp.item.Contract(), getStateFunc, ipp.item.Receiver()); err != nil {
+		return e.Wrap(err)
+	}
+
 	if found, _ := cstate.CheckNotExistsState(
 		state.StateKeyNFT(ipp.item.Contract(), ipp.idx), getStateFunc); found {
 		return e.Wrap(
@@ -76,6 +80,14 @@ func (ipp *MintItemProcessor) PreProcess(
 		}
 	}
 
+	if acc := ipp.item.RoyaltyReceiver(); acc != nil {
+		if _, _, _, cErr := cstate.ExistsCAccount(
+			acc, "royalty receiver", true, false, getStateFunc); cErr != nil {
+			return e.Wrap(common.ErrCAccountNA.Wrap(
+				errors.Errorf("%v: royalty receiver %v is contract account", cErr, acc)))
+		}
+	}
+
 	return nil
 }
 
@@ -101,10 +113,23 @@ func (ipp *MintItemProcessor) Process(
 		}
 	}
 
+	if acc := ipp.item.RoyaltyReceiver(); acc != nil {
+		smv, err := cstate.CreateNotExistAccount(acc, getStateFunc)
+		if err != nil {
+			return nil, err
+		} else if smv != nil {
+			sts = append(sts, smv)
+		}
+	}
+
 	n := types.NewNFT(
 		ipp.idx, true, ipp.item.Receiver(), ipp.item.NFTHash(),
 		ipp.item.URI(), ipp.item.Receiver(), ipp.item.Creators(),
-	)
+	).
+		WithAttributes(ipp.item.Attributes()).
+		WithExpiry(ipp.expires).
+		WithSeries(ipp.item.Series()).
+		WithRoyalty(ipp.item.Royalty(), ipp.item.RoyaltyReceiver())
 	if err := n.IsValid(nil); err != nil {
 		return nil, errors.Errorf("invalid nft, %v: %v", ipp.idx, err)
 	}
@@ -122,6 +147,7 @@ func (ipp *MintItemProcessor) Close() {
 	ipp.sender = nil
 	ipp.item = MintItem{}
 	ipp.idx = 0
+	ipp.expires = 0
 	//ipp.box = nil
 	ipp.ns = nil
 
@@ -164,40 +190,56 @@ func NewMintProcessor() ctypes.GetNewProcessor {
 func (opp *MintProcessor) PreProcess(
 	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
 ) (context.Context, base.OperationProcessReasonError, error) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
 	fact, ok := op.Fact().(MintFact)
 	if !ok {
-		return ctx, base.NewBaseOperationProcessReasonError(
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
 			common.ErrMPreProcess.
 				Wrap(common.ErrMTypeMismatch).
 				Errorf("expected %T, not %T", MintFact{}, op.Fact())), nil
 	}
 
 	if err := fact.IsValid(nil); err != nil {
-		return ctx, base.NewBaseOperationProcessReasonError(
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
 			common.ErrMPreProcess.
 				Errorf("%v", err)), nil
 	}
 
-	idxes := map[string]uint64{}
+	params, rErr := currentParams(getStateFunc)
+	if rErr != nil {
+		return ctx, rErr, nil
+	}
+
+	if err := params.CheckMintItems(len(fact.Items())); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValOOR).
+				Errorf("%v", err)), nil
+	}
+
+	allocators := map[string]*mintIndexAllocator{}
+	policies := map[string]types.CollectionPolicy{}
+	minters := map[string]bool{}
+	series := map[string]types.Series{}
 	for _, item := range fact.Items() {
-		if _, found := idxes[item.contract.String()]; !found {
+		if _, found := allocators[item.contract.String()]; !found {
 			st, err := cstate.ExistsState(
 				state.NFTStateKey(item.contract, state.CollectionKey), "design", getStateFunc)
 			if err != nil {
-				return nil, base.NewBaseOperationProcessReasonError(
+				return nil, base.NewBaseOperationProcessReasonError("%s",
 					common.ErrMPreProcess.
 						Wrap(common.ErrMServiceNF).Errorf("nft service state for contract account, %v: %v", item.Contract(), err)), nil
 			}
 
-			design, err := state.StateCollectionValue(st)
+			design, err := state.EffectiveCollectionValue(st, opp.Height(), getStateFunc)
 			if err != nil {
-				return nil, base.NewBaseOperationProcessReasonError(
+				return nil, base.NewBaseOperationProcessReasonError("%s",
 					common.ErrMPreProcess.
 						Wrap(common.ErrMServiceNF).Errorf("nft service state for contract account, %v: %v", item.Contract(), err)), nil
 			}
 
 			if !design.Active() {
-				return nil, base.NewBaseOperationProcessReasonError(
+				return nil, base.NewBaseOperationProcessReasonError("%s",
 					common.ErrMPreProcess.
 						Wrap(common.ErrMServiceNF).Errorf(
 						"nft service in contract account %v has already been deactivated", item.Contract())), nil
@@ -206,61 +248,150 @@ func (opp *MintProcessor) PreProcess(
 
 			policy, ok := design.Policy().(types.CollectionPolicy)
 			if !ok {
-				return nil, base.NewBaseOperationProcessReasonError(
+				return nil, base.NewBaseOperationProcessReasonError("%s",
 					common.ErrMPreProcess.
 						Wrap(common.ErrMTypeMismatch).
 						Errorf("expected %T, not %T", types.CollectionPolicy{}, design.Policy())), nil
 			}
 
 			whitelist := policy.Whitelist()
-			_, cSt, aErr, cErr := cstate.ExistsCAccount(
-				item.Contract(), "contract", true, true, getStateFunc)
-			if aErr != nil {
-				return ctx, base.NewBaseOperationProcessReasonError(
-					common.ErrMPreProcess.
-						Errorf("%v", aErr)), nil
-			} else if cErr != nil {
-				return ctx, base.NewBaseOperationProcessReasonError(
-					common.ErrMPreProcess.
-						Errorf("%v", cErr)), nil
-			}
-
-			ca, err := cestate.LoadCAStateValue(cSt)
+			// a collection without whitelist is open to every sender; the
+			// series minters are checked with each item.
+			owner, err := isCollectionOwner(item.Contract(), fact.Sender(), getStateFunc)
 			if err != nil {
-				return ctx, base.NewBaseOperationProcessReasonError(
-					common.ErrMPreProcess.
-						Errorf("%v", err)), nil
+				return nil, base.NewBaseOperationProcessReasonError("%s",
+					common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).Errorf("%v", err)), nil
 			}
-			if !ca.Owner().Equal(fact.Sender()) {
-				for i := range whitelist {
-					if whitelist[i].Equal(fact.Sender()) {
-						break
-					}
-					if i == len(whitelist)-1 {
-						return ctx, base.NewBaseOperationProcessReasonError(
-							common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
-								Errorf(
-									"sender %v is neither the owner nor in the minter whitelist of contract account %v",
-									fact.Sender(), item.Contract())), nil
-					}
+
+			minter := owner || len(whitelist) < 1
+			for i := range whitelist {
+				if whitelist[i].Equal(fact.Sender()) {
+					minter = true
+
+					break
 				}
 			}
+			minters[item.contract.String()] = minter
 
 			st, err = cstate.ExistsState(state.NFTStateKey(item.contract, state.LastIDXKey), "collection index", getStateFunc)
 			if err != nil {
-				return nil, base.NewBaseOperationProcessReasonError(
+				return nil, base.NewBaseOperationProcessReasonError("%s",
 					common.ErrMPreProcess.
 						Wrap(common.ErrMStateNF).Errorf("collection last index, %v: %v", item.contract, err)), nil
 			}
 
 			nftID, err := state.StateLastNFTIndexValue(st)
 			if err != nil {
-				return nil, base.NewBaseOperationProcessReasonError(
+				return nil, base.NewBaseOperationProcessReasonError("%s",
 					common.ErrMPreProcess.
 						Wrap(common.ErrMStateInvalid).Errorf("collection last index, %v: %v", item.contract, err)), nil
 			}
 
-			idxes[item.contract.String()] = nftID
+			allocators[item.contract.String()] = newMintIndexAllocator(
+				item.contract, policy, nftID, op.Hash(), opp.Height())
+			policies[item.contract.String()] = policy
+		}
+
+		if item.Series() > 0 {
+			sk := state.StateKeySeries(item.Contract(), item.Series())
+			if _, found := series[sk]; !found {
+				st, err := cstate.ExistsState(sk, "series", getStateFunc)
+				if err != nil {
+					return nil, base.NewBaseOperationProcessReasonError("%s",
+						common.ErrMPreProcess.
+							Wrap(common.ErrMStateNF).Errorf(
+							"series %v in contract account %v", item.Series(), item.Contract())), nil
+				}
+
+				s, err := state.StateSeriesValue(st)
+				if err != nil {
+					return nil, base.NewBaseOperationProcessReasonError("%s",
+						common.ErrMPreProcess.
+							Wrap(common.ErrMStateValInvalid).Errorf(
+							"series %v in contract account %v", item.Series(), item.Contract())), nil
+				}
+				series[sk] = *s
+			}
+
+			s := series[sk]
+			if !minters[item.contract.String()] && !s.IsMinter(fact.Sender()) {
+				return ctx, base.NewBaseOperationProcessReasonError("%s",
+					common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+						Errorf(
+							"sender %v is neither the owner nor a minter of series %v of contract account %v",
+							fact.Sender(), item.Series(), item.Contract())), nil
+			}
+
+			if s.MaxSupply() > 0 && s.Count() >= s.MaxSupply() {
+				return nil, base.NewBaseOperationProcessReasonError("%s",
+					common.ErrMPreProcess.
+						Wrap(common.ErrMValOOR).Errorf(
+						"series %v of contract account %v reached max supply %v",
+						item.Series(), item.Contract(), s.MaxSupply())), nil
+			}
+			series[sk] = s.WithCount(s.Count() + 1)
+		} else if !minters[item.contract.String()] {
+			return ctx, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+					Errorf(
+						"sender %v is neither the owner nor in the minter whitelist of contract account %v",
+						fact.Sender(), item.Contract())), nil
+		}
+
+		if item.URI() == "" && policies[item.contract.String()].BaseURI() == "" {
+			return nil, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.
+					Wrap(common.ErrMValueInvalid).Errorf(
+					"empty uri without base uri in contract account %v", item.Contract())), nil
+		}
+
+		if err := checkURIRule(params, policies[item.contract.String()].URIRule(), item.URI()); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.
+					Wrap(common.ErrMValueInvalid).Errorf(
+					"nft uri for contract account %v: %v", item.Contract(), err)), nil
+		}
+
+		if err := checkOnChainURIs(item.Contract(), getStateFunc, item.URI()); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.
+					Wrap(common.ErrMValueInvalid).Errorf(
+					"nft uri for contract account %v: %v", item.Contract(), err)), nil
+		}
+
+		if err := params.CheckURIs(item.URI()); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.
+					Wrap(common.ErrMValueInvalid).Errorf(
+					"nft uri for contract account %v: %v", item.Contract(), err)), nil
+		}
+
+		if err := params.CheckNFTHash(item.NFTHash()); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.
+					Wrap(common.ErrMValueInvalid).Errorf(
+					"nft hash for contract account %v: %v", item.Contract(), err)), nil
+		}
+
+		if err := params.CheckSigners(item.Creators()); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.
+					Wrap(common.ErrMValOOR).Errorf(
+					"creators for contract account %v: %v", item.Contract(), err)), nil
+		}
+
+		if err := policies[item.contract.String()].IsValidNFTHash(item.NFTHash()); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.
+					Wrap(common.ErrMValueInvalid).Errorf(
+					"nft hash for contract account %v: %v", item.Contract(), err)), nil
+		}
+
+		if mr := policies[item.contract.String()].MaxRoyalty(); item.Royalty() > mr {
+			return nil, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.
+					Wrap(common.ErrMValOOR).Errorf(
+					"royalty %v over max royalty %v of contract account %v", item.Royalty(), mr, item.Contract())), nil
 		}
 	}
 
@@ -268,22 +399,27 @@ func (opp *MintProcessor) PreProcess(
 		ip := mintItemProcessorPool.Get()
 		ipc, ok := ip.(*MintItemProcessor)
 		if !ok {
-			return nil, base.NewBaseOperationProcessReasonError(
+			return nil, base.NewBaseOperationProcessReasonError("%s",
 				common.ErrMTypeMismatch.Errorf("expected MintItemProcessor, not %T", ip)), nil
 		}
 
 		ipc.h = op.Hash()
 		ipc.sender = fact.Sender()
 		ipc.item = item
-		ipc.idx = idxes[item.contract.String()]
+		idx, err := allocators[item.contract.String()].Allocate(item, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.
+					Wrap(common.ErrMValueInvalid).Errorf("%v", err)), nil
+		}
+		ipc.idx = idx
 		//ipc.box = nil
 
 		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
-			return nil, base.NewBaseOperationProcessReasonError(
+			return nil, base.NewBaseOperationProcessReasonError("%s",
 				common.ErrMPreProcess.Errorf("%v", err),
 			), nil
 		}
-		idxes[item.contract.String()] += 1
 
 		ipc.Close()
 	}
@@ -295,16 +431,46 @@ func (opp *MintProcessor) Process( // nolint:dupl
 	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
 	[]base.StateMergeValue, base.OperationProcessReasonError, error,
 ) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
 	e := util.StringError("failed to process Mint")
 
 	fact, _ := op.Fact().(MintFact)
-	idxes := map[string]uint64{}
+	allocators := map[string]*mintIndexAllocator{}
 	designs := map[string]types.Design{}
+	series := map[string]types.Series{}
 
 	for _, item := range fact.items {
-		idxKey := state.NFTStateKey(item.contract, state.LastIDXKey)
-		if _, found := idxes[idxKey]; !found {
-			st, err := cstate.ExistsState(idxKey, "collection index", getStateFunc)
+		if item.Series() > 0 {
+			sk := state.StateKeySeries(item.contract, item.Series())
+			s, found := series[sk]
+			if !found {
+				st, err := cstate.ExistsState(sk, "series", getStateFunc)
+				if err != nil {
+					return nil, base.NewBaseOperationProcessReasonError("series state not found, %v: %w", item.Series(), err), nil
+				}
+
+				v, err := state.StateSeriesValue(st)
+				if err != nil {
+					return nil, base.NewBaseOperationProcessReasonError("series state value not found, %v: %w", item.Series(), err), nil
+				}
+				s = *v
+			}
+			series[sk] = s.WithCount(s.Count() + 1)
+		}
+
+		if d, found := designs[item.contract.String()]; !found {
+			st, _ := cstate.ExistsState(state.NFTStateKey(item.contract, state.CollectionKey), "design", getStateFunc)
+			design, _ := state.EffectiveCollectionValue(st, opp.Height(), getStateFunc)
+			de := types.NewDesign(
+				design.Contract(), design.Creator(), design.Active(), design.Paused(), design.Count()+1, design.Policy(),
+			)
+			designs[item.contract.String()] = de
+
+			policy, _ := design.Policy().(types.CollectionPolicy)
+
+			st, err := cstate.ExistsState(
+				state.NFTStateKey(item.contract, state.LastIDXKey), "collection index", getStateFunc)
 			if err != nil {
 				return nil, base.NewBaseOperationProcessReasonError("collection last index state not found, %v: %w", item.contract, err), nil
 			}
@@ -314,16 +480,10 @@ func (opp *MintProcessor) Process( // nolint:dupl
 				return nil, base.NewBaseOperationProcessReasonError("collection last index value not found, %v: %w", item.contract, err), nil
 			}
 
-			idxes[idxKey] = nftID
-		}
-
-		if d, found := designs[item.contract.String()]; !found {
-			st, _ := cstate.ExistsState(state.NFTStateKey(item.contract, state.CollectionKey), "design", getStateFunc)
-			design, _ := state.StateCollectionValue(st)
-			de := types.NewDesign(design.Contract(), design.Creator(), design.Active(), design.Count()+1, design.Policy())
-			designs[item.contract.String()] = de
+			allocators[item.contract.String()] = newMintIndexAllocator(
+				item.contract, policy, nftID, op.Hash(), opp.Height())
 		} else {
-			de := types.NewDesign(d.Contract(), d.Creator(), d.Active(), d.Count()+1, d.Policy())
+			de := types.NewDesign(d.Contract(), d.Creator(), d.Active(), d.Paused(), d.Count()+1, d.Policy())
 			designs[item.contract.String()] = de
 		}
 	}
@@ -333,7 +493,6 @@ func (opp *MintProcessor) Process( // nolint:dupl
 
 	ipcs := make([]*MintItemProcessor, len(fact.Items()))
 	for i, item := range fact.Items() {
-		idxKey := state.NFTStateKey(item.contract, state.LastIDXKey)
 		ip := mintItemProcessorPool.Get()
 		ipc, ok := ip.(*MintItemProcessor)
 		if !ok {
@@ -343,16 +502,25 @@ func (opp *MintProcessor) Process( // nolint:dupl
 		ipc.h = op.Hash()
 		ipc.sender = fact.Sender()
 		ipc.item = item
-		ipc.idx = idxes[idxKey]
+		idx, err := allocators[item.contract.String()].Allocate(item, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to assign nft idx; %w", err), nil
+		}
+		ipc.idx = idx
 		ipc.ns = nsts
 
+		// the first membership period is included in the mint.
+		policy, _ := designs[item.contract.String()].Policy().(types.CollectionPolicy)
+		if rule := policy.MembershipRule(); rule.IsMembership() {
+			ipc.expires = rule.Extend(0, opp.Height(), 1)
+		}
+
 		s, err := ipc.Process(ctx, op, getStateFunc)
 		if err != nil {
 			return nil, base.NewBaseOperationProcessReasonError("failed to process MintItem; %w", err), nil
 		}
 		sts = append(sts, s...)
 
-		idxes[idxKey] += 1
 		ipcs[i] = ipc
 	}
 	for _, design := range designs {
@@ -364,9 +532,19 @@ func (opp *MintProcessor) Process( // nolint:dupl
 		)
 	}
 
-	for key, idx := range idxes {
-		iv := cstate.NewStateMergeValue(key, state.NewLastNFTIndexStateValue(idx))
+	for sk, s := range series {
+		if err := s.IsValid(nil); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("invalid series, %v: %w", s.ID(), err), nil
+		}
+
+		sts = append(sts, cstate.NewStateMergeValue(sk, state.NewSeriesStateValue(s)))
+	}
+
+	for _, alloc := range allocators {
+		iv := cstate.NewStateMergeValue(
+			state.NFTStateKey(alloc.contract, state.LastIDXKey), state.NewLastNFTIndexStateValue(alloc.Next()))
 		sts = append(sts, iv)
+		sts = append(sts, alloc.States()...)
 	}
 
 	for _, ns := range nsts {
@@ -377,7 +555,7 @@ func (opp *MintProcessor) Process( // nolint:dupl
 		ipc.Close()
 	}
 
-	idxes = nil
+	allocators = nil
 
 	return sts, nil, nil
 }
@@ -0,0 +1,80 @@
+package nft
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+func TestMintBaseURI(t *testing.T) {
+	cases := []struct {
+		name     string
+		policy   types.CollectionPolicy
+		uri      types.URI
+		expected types.URI
+		err      string
+	}{
+		{"base uri", newTestCollectionPolicy().WithBaseURI("https://example.com/", ".json"), "", "https://example.com/0.json", ""},
+		{"uri over base uri", newTestCollectionPolicy().WithBaseURI("https://example.com/", ".json"), "https://example.com/a", "https://example.com/a", ""},
+		{"no base uri", newTestCollectionPolicy(), "", "", "empty uri without base uri"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			g := newTestStateGetter()
+
+			sender, priv := g.newAccount(t)
+			contract := g.newCollection(t, sender, c.policy)
+
+			op, err := NewMint(NewMintFact([]byte("token"), sender, []MintItem{NewMintItem(
+				contract, sender, "hash", c.uri, types.NewSigners(nil), nil, 0, 0, nil, "MCC",
+			)}))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := op.Sign(priv, testNetworkID); err != nil {
+				t.Fatal(err)
+			}
+
+			sts, err := processTestOperation(t, NewMintProcessor(), op, g.GetStateFunc)
+
+			switch {
+			case c.err != "":
+				if err == nil || !strings.Contains(err.Error(), c.err) {
+					t.Fatalf("expected %q, not %v", c.err, err)
+				}
+
+				return
+			case err != nil:
+				t.Fatalf("mint: %v", err)
+			}
+
+			n := mintedNFT(t, sts)
+			if n.URI() != c.uri {
+				t.Fatalf("expected stored uri %q, not %q", c.uri, n.URI())
+			}
+
+			if uri := c.policy.TokenURI(n.ID(), n.URI()); uri != c.expected {
+				t.Fatalf("expected token uri %q, not %q", c.expected, uri)
+			}
+		})
+	}
+}
+
+func mintedNFT(t *testing.T, sts []base.StateMergeValue) types.NFT {
+	t.Helper()
+
+	for i := range sts {
+		if v, ok := sts[i].Value().(state.NFTStateValue); ok {
+			return v.NFT
+		}
+	}
+
+	t.Fatal("no minted nft")
+
+	return types.NFT{}
+}
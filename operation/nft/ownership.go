@@ -0,0 +1,93 @@
+package nft
+
+import (
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/extension"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+// collectionOwner returns the owner of the collection of the contract. The
+// collection is owned by the owner of the contract account until its ownership
+// is transferred; the ownership state keeps the owner after that.
+func collectionOwner(contract base.Address, getStateFunc base.GetStateFunc) (base.Address, error) {
+	st, found, err := getStateFunc(state.StateKeyOwnership(contract))
+	switch {
+	case err != nil:
+		return nil, err
+	case found:
+		ownership, err := state.StateOwnershipValue(st)
+		if err != nil {
+			return nil, err
+		}
+
+		if ownership.Accepted() {
+			return ownership.To(), nil
+		}
+
+		return ownership.From(), nil
+	}
+
+	st, err = cstate.ExistsState(extension.StateKeyContractAccount(contract), "contract account", getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := extension.StateContractAccountValue(st)
+	if err != nil {
+		return nil, err
+	}
+
+	return status.Owner(), nil
+}
+
+// isCollectionOwner reports whether a is the owner of the collection of the
+// contract.
+func isCollectionOwner(contract, a base.Address, getStateFunc base.GetStateFunc) (bool, error) {
+	owner, err := collectionOwner(contract, getStateFunc)
+	if err != nil {
+		return false, errors.Errorf("collection owner of contract account %v: %v", contract, err)
+	}
+
+	return owner.Equal(a), nil
+}
+
+// checkCollectionOwner checks the sender is the owner of the collection.
+func checkCollectionOwner(design types.Design, sender base.Address, getStateFunc base.GetStateFunc) error {
+	switch ok, err := isCollectionOwner(design.Contract(), sender, getStateFunc); {
+	case err != nil:
+		return err
+	case !ok:
+		return errors.Errorf("sender %v is not the collection owner of contract account %v",
+			sender, design.Contract())
+	}
+
+	return nil
+}
+
+// pendingOwnership returns the pending ownership transfer of the collection,
+// or nil when nothing is pending.
+func pendingOwnership(
+	contract base.Address, getStateFunc base.GetStateFunc,
+) (*state.OwnershipStateValue, error) {
+	st, found, err := getStateFunc(state.StateKeyOwnership(contract))
+	switch {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, nil
+	}
+
+	ownership, err := state.StateOwnershipValue(st)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ownership.IsPending() {
+		return nil, nil
+	}
+
+	return ownership, nil
+}
@@ -0,0 +1,127 @@
+package nft
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/imfact-labs/currency-model/state/extension"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/nft-model/state"
+)
+
+func TestCollectionOwnership(t *testing.T) {
+	g := newTestStateGetter()
+
+	creator, creatorPriv := g.newAccount(t)
+	owner, ownerPriv := g.newAccount(t)
+	newOwner, newOwnerPriv := g.newAccount(t)
+	contract := g.newCollection(t, creator, newTestCollectionPolicy())
+
+	// the contract account changes its owner after the registration.
+	status := ctypes.NewContractAccountStatus(owner, nil)
+	status.SetActive(true)
+	g.set(extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(status))
+
+	transfer := func(sender base.Address, priv base.Privatekey) error {
+		op, err := NewTransferCollectionOwnership(NewTransferCollectionOwnershipFact(
+			[]byte("token"), sender, contract, newOwner, ctypes.CurrencyID("MCC")))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := op.Sign(priv, testNetworkID); err != nil {
+			t.Fatal(err)
+		}
+
+		sts, err := processTestOperation(t, NewTransferCollectionOwnershipProcessor(), op, g.GetStateFunc)
+		g.apply(sts)
+
+		return err
+	}
+
+	accept := func(sender base.Address, priv base.Privatekey) error {
+		op, err := NewAcceptCollectionOwnership(NewAcceptCollectionOwnershipFact(
+			[]byte("token"), sender, contract, ctypes.CurrencyID("MCC")))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := op.Sign(priv, testNetworkID); err != nil {
+			t.Fatal(err)
+		}
+
+		sts, err := processTestOperation(t, NewAcceptCollectionOwnershipProcessor(), op, g.GetStateFunc)
+		g.apply(sts)
+
+		return err
+	}
+
+	expectError := func(t *testing.T, err error, expected string) {
+		t.Helper()
+
+		switch {
+		case err == nil:
+			t.Fatalf("expected %q", expected)
+		case !strings.Contains(err.Error(), expected):
+			t.Fatalf("expected %q, not %v", expected, err)
+		}
+	}
+
+	checkOwner := func(t *testing.T, expected base.Address) {
+		t.Helper()
+
+		a, err := collectionOwner(contract, g.GetStateFunc)
+		switch {
+		case err != nil:
+			t.Fatal(err)
+		case !a.Equal(expected):
+			t.Fatalf("collection owner %v, expected %v", a, expected)
+		}
+	}
+
+	t.Run("contract account owner", func(t *testing.T) {
+		checkOwner(t, owner)
+
+		expectError(t, transfer(creator, creatorPriv), "is not the collection owner")
+	})
+
+	t.Run("transfer", func(t *testing.T) {
+		if err := transfer(owner, ownerPriv); err != nil {
+			t.Fatal(err)
+		}
+
+		checkOwner(t, owner)
+	})
+
+	t.Run("accept by other", func(t *testing.T) {
+		expectError(t, accept(owner, ownerPriv), "is not the new owner")
+	})
+
+	t.Run("accept", func(t *testing.T) {
+		if err := accept(newOwner, newOwnerPriv); err != nil {
+			t.Fatal(err)
+		}
+
+		checkOwner(t, newOwner)
+
+		st, _, err := g.GetStateFunc(state.StateKeyOwnership(contract))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ownership, err := state.StateOwnershipValue(st)
+		switch {
+		case err != nil:
+			t.Fatal(err)
+		case !ownership.From().Equal(owner):
+			t.Fatalf("ownership from %v, expected %v", ownership.From(), owner)
+		}
+
+		expectError(t, accept(newOwner, newOwnerPriv), "pending ownership transfer")
+	})
+
+	t.Run("contract account owner after transfer", func(t *testing.T) {
+		expectError(t, transfer(owner, ownerPriv), "is not the collection owner")
+	})
+}
@@ -0,0 +1,35 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+// currentParams loads the nft params the processors check the facts against.
+func currentParams(getStateFunc base.GetStateFunc) (types.Params, base.OperationProcessReasonError) {
+	params, err := state.CurrentParams(getStateFunc)
+	if err != nil {
+		return types.Params{}, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).Errorf("nft params: %v", err))
+	}
+
+	return params, nil
+}
+
+// checkCollectionParams checks the whitelist and uris of a collection config
+// which is not built into a policy yet.
+func checkCollectionParams(
+	params types.Params, whitelist []base.Address, baseURI, suffix types.URI, uris ...types.URI,
+) error {
+	if err := params.CheckWhitelist(len(whitelist)); err != nil {
+		return err
+	}
+
+	if err := params.CheckURIs(append(uris, baseURI, suffix)...); err != nil {
+		return err
+	}
+
+	return params.CheckBaseURI(baseURI, suffix)
+}
@@ -0,0 +1,115 @@
+package nft
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+// setParams sets the nft params of the network.
+func (g *testStateGetter) setParams(params types.Params) {
+	g.set(state.StateKeyParams, state.NewParamsStateValue(params))
+}
+
+func TestApproveParams(t *testing.T) {
+	g := newTestStateGetter()
+
+	sender, priv := g.newAccount(t)
+	approved, _ := g.newAccount(t)
+	contract := g.newCollection(t, sender, newTestCollectionPolicy())
+
+	items := make([]ApproveItem, 2)
+	for i := range items {
+		g.setNFT(contract, types.NewNFT(
+			uint64(i), true, sender, "hash", "https://example.com/1", sender, types.NewSigners(nil)))
+
+		items[i] = NewApproveItem(contract, approved, uint64(i), "MCC")
+	}
+
+	op, err := NewApprove(NewApproveFact([]byte("token"), sender, items))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := op.Sign(priv, testNetworkID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := processTestOperation(t, NewApproveProcessor(), op, g.GetStateFunc); err != nil {
+		t.Fatalf("approve items within default params: %v", err)
+	}
+
+	g.setParams(types.NewParams(100, 100, 20, 10, 10, 1000, 1024, 1, 100))
+
+	if _, err := processTestOperation(t, NewApproveProcessor(), op, g.GetStateFunc); err == nil || !strings.Contains(err.Error(), "items over allowed") {
+		t.Fatalf("approve items over max approve items of params: %v", err)
+	}
+}
+
+func TestAddSignatureParams(t *testing.T) {
+	g := newTestStateGetter()
+
+	sender, priv := g.newAccount(t)
+	other, _ := g.newAccount(t)
+	contract := g.newCollection(t, sender, newTestCollectionPolicy())
+
+	g.setNFT(contract, types.NewNFT(
+		0, true, sender, "hash", "https://example.com/1", sender,
+		types.NewSigners([]types.Signer{types.NewSigner(sender, 50, false), types.NewSigner(other, 50, false)}),
+	))
+
+	op, err := NewAddSignature(NewAddSignatureFact(
+		[]byte("token"), sender, []AddSignatureItem{NewAddSignatureItem(contract, 0, "MCC")}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := op.Sign(priv, testNetworkID); err != nil {
+		t.Fatal(err)
+	}
+
+	g.setParams(types.NewParams(100, 100, 20, 10, 1, 1000, 1024, 100, 100))
+
+	if _, err := processTestOperation(t, NewSignProcessor(), op, g.GetStateFunc); err == nil || !strings.Contains(err.Error(), "signers over allowed") {
+		t.Fatalf("add signature to nft of creators over max signers of params: %v", err)
+	}
+
+	g.setParams(types.NewParams(100, 100, 20, 10, 10, 1000, 1024, 100, 100))
+
+	if _, err := processTestOperation(t, NewSignProcessor(), op, g.GetStateFunc); err != nil {
+		t.Fatalf("add signature within params: %v", err)
+	}
+}
+
+func TestUpdateAttributesParams(t *testing.T) {
+	g := newTestStateGetter()
+
+	sender, priv := g.newAccount(t)
+	contract := g.newCollection(t, sender, newTestCollectionPolicy())
+
+	uri := types.URI("https://example.com/" + strings.Repeat("a", 100))
+	g.setNFT(contract, types.NewNFT(0, true, sender, "hash", uri, sender, types.NewSigners(nil)))
+
+	op, err := NewUpdateAttributes(NewUpdateAttributesFact(
+		[]byte("token"), sender, contract, 0,
+		types.Attributes{types.NewStringAttribute("color", "red")}, nil, "MCC"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := op.Sign(priv, testNetworkID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := processTestOperation(t, NewUpdateAttributesProcessor(), op, g.GetStateFunc); err != nil {
+		t.Fatalf("update attributes within default params: %v", err)
+	}
+
+	g.setParams(types.NewParams(100, 100, 20, 10, 10, uint64(len(uri)-1), 1024, 100, 100))
+
+	if _, err := processTestOperation(t, NewUpdateAttributesProcessor(), op, g.GetStateFunc); err == nil || !strings.Contains(err.Error(), "uri length over max") {
+		t.Fatalf("update attributes of nft with uri over max uri length of params: %v", err)
+	}
+}
@@ -0,0 +1,190 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	PauseFactHint = hint.MustNewHint("mitum-nft-pause-operation-fact-v0.0.1")
+	PauseHint     = hint.MustNewHint("mitum-nft-pause-operation-v0.0.1")
+)
+
+var (
+	PauseModePause   = PauseMode("pause")
+	PauseModeUnpause = PauseMode("unpause")
+)
+
+type PauseMode string
+
+func (mode PauseMode) IsValid([]byte) error {
+	if !(mode == PauseModePause || mode == PauseModeUnpause) {
+		return common.ErrValueInvalid.Wrap(errors.Errorf("wrong pause mode, %v", mode))
+	}
+
+	return nil
+}
+
+func (mode PauseMode) Bytes() []byte {
+	return []byte(mode)
+}
+
+func (mode PauseMode) String() string {
+	return string(mode)
+}
+
+func (mode PauseMode) Equal(cmode PauseMode) bool {
+	return string(mode) == string(cmode)
+}
+
+type PauseFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	mode     PauseMode
+	currency ctypes.CurrencyID
+}
+
+func NewPauseFact(
+	token []byte,
+	sender, contract base.Address,
+	mode PauseMode,
+	currency ctypes.CurrencyID,
+) PauseFact {
+	bf := base.NewBaseFact(PauseFactHint, token)
+
+	fact := PauseFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		mode:     mode,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact PauseFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.mode,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact PauseFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact PauseFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact PauseFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.mode.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact PauseFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact PauseFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact PauseFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact PauseFact) Mode() PauseMode {
+	return fact.mode
+}
+
+func (fact PauseFact) Currency() ctypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact PauseFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+func (fact PauseFact) FeeBase() map[ctypes.CurrencyID][]common.Big {
+	required := make(map[ctypes.CurrencyID][]common.Big)
+	required[fact.Currency()] = []common.Big{common.ZeroBig}
+
+	return required
+}
+
+func (fact PauseFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact PauseFact) FeeItemCount() (uint, bool) {
+	return extras.ZeroItem, extras.HasNoItem
+}
+
+func (fact PauseFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact PauseFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact PauseFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact PauseFact) DupKey() (map[ctypes.DuplicationKeyType][]string, error) {
+	r := make(map[ctypes.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fact.sender.String()}
+	r[extras.DuplicationKeyTypeContractStatus] = []string{fact.contract.String()}
+
+	return r, nil
+}
+
+// Pause stops or resumes every nft transfer of a collection. Minting, signing
+// and operator delegation are not affected.
+type Pause struct {
+	extras.ExtendedOperation
+}
+
+func NewPause(fact PauseFact) (Pause, error) {
+	return Pause{
+		ExtendedOperation: extras.NewExtendedOperation(PauseHint, fact),
+	}, nil
+}
@@ -0,0 +1,29 @@
+package nft
+
+import (
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+func (fact *PauseFact) unpack(
+	enc encoder.Encoder,
+	sd, ct, md, cid string,
+) error {
+	fact.currency = ctypes.CurrencyID(cid)
+	fact.mode = PauseMode(md)
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	contract, err := base.DecodeAddress(ct, enc)
+	if err != nil {
+		return err
+	}
+	fact.contract = contract
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type PauseFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address      `json:"sender"`
+	Contract base.Address      `json:"contract"`
+	Mode     PauseMode         `json:"mode"`
+	Currency ctypes.CurrencyID `json:"currency"`
+}
+
+func (fact PauseFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(PauseFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		Mode:                  fact.mode,
+		Currency:              fact.currency,
+	})
+}
+
+type PauseFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string `json:"sender"`
+	Contract string `json:"contract"`
+	Mode     string `json:"mode"`
+	Currency string `json:"currency"`
+}
+
+func (fact *PauseFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u PauseFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.Mode, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op Pause) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationMarshaler{
+		BaseOperationJSONMarshaler:           op.BaseOperation.JSONMarshaler(),
+		BaseOperationExtensionsJSONMarshaler: op.BaseOperationExtensions.JSONMarshaler(),
+	})
+}
+
+func (op *Pause) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	var ueo extras.BaseOperationExtensions
+	if err := ueo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperationExtensions = &ueo
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package nft
+
+import (
+	cstate "github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/nft-model/state"
+)
+
+// clearPendingPolicy returns the state clearing the scheduled policy of the
+// contract, or nil when nothing is scheduled. It is written whenever the
+// policy is replaced, so a scheduled policy taking effect later does not
+// override the replacement.
+func clearPendingPolicy(contract base.Address, getStateFunc base.GetStateFunc) (base.StateMergeValue, error) {
+	st, found, err := getStateFunc(state.StateKeyPendingPolicy(contract))
+	switch {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, nil
+	}
+
+	pending, err := state.StatePendingPolicyValue(st)
+	if err != nil {
+		return nil, err
+	}
+
+	if !pending.IsScheduled() {
+		return nil, nil
+	}
+
+	return cstate.NewStateMergeValue(
+		state.StateKeyPendingPolicy(contract), state.NewClearedPendingPolicyStateValue()), nil
+}
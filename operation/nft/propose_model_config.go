@@ -0,0 +1,211 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	ProposeModelConfigFactHint = hint.MustNewHint("mitum-nft-propose-model-config-operation-fact-v0.0.1")
+	ProposeModelConfigHint     = hint.MustNewHint("mitum-nft-propose-model-config-operation-v0.0.1")
+)
+
+type ProposeModelConfigFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	proposalID uint64
+	policy     types.CollectionPolicy
+	expiresAt  base.Height
+	currency   ctypes.CurrencyID
+}
+
+func NewProposeModelConfigFact(
+	token []byte,
+	sender, contract base.Address,
+	proposalID uint64,
+	policy types.CollectionPolicy,
+	expiresAt base.Height,
+	currency ctypes.CurrencyID,
+) ProposeModelConfigFact {
+	bf := base.NewBaseFact(ProposeModelConfigFactHint, token)
+
+	fact := ProposeModelConfigFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		proposalID: proposalID,
+		policy:     policy,
+		expiresAt:  expiresAt,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ProposeModelConfigFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.policy,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if fact.proposalID < 1 {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrValOOR.Wrap(errors.Errorf("proposal id under one, %d", fact.proposalID)))
+	}
+
+	if fact.expiresAt < 1 {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrValOOR.Wrap(errors.Errorf("wrong proposal expiry height, %v", fact.expiresAt)))
+	}
+
+	if err := fact.policy.URIRule().CheckURIs(
+		fact.policy.URI(), fact.policy.BaseURI(), fact.policy.ExternalURL(), fact.policy.ContractURI()); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	as, err := fact.policy.Addresses()
+	if err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	for _, a := range as {
+		if a.Equal(fact.contract) {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("policy account %v is same with contract account", a)))
+		}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact ProposeModelConfigFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ProposeModelConfigFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ProposeModelConfigFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		util.Uint64ToBytes(fact.proposalID),
+		fact.policy.Bytes(),
+		fact.expiresAt.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact ProposeModelConfigFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ProposeModelConfigFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ProposeModelConfigFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact ProposeModelConfigFact) ProposalID() uint64 {
+	return fact.proposalID
+}
+
+func (fact ProposeModelConfigFact) Policy() types.CollectionPolicy {
+	return fact.policy
+}
+
+func (fact ProposeModelConfigFact) ExpiresAt() base.Height {
+	return fact.expiresAt
+}
+
+func (fact ProposeModelConfigFact) Currency() ctypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact ProposeModelConfigFact) Addresses() ([]base.Address, error) {
+	as, err := fact.policy.Addresses()
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]base.Address{fact.sender}, as...), nil
+}
+
+func (fact ProposeModelConfigFact) FeeBase() map[ctypes.CurrencyID][]common.Big {
+	required := make(map[ctypes.CurrencyID][]common.Big)
+	required[fact.Currency()] = []common.Big{common.ZeroBig}
+
+	return required
+}
+
+func (fact ProposeModelConfigFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact ProposeModelConfigFact) FeeItemCount() (uint, bool) {
+	return extras.ZeroItem, extras.HasNoItem
+}
+
+func (fact ProposeModelConfigFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact ProposeModelConfigFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact ProposeModelConfigFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact ProposeModelConfigFact) DupKey() (map[ctypes.DuplicationKeyType][]string, error) {
+	r := make(map[ctypes.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fact.sender.String()}
+	r[extras.DuplicationKeyTypeContractStatus] = []string{fact.contract.String()}
+
+	return r, nil
+}
+
+// ProposeModelConfig proposes a new policy for the collection of the contract
+// account. Only the collection admins can propose; the proposal is applied
+// when the approvals of the admins reach the threshold before it expires.
+type ProposeModelConfig struct {
+	extras.ExtendedOperation
+}
+
+func NewProposeModelConfig(fact ProposeModelConfigFact) (ProposeModelConfig, error) {
+	return ProposeModelConfig{
+		ExtendedOperation: extras.NewExtendedOperation(ProposeModelConfigHint, fact),
+	}, nil
+}
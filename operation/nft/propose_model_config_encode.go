@@ -0,0 +1,45 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+func (fact *ProposeModelConfigFact) unpack(
+	enc encoder.Encoder,
+	sd, ct string,
+	id uint64,
+	bpo []byte,
+	expiresAt int64,
+	cid string,
+) error {
+	fact.currency = ctypes.CurrencyID(cid)
+	fact.proposalID = id
+	fact.expiresAt = base.Height(expiresAt)
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	contract, err := base.DecodeAddress(ct, enc)
+	if err != nil {
+		return err
+	}
+	fact.contract = contract
+
+	if hinter, err := enc.Decode(bpo); err != nil {
+		return err
+	} else if policy, ok := hinter.(types.CollectionPolicy); !ok {
+		return common.ErrTypeMismatch.Wrap(errors.Errorf("expected CollectionPolicy, not %T", hinter))
+	} else {
+		fact.policy = policy
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package nft
+
+import (
+	"encoding/json"
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+type ProposeModelConfigFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender     base.Address           `json:"sender"`
+	Contract   base.Address           `json:"contract"`
+	ProposalID uint64                 `json:"proposal_id"`
+	Policy     types.CollectionPolicy `json:"policy"`
+	ExpiresAt  base.Height            `json:"expires_at"`
+	Currency   ctypes.CurrencyID      `json:"currency"`
+}
+
+func (fact ProposeModelConfigFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ProposeModelConfigFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		ProposalID:            fact.proposalID,
+		Policy:                fact.policy,
+		ExpiresAt:             fact.expiresAt,
+		Currency:              fact.currency,
+	})
+}
+
+type ProposeModelConfigFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender     string          `json:"sender"`
+	Contract   string          `json:"contract"`
+	ProposalID uint64          `json:"proposal_id"`
+	Policy     json.RawMessage `json:"policy"`
+	ExpiresAt  int64           `json:"expires_at"`
+	Currency   string          `json:"currency"`
+}
+
+func (fact *ProposeModelConfigFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u ProposeModelConfigFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.ProposalID, u.Policy, u.ExpiresAt, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op ProposeModelConfig) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationMarshaler{
+		BaseOperationJSONMarshaler:           op.BaseOperation.JSONMarshaler(),
+		BaseOperationExtensionsJSONMarshaler: op.BaseOperationExtensions.JSONMarshaler(),
+	})
+}
+
+func (op *ProposeModelConfig) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	var ueo extras.BaseOperationExtensions
+	if err := ueo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperationExtensions = &ueo
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+var proposeModelConfigProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ProposeModelConfigProcessor)
+	},
+}
+
+func (ProposeModelConfig) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type ProposeModelConfigProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewProposeModelConfigProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new ProposeModelConfigProcessor")
+
+		nopp := proposeModelConfigProcessorPool.Get()
+		opp, ok := nopp.(*ProposeModelConfigProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected ProposeModelConfigProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ProposeModelConfigProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(ProposeModelConfigFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", ProposeModelConfigFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	design, policy, rErr := loadAdminPolicy(fact.Contract(), fact.Sender(), opp.Height(), getStateFunc)
+	if rErr != nil {
+		return ctx, rErr, nil
+	}
+
+	if policy.MembershipRule().IsMembership() != fact.Policy().MembershipRule().IsMembership() {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("membership of collection in contract account %v can not be changed", design.Contract())), nil
+	}
+
+	params, rErr := currentParams(getStateFunc)
+	if rErr != nil {
+		return ctx, rErr, nil
+	}
+
+	p := fact.Policy()
+	if err := checkURIRule(params, p.URIRule(), p.URI(), p.BaseURI(), p.ExternalURL(), p.ContractURI()); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	if err := params.CheckPolicy(p); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	as, _ := p.Addresses()
+	for _, a := range as {
+		if _, _, _, cErr := cstate.ExistsCAccount(a, "policy account", true, false, getStateFunc); cErr != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+					Errorf("%v: policy account %v is contract account", cErr, a)), nil
+		}
+	}
+
+	if fact.ExpiresAt() <= opp.Height() {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValOOR).
+				Errorf("proposal expiry height %v not over current height %v", fact.ExpiresAt(), opp.Height())), nil
+	}
+
+	proposals, err := pendingProposals(fact.Contract(), opp.Height(), getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("proposals of contract account %v: %v", fact.Contract(), err)), nil
+	}
+
+	if findProposal(proposals, fact.ProposalID()) >= 0 {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMStateE).
+				Errorf("proposal %d already pending in contract account %v", fact.ProposalID(), fact.Contract())), nil
+	}
+
+	if !policy.AdminRule().Approved([]base.Address{fact.Sender()}) && len(proposals) >= types.MaxProposals {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValOOR).
+				Errorf("pending proposals of contract account %v over max, %d", fact.Contract(), types.MaxProposals)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ProposeModelConfigProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, _ := op.Fact().(ProposeModelConfigFact)
+
+	st, err := cstate.ExistsState(state.NFTStateKey(fact.Contract(), state.CollectionKey), "design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection design not found, %v: %w", fact.Contract(), err), nil
+	}
+
+	design, err := state.EffectiveCollectionValue(st, opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection design value not found, %v: %w", fact.Contract(), err), nil
+	}
+
+	policy, ok := design.Policy().(types.CollectionPolicy)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"expected %T, not %T", types.CollectionPolicy{}, design.Policy()), nil
+	}
+
+	proposals, err := pendingProposals(fact.Contract(), opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("proposals not found, %v: %w", fact.Contract(), err), nil
+	}
+
+	var sts []base.StateMergeValue
+	as, _ := fact.Policy().Addresses()
+	for _, a := range as {
+		smv, err := cstate.CreateNotExistAccount(a, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		} else if smv != nil {
+			sts = append(sts, smv)
+		}
+	}
+
+	proposal := types.NewConfigProposal(
+		fact.ProposalID(), fact.Sender(), fact.Policy(), fact.ExpiresAt(), []base.Address{fact.Sender()})
+
+	if policy.AdminRule().Approved(proposal.Approvals()) {
+		asts, err := applyProposal(*design, policy, proposal, proposals, opp.Height(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("pending policy not found, %v: %w", fact.Contract(), err), nil
+		}
+
+		return append(sts, asts...), nil, nil
+	}
+
+	sts = append(sts, cstate.NewStateMergeValue(
+		state.StateKeyProposals(fact.Contract()), state.NewProposalsStateValue(append(proposals, proposal))))
+
+	return sts, nil, nil
+}
+
+func (opp *ProposeModelConfigProcessor) Close() error {
+	proposeModelConfigProcessorPool.Put(opp)
+
+	return nil
+}
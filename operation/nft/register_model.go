@@ -19,13 +19,31 @@ var (
 
 type RegisterModelFact struct {
 	base.BaseFact
-	sender          base.Address
-	contract        base.Address
-	name            types.CollectionName
-	royalty         types.PaymentParameter
-	uri             types.URI
-	minterWhitelist []base.Address
-	currency        ctypes.CurrencyID
+	sender           base.Address
+	contract         base.Address
+	name             types.CollectionName
+	royalty          types.PaymentParameter
+	uri              types.URI
+	minterWhitelist  []base.Address
+	pauser           base.Address
+	clawback         bool
+	mintMode         types.MintMode
+	maxSupply        uint64
+	baseURI          types.URI
+	uriSuffix        types.URI
+	symbol           types.CollectionSymbol
+	description      types.CollectionDescription
+	externalURL      types.URI
+	contractURI      types.URI
+	hashAlgorithms   []types.HashAlgorithm
+	uriRule          types.URIRule
+	attributeUpdater base.Address
+	oracleRule       types.OracleRule
+	membershipRule   types.MembershipRule
+	maxRoyalty       types.PaymentParameter
+	adminRule        types.AdminRule
+	minUpdateDelay   uint64
+	currency         ctypes.CurrencyID
 }
 
 func NewRegisterModelFact(
@@ -36,18 +54,52 @@ func NewRegisterModelFact(
 	royalty types.PaymentParameter,
 	uri types.URI,
 	whitelist []base.Address,
+	pauser base.Address,
+	clawback bool,
+	mintMode types.MintMode,
+	maxSupply uint64,
+	baseURI, uriSuffix types.URI,
+	symbol types.CollectionSymbol,
+	description types.CollectionDescription,
+	externalURL, contractURI types.URI,
+	hashAlgorithms []types.HashAlgorithm,
+	uriRule types.URIRule,
+	attributeUpdater base.Address,
+	oracleRule types.OracleRule,
+	membershipRule types.MembershipRule,
+	maxRoyalty types.PaymentParameter,
+	adminRule types.AdminRule,
+	minUpdateDelay uint64,
 	currency ctypes.CurrencyID,
 ) RegisterModelFact {
 	bf := base.NewBaseFact(RegisterModelFactHint, token)
 	fact := RegisterModelFact{
-		BaseFact:        bf,
-		sender:          sender,
-		contract:        contract,
-		name:            name,
-		royalty:         royalty,
-		uri:             uri,
-		minterWhitelist: whitelist,
-		currency:        currency,
+		BaseFact:         bf,
+		sender:           sender,
+		contract:         contract,
+		name:             name,
+		royalty:          royalty,
+		uri:              uri,
+		minterWhitelist:  whitelist,
+		pauser:           pauser,
+		clawback:         clawback,
+		mintMode:         mintMode,
+		maxSupply:        maxSupply,
+		baseURI:          baseURI,
+		uriSuffix:        uriSuffix,
+		symbol:           symbol,
+		description:      description,
+		externalURL:      externalURL,
+		contractURI:      contractURI,
+		hashAlgorithms:   hashAlgorithms,
+		uriRule:          uriRule,
+		attributeUpdater: attributeUpdater,
+		oracleRule:       oracleRule,
+		membershipRule:   membershipRule,
+		maxRoyalty:       maxRoyalty,
+		adminRule:        adminRule,
+		minUpdateDelay:   minUpdateDelay,
+		currency:         currency,
 	}
 	fact.SetHash(fact.GenerateHash())
 
@@ -65,6 +117,11 @@ func (fact RegisterModelFact) IsValid(b []byte) error {
 		fact.name,
 		fact.royalty,
 		fact.uri,
+		fact.mintMode,
+		fact.symbol,
+		fact.description,
+		fact.externalURL,
+		fact.contractURI,
 		fact.currency,
 	); err != nil {
 		return common.ErrFactInvalid.Wrap(err)
@@ -75,11 +132,6 @@ func (fact RegisterModelFact) IsValid(b []byte) error {
 			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
 	}
 
-	if l := len(fact.minterWhitelist); l > types.MaxWhitelist {
-		return common.ErrFactInvalid.Wrap(
-			common.ErrArrayLen.Wrap(errors.Errorf("whitelist over allowed, %d > %d", l, types.MaxWhitelist)))
-	}
-
 	founds := map[string]struct{}{}
 	for _, white := range fact.minterWhitelist {
 		if err := white.IsValid(nil); err != nil {
@@ -97,6 +149,94 @@ func (fact RegisterModelFact) IsValid(b []byte) error {
 		founds[white.String()] = struct{}{}
 	}
 
+	if fact.pauser != nil {
+		if err := fact.pauser.IsValid(nil); err != nil {
+			return common.ErrFactInvalid.Wrap(err)
+		}
+
+		if fact.pauser.Equal(fact.contract) {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("pauser %v is same with contract account", fact.pauser)))
+		}
+	}
+
+	if err := types.IsValidBaseURI(fact.baseURI, fact.uriSuffix); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := types.IsValidHashAlgorithms(fact.hashAlgorithms); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := fact.uriRule.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := fact.uriRule.CheckURIs(fact.uri, fact.baseURI, fact.externalURL, fact.contractURI); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.maxSupply > types.MaxCount {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrValOOR.Wrap(errors.Errorf("max supply over max count, %d > %d", fact.maxSupply, types.MaxCount)))
+	}
+
+	if fact.mintMode.IsRandom() && fact.maxSupply < 1 {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrValueInvalid.Wrap(errors.Errorf("max supply required for %v mint mode", fact.mintMode)))
+	}
+
+	if fact.attributeUpdater != nil {
+		if err := fact.attributeUpdater.IsValid(nil); err != nil {
+			return common.ErrFactInvalid.Wrap(err)
+		}
+
+		if fact.attributeUpdater.Equal(fact.contract) {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("attribute updater %v is same with contract account", fact.attributeUpdater)))
+		}
+	}
+
+	if err := fact.oracleRule.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	for _, oracle := range fact.oracleRule.Oracles() {
+		if oracle.Equal(fact.contract) {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("oracle %v is same with contract account", oracle)))
+		}
+	}
+
+	if err := fact.membershipRule.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if t := fact.membershipRule.Treasury(); t != nil && t.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("treasury %v is same with contract account", t)))
+	}
+
+	if err := fact.maxRoyalty.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := fact.adminRule.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	for _, admin := range fact.adminRule.Admins() {
+		if admin.Equal(fact.contract) {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("admin %v is same with contract account", admin)))
+		}
+	}
+
+	if fact.maxRoyalty > 0 && fact.royalty > fact.maxRoyalty {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrValOOR.Wrap(errors.Errorf("royalty over max royalty, %d > %d", fact.royalty, fact.maxRoyalty)))
+	}
+
 	if err := common.IsValidOperationFact(fact, b); err != nil {
 		return common.ErrFactInvalid.Wrap(err)
 	}
@@ -118,6 +258,41 @@ func (fact RegisterModelFact) Bytes() []byte {
 		as[i] = white.Bytes()
 	}
 
+	var pb []byte
+	if fact.pauser != nil {
+		pb = fact.pauser.Bytes()
+	}
+
+	var ub []byte
+	if fact.attributeUpdater != nil {
+		ub = fact.attributeUpdater.Bytes()
+	}
+
+	var mb []byte
+	if fact.maxRoyalty > 0 {
+		mb = fact.maxRoyalty.Bytes()
+	}
+
+	var db []byte
+	if fact.minUpdateDelay > 0 {
+		db = util.Uint64ToBytes(fact.minUpdateDelay)
+	}
+
+	var cb []byte
+	if fact.clawback {
+		cb = []byte{1}
+	}
+
+	var sb []byte
+	if fact.maxSupply > 0 {
+		sb = util.Uint64ToBytes(fact.maxSupply)
+	}
+
+	hs := make([][]byte, len(fact.hashAlgorithms))
+	for i, ha := range fact.hashAlgorithms {
+		hs[i] = ha.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		fact.Token(),
 		fact.sender.Bytes(),
@@ -127,6 +302,26 @@ func (fact RegisterModelFact) Bytes() []byte {
 		fact.uri.Bytes(),
 		fact.currency.Bytes(),
 		util.ConcatBytesSlice(as...),
+		types.OptionalBytes(
+			pb,
+			cb,
+			fact.mintMode.Bytes(),
+			sb,
+			fact.baseURI.Bytes(),
+			fact.uriSuffix.Bytes(),
+			fact.symbol.Bytes(),
+			fact.description.Bytes(),
+			fact.externalURL.Bytes(),
+			fact.contractURI.Bytes(),
+			util.ConcatBytesSlice(hs...),
+			fact.uriRule.Bytes(),
+			ub,
+			fact.oracleRule.Bytes(),
+			fact.membershipRule.Bytes(),
+			mb,
+			fact.adminRule.Bytes(),
+			db,
+		),
 	)
 }
 
@@ -205,6 +400,78 @@ func (fact RegisterModelFact) DupKey() (map[ctypes.DuplicationKeyType][]string,
 	return r, nil
 }
 
+func (fact RegisterModelFact) Pauser() base.Address {
+	return fact.pauser
+}
+
+func (fact RegisterModelFact) AttributeUpdater() base.Address {
+	return fact.attributeUpdater
+}
+
+func (fact RegisterModelFact) OracleRule() types.OracleRule {
+	return fact.oracleRule
+}
+
+func (fact RegisterModelFact) MembershipRule() types.MembershipRule {
+	return fact.membershipRule
+}
+
+func (fact RegisterModelFact) MaxRoyalty() types.PaymentParameter {
+	return fact.maxRoyalty
+}
+
+func (fact RegisterModelFact) AdminRule() types.AdminRule {
+	return fact.adminRule
+}
+
+func (fact RegisterModelFact) MinUpdateDelay() uint64 {
+	return fact.minUpdateDelay
+}
+
+func (fact RegisterModelFact) ClawbackEnabled() bool {
+	return fact.clawback
+}
+
+func (fact RegisterModelFact) MintMode() types.MintMode {
+	return fact.mintMode
+}
+
+func (fact RegisterModelFact) MaxSupply() uint64 {
+	return fact.maxSupply
+}
+
+func (fact RegisterModelFact) BaseURI() types.URI {
+	return fact.baseURI
+}
+
+func (fact RegisterModelFact) URISuffix() types.URI {
+	return fact.uriSuffix
+}
+
+func (fact RegisterModelFact) Symbol() types.CollectionSymbol {
+	return fact.symbol
+}
+
+func (fact RegisterModelFact) Description() types.CollectionDescription {
+	return fact.description
+}
+
+func (fact RegisterModelFact) ExternalURL() types.URI {
+	return fact.externalURL
+}
+
+func (fact RegisterModelFact) ContractURI() types.URI {
+	return fact.contractURI
+}
+
+func (fact RegisterModelFact) HashAlgorithms() []types.HashAlgorithm {
+	return fact.hashAlgorithms
+}
+
+func (fact RegisterModelFact) URIRule() types.URIRule {
+	return fact.uriRule
+}
+
 func (fact RegisterModelFact) Currency() ctypes.CurrencyID {
 	return fact.currency
 }
@@ -61,28 +61,30 @@ func NewRegisterModelProcessor() ctypes.GetNewProcessor {
 func (opp *RegisterModelProcessor) PreProcess(
 	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
 ) (context.Context, base.OperationProcessReasonError, error) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
 	fact, ok := op.Fact().(RegisterModelFact)
 	if !ok {
-		return ctx, base.NewBaseOperationProcessReasonError(
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
 			common.ErrMPreProcess.
 				Wrap(common.ErrMTypeMismatch).
 				Errorf("expected %T, not %T", RegisterModelFact{}, op.Fact())), nil
 	}
 
 	if err := fact.IsValid(nil); err != nil {
-		return ctx, base.NewBaseOperationProcessReasonError(
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
 			common.ErrMPreProcess.
 				Errorf("%v", err)), nil
 	}
 
 	if found, _ := cstate.CheckNotExistsState(state.NFTStateKey(fact.contract, state.CollectionKey), getStateFunc); found {
-		return ctx, base.NewBaseOperationProcessReasonError(
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
 			common.ErrMPreProcess.
 				Wrap(common.ErrMServiceE).Errorf("nft collection for contract account %v", fact.Contract())), nil
 	}
 
 	if found, _ := cstate.CheckNotExistsState(state.NFTStateKey(fact.contract, state.LastIDXKey), getStateFunc); found {
-		return ctx, base.NewBaseOperationProcessReasonError(
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
 			common.ErrMPreProcess.
 				Wrap(common.ErrMServiceE).Errorf("nft collection for contract account %v: last index already exists", fact.Contract())), nil
 	}
@@ -90,12 +92,71 @@ func (opp *RegisterModelProcessor) PreProcess(
 	whitelist := fact.WhiteList()
 	for _, white := range whitelist {
 		if _, _, _, cErr := cstate.ExistsCAccount(white, "whitelist", true, false, getStateFunc); cErr != nil {
-			return ctx, base.NewBaseOperationProcessReasonError(
+			return ctx, base.NewBaseOperationProcessReasonError("%s",
 				common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
 					Errorf("%v: whitelist %v is contract account", cErr, white)), nil
 		}
 	}
 
+	if pauser := fact.Pauser(); pauser != nil {
+		if _, _, _, cErr := cstate.ExistsCAccount(pauser, "pauser", true, false, getStateFunc); cErr != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+					Errorf("%v: pauser %v is contract account", cErr, pauser)), nil
+		}
+	}
+
+	if updater := fact.AttributeUpdater(); updater != nil {
+		if _, _, _, cErr := cstate.ExistsCAccount(updater, "attribute updater", true, false, getStateFunc); cErr != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+					Errorf("%v: attribute updater %v is contract account", cErr, updater)), nil
+		}
+	}
+
+	for _, oracle := range fact.OracleRule().Oracles() {
+		if _, _, _, cErr := cstate.ExistsCAccount(oracle, "oracle", true, false, getStateFunc); cErr != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+					Errorf("%v: oracle %v is contract account", cErr, oracle)), nil
+		}
+	}
+
+	for _, admin := range fact.AdminRule().Admins() {
+		if _, _, _, cErr := cstate.ExistsCAccount(admin, "admin", true, false, getStateFunc); cErr != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+					Errorf("%v: admin %v is contract account", cErr, admin)), nil
+		}
+	}
+
+	if treasury := fact.MembershipRule().Treasury(); treasury != nil {
+		if _, _, _, cErr := cstate.ExistsCAccount(treasury, "treasury", true, false, getStateFunc); cErr != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+					Errorf("%v: treasury %v is contract account", cErr, treasury)), nil
+		}
+	}
+
+	params, rErr := currentParams(getStateFunc)
+	if rErr != nil {
+		return ctx, rErr, nil
+	}
+
+	if err := checkURIRule(
+		params, fact.URIRule(), fact.URI(), fact.BaseURI(), fact.ExternalURL(), fact.ContractURI()); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	if err := checkCollectionParams(params, fact.WhiteList(), fact.BaseURI(), fact.URISuffix(),
+		fact.URI(), fact.ExternalURL(), fact.ContractURI()); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("%v", err)), nil
+	}
+
 	return ctx, nil, nil
 }
 
@@ -103,6 +164,8 @@ func (opp *RegisterModelProcessor) Process(
 	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
 	[]base.StateMergeValue, base.OperationProcessReasonError, error,
 ) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
 	fact, _ := op.Fact().(RegisterModelFact)
 	var sts []base.StateMergeValue
 	whitelist := fact.WhiteList()
@@ -115,8 +178,66 @@ func (opp *RegisterModelProcessor) Process(
 		}
 	}
 
-	policy := types.NewCollectionPolicy(fact.Name(), fact.Royalty(), fact.URI(), fact.WhiteList())
-	design := types.NewDesign(fact.Contract(), fact.Sender(), true, 0, policy)
+	if pauser := fact.Pauser(); pauser != nil {
+		smv, err := cstate.CreateNotExistAccount(pauser, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		} else if smv != nil {
+			sts = append(sts, smv)
+		}
+	}
+
+	if updater := fact.AttributeUpdater(); updater != nil {
+		smv, err := cstate.CreateNotExistAccount(updater, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		} else if smv != nil {
+			sts = append(sts, smv)
+		}
+	}
+
+	for _, oracle := range fact.OracleRule().Oracles() {
+		smv, err := cstate.CreateNotExistAccount(oracle, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		} else if smv != nil {
+			sts = append(sts, smv)
+		}
+	}
+
+	for _, admin := range fact.AdminRule().Admins() {
+		smv, err := cstate.CreateNotExistAccount(admin, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		} else if smv != nil {
+			sts = append(sts, smv)
+		}
+	}
+
+	if treasury := fact.MembershipRule().Treasury(); treasury != nil {
+		smv, err := cstate.CreateNotExistAccount(treasury, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		} else if smv != nil {
+			sts = append(sts, smv)
+		}
+	}
+
+	policy := types.NewCollectionPolicy(fact.Name(), fact.Royalty(), fact.URI(), fact.WhiteList()).
+		WithPauser(fact.Pauser()).
+		WithClawback(fact.ClawbackEnabled()).
+		WithMintMode(fact.MintMode(), fact.MaxSupply()).
+		WithBaseURI(fact.BaseURI(), fact.URISuffix()).
+		WithMetadata(fact.Symbol(), fact.Description(), fact.ExternalURL(), fact.ContractURI()).
+		WithHashAlgorithms(fact.HashAlgorithms()).
+		WithURIRule(fact.URIRule()).
+		WithAttributeUpdater(fact.AttributeUpdater()).
+		WithOracleRule(fact.OracleRule()).
+		WithMembershipRule(fact.MembershipRule()).
+		WithMaxRoyalty(fact.MaxRoyalty()).
+		WithAdminRule(fact.AdminRule()).
+		WithMinUpdateDelay(fact.MinUpdateDelay())
+	design := types.NewDesign(fact.Contract(), fact.Sender(), true, false, 0, policy)
 	if err := design.IsValid(nil); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("invalid collection design, %v: %w", fact.Contract(), err), nil
 	}
@@ -0,0 +1,92 @@
+package nft
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+func TestRegisterModelMetadata(t *testing.T) {
+	cases := []struct {
+		name        string
+		symbol      types.CollectionSymbol
+		description types.CollectionDescription
+		external    types.URI
+		contractURI types.URI
+		err         string
+	}{
+		{"metadata", "NFT", "collection of nfts", "https://example.com/about", "https://example.com/contract.json", ""},
+		{"no metadata", "", "", "", "", ""},
+		{"wrong symbol", "nft", "", "", "", "wrong collection symbol"},
+		{"long description", "", types.CollectionDescription(strings.Repeat("a", types.MaxLengthCollectionDescription+1)), "", "", "description"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			g := newTestStateGetter()
+
+			sender, priv := g.newAccount(t)
+			contract := g.newContractAccount(t, sender, false)
+
+			op, err := NewRegisterModel(NewRegisterModelFact(
+				[]byte("token"), sender, contract, "collection", 10, "https://example.com", nil, nil, false,
+				"", 0, "", "", c.symbol, c.description, c.external, c.contractURI,
+				nil, types.URIRule{}, nil, types.OracleRule{}, types.MembershipRule{}, 0, types.AdminRule{}, 0, "MCC",
+			))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := op.Sign(priv, testNetworkID); err != nil {
+				t.Fatal(err)
+			}
+
+			sts, err := processTestOperation(t, NewRegisterModelProcessor(), op, g.GetStateFunc)
+
+			switch {
+			case c.err != "":
+				if err == nil || !strings.Contains(err.Error(), c.err) {
+					t.Fatalf("expected %q, not %v", c.err, err)
+				}
+
+				return
+			case err != nil:
+				t.Fatalf("register model: %v", err)
+			}
+
+			policy := registeredPolicy(t, sts)
+
+			switch {
+			case policy.Symbol() != c.symbol,
+				policy.Description() != c.description,
+				policy.ExternalURL() != c.external,
+				policy.ContractURI() != c.contractURI:
+				t.Fatalf("expected metadata %q %q %q %q, not %q %q %q %q",
+					c.symbol, c.description, c.external, c.contractURI,
+					policy.Symbol(), policy.Description(), policy.ExternalURL(), policy.ContractURI())
+			}
+		})
+	}
+}
+
+func registeredPolicy(t *testing.T, sts []base.StateMergeValue) types.CollectionPolicy {
+	t.Helper()
+
+	for i := range sts {
+		if v, ok := sts[i].Value().(state.CollectionStateValue); ok {
+			policy, ok := v.Design.Policy().(types.CollectionPolicy)
+			if !ok {
+				t.Fatalf("expected %T, not %T", types.CollectionPolicy{}, v.Design.Policy())
+			}
+
+			return policy
+		}
+	}
+
+	t.Fatal("no collection design")
+
+	return types.CollectionPolicy{}
+}
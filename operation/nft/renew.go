@@ -0,0 +1,178 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/imfact-labs/nft-model/operation/processor"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	RenewFactHint = hint.MustNewHint("mitum-nft-renew-operation-fact-v0.0.1")
+	RenewHint     = hint.MustNewHint("mitum-nft-renew-operation-v0.0.1")
+)
+
+type RenewFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	nftIdx   uint64
+	periods  uint64
+	currency ctypes.CurrencyID
+}
+
+func NewRenewFact(
+	token []byte,
+	sender, contract base.Address,
+	nftIdx uint64,
+	periods uint64,
+	currency ctypes.CurrencyID,
+) RenewFact {
+	bf := base.NewBaseFact(RenewFactHint, token)
+
+	fact := RenewFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		nftIdx:   nftIdx,
+		periods:  periods,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RenewFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if fact.periods < 1 || fact.periods > types.MaxRenewPeriods {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(
+			errors.Errorf("renew periods out of range, %d, 1 <= periods <= %d", fact.periods, types.MaxRenewPeriods)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact RenewFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RenewFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RenewFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		util.Uint64ToBytes(fact.nftIdx),
+		util.Uint64ToBytes(fact.periods),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RenewFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RenewFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RenewFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact RenewFact) NFT() uint64 {
+	return fact.nftIdx
+}
+
+func (fact RenewFact) Periods() uint64 {
+	return fact.periods
+}
+
+func (fact RenewFact) Currency() ctypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact RenewFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+func (fact RenewFact) FeeBase() map[ctypes.CurrencyID][]common.Big {
+	required := make(map[ctypes.CurrencyID][]common.Big)
+	required[fact.Currency()] = []common.Big{common.ZeroBig}
+
+	return required
+}
+
+func (fact RenewFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact RenewFact) FeeItemCount() (uint, bool) {
+	return extras.ZeroItem, extras.HasNoItem
+}
+
+func (fact RenewFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact RenewFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact RenewFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact RenewFact) DupKey() (map[ctypes.DuplicationKeyType][]string, error) {
+	r := make(map[ctypes.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fact.sender.String()}
+	r[processor.DuplicationTypeContractNFT] = []string{processor.DuplicationKeyNFT(fact.contract, fact.nftIdx)}
+
+	return r, nil
+}
+
+// Renew extends the membership of a nft by periods. Anyone can renew a
+// membership; the price of the periods is paid to the treasury of the
+// collection.
+type Renew struct {
+	extras.ExtendedOperation
+}
+
+func NewRenew(fact RenewFact) (Renew, error) {
+	return Renew{
+		ExtendedOperation: extras.NewExtendedOperation(RenewHint, fact),
+	}, nil
+}
@@ -0,0 +1,32 @@
+package nft
+
+import (
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+func (fact *RenewFact) unpack(
+	enc encoder.Encoder,
+	sd, ct string,
+	nid, periods uint64,
+	cid string,
+) error {
+	fact.currency = ctypes.CurrencyID(cid)
+	fact.nftIdx = nid
+	fact.periods = periods
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	contract, err := base.DecodeAddress(ct, enc)
+	if err != nil {
+		return err
+	}
+	fact.contract = contract
+
+	return nil
+}
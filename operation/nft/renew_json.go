@@ -0,0 +1,79 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type RenewFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address      `json:"sender"`
+	Contract base.Address      `json:"contract"`
+	NftIdx   uint64            `json:"nft_idx"`
+	Periods  uint64            `json:"periods"`
+	Currency ctypes.CurrencyID `json:"currency"`
+}
+
+func (fact RenewFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RenewFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		NftIdx:                fact.nftIdx,
+		Periods:               fact.periods,
+		Currency:              fact.currency,
+	})
+}
+
+type RenewFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string `json:"sender"`
+	Contract string `json:"contract"`
+	NftIdx   uint64 `json:"nft_idx"`
+	Periods  uint64 `json:"periods"`
+	Currency string `json:"currency"`
+}
+
+func (fact *RenewFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u RenewFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.NftIdx, u.Periods, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op Renew) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationMarshaler{
+		BaseOperationJSONMarshaler:           op.BaseOperation.JSONMarshaler(),
+		BaseOperationExtensionsJSONMarshaler: op.BaseOperationExtensions.JSONMarshaler(),
+	})
+}
+
+func (op *Renew) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	var ueo extras.BaseOperationExtensions
+	if err := ueo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperationExtensions = &ueo
+
+	return nil
+}
@@ -0,0 +1,240 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	ccstate "github.com/imfact-labs/currency-model/state/currency"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+var renewProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RenewProcessor)
+	},
+}
+
+func (Renew) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type RenewProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRenewProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new RenewProcessor")
+
+		nopp := renewProcessorPool.Get()
+		opp, ok := nopp.(*RenewProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected RenewProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RenewProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(RenewFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", RenewFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	st, err := cstate.ExistsState(state.NFTStateKey(fact.Contract(), state.CollectionKey), "design", getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service state for contract account %v", fact.Contract())), nil
+	}
+
+	design, err := state.EffectiveCollectionValue(st, opp.Height(), getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service state value for contract account %v", fact.Contract())), nil
+	}
+
+	if !design.Active() {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service in contract account %v has already been deactivated ", fact.Contract())), nil
+	}
+
+	policy, ok := design.Policy().(types.CollectionPolicy)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", types.CollectionPolicy{}, design.Policy())), nil
+	}
+
+	rule := policy.MembershipRule()
+	if !rule.IsMembership() {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("collection in contract account %v is not membership", fact.Contract())), nil
+	}
+
+	st, err = cstate.ExistsState(state.StateKeyNFT(fact.Contract(), fact.NFT()), "nft", getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+				Errorf("nft idx %v in contract account %v", fact.NFT(), fact.Contract())), nil
+	}
+
+	nv, err := state.StateNFTValue(st)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("nft idx %v in contract account %v", fact.NFT(), fact.Contract())), nil
+	}
+
+	if !nv.Active() {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("burned nft idx %v in contract account %v", fact.NFT(), fact.Contract())), nil
+	}
+
+	st, err = cstate.ExistsState(
+		ccstate.BalanceStateKey(fact.Sender(), rule.Currency()), "balance", getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+				Errorf("balance of currency %v of account %v", rule.Currency(), fact.Sender())), nil
+	}
+
+	balance, err := ccstate.StateBalanceValue(st)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("balance of currency %v of account %v", rule.Currency(), fact.Sender())), nil
+	}
+
+	if price := rule.Price(fact.Periods()); balance.Big().Compare(price) < 0 {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("insufficient balance of account %v for renewal, %v < %v",
+					fact.Sender(), balance.Big(), price)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RenewProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, _ := op.Fact().(RenewFact)
+
+	st, err := cstate.ExistsState(state.NFTStateKey(fact.Contract(), state.CollectionKey), "design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection design not found, %v: %w", fact.Contract(), err), nil
+	}
+
+	design, err := state.EffectiveCollectionValue(st, opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection design value not found, %v: %w", fact.Contract(), err), nil
+	}
+
+	policy, ok := design.Policy().(types.CollectionPolicy)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"expected %T, not %T", types.CollectionPolicy{}, design.Policy()), nil
+	}
+
+	st, err = cstate.ExistsState(state.StateKeyNFT(fact.Contract(), fact.NFT()), "nft", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft not found, %v: %w", fact.NFT(), err), nil
+	}
+
+	nv, err := state.StateNFTValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft value not found, %v: %w", fact.NFT(), err), nil
+	}
+
+	rule := policy.MembershipRule()
+	price := ctypes.NewAmount(rule.Price(fact.Periods()), rule.Currency())
+
+	var sts []base.StateMergeValue
+
+	smv, err := cstate.CreateNotExistAccount(rule.Treasury(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	} else if smv != nil {
+		sts = append(sts, smv)
+	}
+
+	sk := ccstate.BalanceStateKey(fact.Sender(), rule.Currency())
+	sts = append(sts, common.NewBaseStateMergeValue(
+		sk,
+		ccstate.NewDeductBalanceStateValue(price),
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return ccstate.NewBalanceStateValueMerger(height, sk, rule.Currency(), st)
+		},
+	))
+
+	tk := ccstate.BalanceStateKey(rule.Treasury(), rule.Currency())
+	sts = append(sts, common.NewBaseStateMergeValue(
+		tk,
+		ccstate.NewAddBalanceStateValue(price),
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return ccstate.NewBalanceStateValueMerger(height, tk, rule.Currency(), st)
+		},
+	))
+
+	n := types.NewNFT(nv.ID(), nv.Active(), nv.Owner(), nv.NFTHash(), nv.URI(), nv.Approved(), nv.Creators()).
+		WithOptionalFields(*nv).
+		WithExpiry(rule.Extend(nv.ExpiresAt(), opp.Height(), fact.Periods()))
+	if err := n.IsValid(nil); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid nft, %v: %w", fact.NFT(), err), nil
+	}
+
+	sts = append(sts, cstate.NewStateMergeValue(state.StateKeyNFT(fact.Contract(), fact.NFT()), state.NewNFTStateValue(n)))
+
+	return sts, nil, nil
+}
+
+func (opp *RenewProcessor) Close() error {
+	renewProcessorPool.Put(opp)
+
+	return nil
+}
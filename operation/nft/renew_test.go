@@ -0,0 +1,92 @@
+package nft
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	ccstate "github.com/imfact-labs/currency-model/state/currency"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+func TestRenew(t *testing.T) {
+	cases := []struct {
+		name     string
+		member   bool
+		balance  int64
+		active   bool
+		expected string
+	}{
+		{"renew", true, 20, true, ""},
+		{"not membership", false, 20, true, "is not membership"},
+		{"insufficient balance", true, 19, true, "insufficient balance"},
+		{"burned", true, 20, false, "burned nft"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			g := newTestStateGetter()
+
+			sender, priv := g.newAccount(t)
+			treasury, _ := g.newAccount(t)
+
+			policy := newTestCollectionPolicy()
+			if c.member {
+				policy = policy.WithMembershipRule(types.NewMembershipRule("MCC", common.NewBig(10), 100, treasury))
+			}
+
+			contract := g.newCollection(t, sender, policy)
+			g.setNFT(contract, types.NewNFT(
+				0, c.active, sender, "hash", "https://example.com/1", sender, types.NewSigners(nil)).WithExpiry(150))
+			g.set(ccstate.BalanceStateKey(sender, "MCC"),
+				ccstate.NewBalanceStateValue(ctypes.NewAmount(common.NewBig(c.balance), "MCC")))
+
+			op, err := NewRenew(NewRenewFact([]byte("token"), sender, contract, 0, 2, "MCC"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := op.Sign(priv, testNetworkID); err != nil {
+				t.Fatal(err)
+			}
+
+			sts, err := processTestOperation(t, NewRenewProcessor(), op, g.GetStateFunc)
+
+			switch {
+			case c.expected != "":
+				if err == nil || !strings.Contains(err.Error(), c.expected) {
+					t.Fatalf("expected %q, not %v", c.expected, err)
+				}
+
+				return
+			case err != nil:
+				t.Fatalf("renew: %v", err)
+			}
+
+			var paid, renewed bool
+			for i := range sts {
+				switch v := sts[i].Value().(type) {
+				case state.NFTStateValue:
+					if v.NFT.ExpiresAt() != base.Height(350) {
+						t.Fatalf("expected expiry 350, not %v", v.NFT.ExpiresAt())
+					}
+
+					renewed = true
+				case ccstate.AddBalanceStateValue:
+					if sts[i].Key() != ccstate.BalanceStateKey(treasury, "MCC") || !v.Amount.Big().Equal(common.NewBig(20)) {
+						t.Fatalf("wrong payment to treasury, %v %v", sts[i].Key(), v.Amount)
+					}
+
+					paid = true
+				}
+			}
+
+			if !paid || !renewed {
+				t.Fatalf("expected payment and renewal, %v %v", paid, renewed)
+			}
+		})
+	}
+}
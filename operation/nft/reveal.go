@@ -0,0 +1,199 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	RevealFactHint = hint.MustNewHint("mitum-nft-reveal-operation-fact-v0.0.1")
+	RevealHint     = hint.MustNewHint("mitum-nft-reveal-operation-v0.0.1")
+)
+
+type RevealFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	baseURI  types.URI
+	seed     uint64
+	uriRoot  util.Hash
+	salt     []byte
+	currency ctypes.CurrencyID
+}
+
+func NewRevealFact(
+	token []byte,
+	sender, contract base.Address,
+	baseURI types.URI,
+	seed uint64,
+	uriRoot util.Hash,
+	salt []byte,
+	currency ctypes.CurrencyID,
+) RevealFact {
+	bf := base.NewBaseFact(RevealFactHint, token)
+
+	fact := RevealFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		baseURI:  baseURI,
+		seed:     seed,
+		uriRoot:  uriRoot,
+		salt:     salt,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RevealFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.baseURI,
+		fact.uriRoot,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if fact.baseURI == "" {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("empty base uri")))
+	}
+
+	if l := len(fact.salt); l < types.MinRevealSaltLength {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(
+			errors.Errorf("salt shorter than %d, %d", types.MinRevealSaltLength, l)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact RevealFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RevealFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RevealFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.baseURI.Bytes(),
+		util.Uint64ToBytes(fact.seed),
+		fact.uriRoot.Bytes(),
+		util.Uint64ToBytes(uint64(len(fact.salt))),
+		fact.salt,
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RevealFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RevealFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RevealFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact RevealFact) BaseURI() types.URI {
+	return fact.baseURI
+}
+
+func (fact RevealFact) Seed() uint64 {
+	return fact.seed
+}
+
+func (fact RevealFact) URIRoot() util.Hash {
+	return fact.uriRoot
+}
+
+func (fact RevealFact) Salt() []byte {
+	return fact.salt
+}
+
+func (fact RevealFact) Currency() ctypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact RevealFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+func (fact RevealFact) FeeBase() map[ctypes.CurrencyID][]common.Big {
+	required := make(map[ctypes.CurrencyID][]common.Big)
+	required[fact.Currency()] = []common.Big{common.ZeroBig}
+
+	return required
+}
+
+func (fact RevealFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact RevealFact) FeeItemCount() (uint, bool) {
+	return extras.ZeroItem, extras.HasNoItem
+}
+
+func (fact RevealFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact RevealFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact RevealFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact RevealFact) DupKey() (map[ctypes.DuplicationKeyType][]string, error) {
+	r := make(map[ctypes.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fact.sender.String()}
+	r[extras.DuplicationKeyTypeContractStatus] = []string{fact.contract.String()}
+
+	return r, nil
+}
+
+// Reveal publishes the final base uri and seed of a committed collection with
+// the uri root and salt opening the commitment.
+type Reveal struct {
+	extras.ExtendedOperation
+}
+
+func NewReveal(fact RevealFact) (Reveal, error) {
+	return Reveal{
+		ExtendedOperation: extras.NewExtendedOperation(RevealHint, fact),
+	}, nil
+}
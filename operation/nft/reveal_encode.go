@@ -0,0 +1,38 @@
+package nft
+
+import (
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+func (fact *RevealFact) unpack(
+	enc encoder.Encoder,
+	sd, ct, bu string,
+	seed uint64,
+	root string,
+	salt []byte,
+	cid string,
+) error {
+	fact.currency = ctypes.CurrencyID(cid)
+	fact.baseURI = types.URI(bu)
+	fact.seed = seed
+	fact.uriRoot = valuehash.NewBytesFromString(root)
+	fact.salt = salt
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	contract, err := base.DecodeAddress(ct, enc)
+	if err != nil {
+		return err
+	}
+	fact.contract = contract
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+type RevealFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address      `json:"sender"`
+	Contract base.Address      `json:"contract"`
+	BaseURI  types.URI         `json:"base_uri"`
+	Seed     uint64            `json:"seed"`
+	URIRoot  util.Hash         `json:"uri_root"`
+	Salt     []byte            `json:"salt"`
+	Currency ctypes.CurrencyID `json:"currency"`
+}
+
+func (fact RevealFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevealFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		BaseURI:               fact.baseURI,
+		Seed:                  fact.seed,
+		URIRoot:               fact.uriRoot,
+		Salt:                  fact.salt,
+		Currency:              fact.currency,
+	})
+}
+
+type RevealFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string `json:"sender"`
+	Contract string `json:"contract"`
+	BaseURI  string `json:"base_uri"`
+	Seed     uint64 `json:"seed"`
+	URIRoot  string `json:"uri_root"`
+	Salt     []byte `json:"salt"`
+	Currency string `json:"currency"`
+}
+
+func (fact *RevealFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u RevealFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.BaseURI, u.Seed, u.URIRoot, u.Salt, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op Reveal) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationMarshaler{
+		BaseOperationJSONMarshaler:           op.BaseOperation.JSONMarshaler(),
+		BaseOperationExtensionsJSONMarshaler: op.BaseOperationExtensions.JSONMarshaler(),
+	})
+}
+
+func (op *Reveal) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	var ueo extras.BaseOperationExtensions
+	if err := ueo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperationExtensions = &ueo
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+var revealProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RevealProcessor)
+	},
+}
+
+func (Reveal) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type RevealProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRevealProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new RevealProcessor")
+
+		nopp := revealProcessorPool.Get()
+		opp, ok := nopp.(*RevealProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected RevealProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RevealProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(RevealFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", RevealFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	params, rErr := currentParams(getStateFunc)
+	if rErr != nil {
+		return ctx, rErr, nil
+	}
+
+	if err := params.CheckURIs(fact.BaseURI()); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	if err := params.CheckBaseURI(fact.BaseURI(), ""); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	st, err := cstate.ExistsState(state.NFTStateKey(fact.Contract(), state.CollectionKey), "design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service state for contract account %v", fact.Contract())), nil
+	}
+
+	design, err := state.EffectiveCollectionValue(st, opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service state value for contract account %v", fact.Contract())), nil
+	}
+
+	if !design.Active() {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service in contract account %v has already been deactivated ", fact.Contract())), nil
+	}
+
+	if err := checkCollectionOwner(*design, fact.Sender(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("%v", err)), nil
+	}
+
+	st, err = cstate.ExistsState(state.NFTStateKey(fact.Contract(), state.RevealKey), "reveal", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+				Errorf("reveal commitment for contract account %v", fact.Contract())), nil
+	}
+
+	reveal, err := state.StateRevealValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("reveal commitment value for contract account %v", fact.Contract())), nil
+	}
+
+	if reveal.Revealed() {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("nft service in contract account %v has already been revealed", fact.Contract())), nil
+	}
+
+	if root := types.RevealURIRoot(fact.BaseURI(), fact.Seed(), reveal.Supply()); !root.Equal(fact.URIRoot()) {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("uri root does not match base uri and seed of contract account %v, %v != %v",
+					fact.Contract(), fact.URIRoot(), root)), nil
+	}
+
+	if h := types.RevealCommitment(fact.URIRoot(), fact.Salt()); !h.Equal(reveal.Commitment()) {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("uri root and salt do not match commitment of contract account %v, %v != %v",
+					fact.Contract(), h, reveal.Commitment())), nil
+	}
+
+	if err := checkURIRule(params, designURIRule(*design), fact.BaseURI()); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("revealed base uri for contract account %v: %v", fact.Contract(), err)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RevealProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, _ := op.Fact().(RevealFact)
+
+	st, err := cstate.ExistsState(state.NFTStateKey(fact.Contract(), state.RevealKey), "reveal", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("reveal commitment not found, %v: %w", fact.Contract(), err), nil
+	}
+
+	reveal, err := state.StateRevealValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("reveal commitment value not found, %v: %w", fact.Contract(), err), nil
+	}
+
+	if root := types.RevealURIRoot(fact.BaseURI(), fact.Seed(), reveal.Supply()); !root.Equal(fact.URIRoot()) {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"uri root does not match base uri and seed, %v: %v != %v", fact.Contract(), fact.URIRoot(), root), nil
+	}
+
+	nr := types.NewReveal(reveal.PreRevealURI(), reveal.Commitment(), reveal.Supply(), fact.BaseURI(), fact.Seed(), true).
+		WithOpening(fact.URIRoot(), fact.Salt())
+	if err := nr.IsValid(nil); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid reveal, %v: %w", fact.Contract(), err), nil
+	}
+
+	return []base.StateMergeValue{
+		cstate.NewStateMergeValue(state.NFTStateKey(fact.Contract(), state.RevealKey), state.NewRevealStateValue(nr)),
+	}, nil, nil
+}
+
+func (opp *RevealProcessor) Close() error {
+	revealProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package nft
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+func TestRevealCommitmentOpening(t *testing.T) {
+	const baseURI, seed, supply = types.URI("https://example.com/"), uint64(3), uint64(5)
+
+	salt := bytes.Repeat([]byte{0x5a}, types.MinRevealSaltLength)
+	root := types.RevealURIRoot(baseURI, seed, supply)
+
+	cases := []struct {
+		name     string
+		baseURI  types.URI
+		seed     uint64
+		root     util.Hash
+		salt     []byte
+		expected string
+	}{
+		{"opening", baseURI, seed, root, salt, ""},
+		{"wrong root", baseURI, seed + 1, types.RevealURIRoot(baseURI, seed+1, supply), salt, "do not match commitment"},
+		{"wrong salt", baseURI, seed, root, bytes.Repeat([]byte{0x5b}, types.MinRevealSaltLength), "do not match commitment"},
+		{"short salt", baseURI, seed, root, salt[1:], "salt shorter"},
+		{"wrong base uri", "https://example.org/", seed, root, salt, "uri root does not match"},
+		{"wrong seed", baseURI, seed + 1, root, salt, "uri root does not match"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			g := newTestStateGetter()
+
+			sender, priv := g.newAccount(t)
+			contract := g.newCollection(t, sender, newTestCollectionPolicy())
+			g.set(state.NFTStateKey(contract, state.RevealKey), state.NewRevealStateValue(types.NewReveal(
+				"https://example.com/hidden", types.RevealCommitment(root, salt), supply, "", 0, false)))
+
+			op, err := NewReveal(NewRevealFact([]byte("token"), sender, contract, c.baseURI, c.seed, c.root, c.salt, "MCC"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := op.Sign(priv, testNetworkID); err != nil {
+				t.Fatal(err)
+			}
+
+			sts, err := processTestOperation(t, NewRevealProcessor(), op, g.GetStateFunc)
+
+			switch {
+			case c.expected != "":
+				if err == nil || !strings.Contains(err.Error(), c.expected) {
+					t.Fatalf("expected %q, not %v", c.expected, err)
+				}
+
+				return
+			case err != nil:
+				t.Fatalf("reveal: %v", err)
+			}
+
+			v, ok := sts[0].Value().(state.RevealStateValue)
+			switch {
+			case !ok:
+				t.Fatalf("expected %T, not %T", state.RevealStateValue{}, sts[0].Value())
+			case !v.Reveal.Revealed() || !v.Reveal.URIRoot().Equal(root) || !bytes.Equal(v.Reveal.Salt(), salt):
+				t.Fatal("reveal without opening")
+			}
+		})
+	}
+}
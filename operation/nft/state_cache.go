@@ -0,0 +1,56 @@
+package nft
+
+import (
+	"sync"
+
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type cachedState struct {
+	st    base.State
+	found bool
+}
+
+// stateCache memoizes the states read by one PreProcess or Process call of an
+// operation processor, including the states not found. The item processors of
+// the fact share it, so the states of a collection are read once for all the
+// items.
+type stateCache struct {
+	sync.RWMutex
+	getStateFunc base.GetStateFunc
+	states       map[string]cachedState
+}
+
+func newStateCache(getStateFunc base.GetStateFunc) *stateCache {
+	return &stateCache{
+		getStateFunc: getStateFunc,
+		states:       map[string]cachedState{},
+	}
+}
+
+func (c *stateCache) GetStateFunc(key string) (base.State, bool, error) {
+	c.RLock()
+	i, found := c.states[key]
+	c.RUnlock()
+
+	if found {
+		return i.st, i.found, nil
+	}
+
+	st, found, err := c.getStateFunc(key)
+	if err != nil {
+		return nil, false, err
+	}
+
+	c.Lock()
+	c.states[key] = cachedState{st: st, found: found}
+	c.Unlock()
+
+	return st, found, nil
+}
+
+// cachedGetStateFunc wraps getStateFunc with a new state cache. The cache is
+// dropped with the call, so nothing outlives the processor.
+var cachedGetStateFunc = func(getStateFunc base.GetStateFunc) base.GetStateFunc {
+	return newStateCache(getStateFunc).GetStateFunc
+}
@@ -0,0 +1,120 @@
+package nft
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+func TestStateCache(t *testing.T) {
+	g := newTestStateGetter()
+	g.set("a", nil)
+
+	c := newStateCache(g.GetStateFunc)
+
+	for range 2 {
+		if _, found, err := c.GetStateFunc("a"); err != nil || !found {
+			t.Fatalf("state a not found, %v", err)
+		}
+
+		if _, found, err := c.GetStateFunc("b"); err != nil || found {
+			t.Fatalf("state b found, %v", err)
+		}
+	}
+
+	if g.reads != 2 {
+		t.Fatalf("expected 2 reads, not %d", g.reads)
+	}
+}
+
+func benchmarkStateCache(b *testing.B, run func(b *testing.B, g *testStateGetter)) {
+	for _, c := range []struct {
+		name   string
+		cached func(base.GetStateFunc) base.GetStateFunc
+	}{
+		{name: "cached", cached: cachedGetStateFunc},
+		{name: "uncached", cached: func(f base.GetStateFunc) base.GetStateFunc { return f }},
+	} {
+		b.Run(c.name, func(b *testing.B) {
+			orig := cachedGetStateFunc
+			cachedGetStateFunc = c.cached
+			defer func() {
+				cachedGetStateFunc = orig
+			}()
+
+			run(b, newTestStateGetter())
+		})
+	}
+}
+
+func BenchmarkMintProcessor(b *testing.B) {
+	benchmarkStateCache(b, func(b *testing.B, g *testStateGetter) {
+		sender, priv := g.newAccount(b)
+		receiver, _ := g.newAccount(b)
+		contract := g.newCollection(b, sender, newTestCollectionPolicy())
+
+		items := make([]MintItem, types.DefaultParams().MaxMintItems())
+		for i := range items {
+			items[i] = NewMintItem(
+				contract, receiver, "hash", "https://example.com/1", types.NewSigners(nil), nil, 0, 0, nil, "MCC",
+			)
+		}
+
+		op, err := NewMint(NewMintFact([]byte("token"), sender, items))
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		if err := op.Sign(priv, testNetworkID); err != nil {
+			b.Fatal(err)
+		}
+
+		g.reads = 0
+		b.ResetTimer()
+
+		for range b.N {
+			if _, err := processTestOperation(b, NewMintProcessor(), op, g.GetStateFunc); err != nil {
+				b.Fatal(err)
+			}
+		}
+
+		b.ReportMetric(float64(g.reads)/float64(b.N), "reads/op")
+	})
+}
+
+func BenchmarkTransferProcessor(b *testing.B) {
+	benchmarkStateCache(b, func(b *testing.B, g *testStateGetter) {
+		sender, priv := g.newAccount(b)
+		receiver, _ := g.newAccount(b)
+		contract := g.newCollection(b, sender, newTestCollectionPolicy())
+
+		items := make([]TransferItem, types.DefaultParams().MaxTransferItems())
+		for i := range items {
+			g.setNFT(contract, types.NewNFT(
+				uint64(i), true, sender, "hash", "https://example.com/1", sender, types.NewSigners(nil)))
+
+			items[i] = NewTransferItem(contract, receiver, uint64(i), "MCC")
+		}
+
+		op, err := NewTransfer(NewTransferFact([]byte("token"), sender, items))
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		if err := op.Sign(priv, testNetworkID); err != nil {
+			b.Fatal(err)
+		}
+
+		g.reads = 0
+		b.ResetTimer()
+
+		for range b.N {
+			if _, err := processTestOperation(b, NewTransferProcessor(), op, g.GetStateFunc); err != nil {
+				b.Fatal(err)
+			}
+		}
+
+		b.ReportMetric(float64(g.reads)/float64(b.N), "reads/op")
+	})
+}
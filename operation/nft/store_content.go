@@ -0,0 +1,192 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/imfact-labs/nft-model/operation/processor"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	StoreContentFactHint = hint.MustNewHint("mitum-nft-store-content-operation-fact-v0.0.1")
+	StoreContentHint     = hint.MustNewHint("mitum-nft-store-content-operation-v0.0.1")
+)
+
+type StoreContentFact struct {
+	base.BaseFact
+	sender    base.Address
+	contract  base.Address
+	contentID uint64
+	index     uint64
+	data      []byte
+	currency  ctypes.CurrencyID
+}
+
+func NewStoreContentFact(
+	token []byte,
+	sender, contract base.Address,
+	contentID, index uint64,
+	data []byte,
+	currency ctypes.CurrencyID,
+) StoreContentFact {
+	bf := base.NewBaseFact(StoreContentFactHint, token)
+
+	fact := StoreContentFact{
+		BaseFact:  bf,
+		sender:    sender,
+		contract:  contract,
+		contentID: contentID,
+		index:     index,
+		data:      data,
+		currency:  currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact StoreContentFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if l := len(fact.data); l < 1 || l > types.MaxContentChunkSize {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(
+			errors.Errorf("content chunk size out of range, %d, 1 <= size <= %d", l, types.MaxContentChunkSize)))
+	}
+
+	if fact.index >= types.MaxContentChunks {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(
+			errors.Errorf("content chunk index out of range, %d >= %d", fact.index, types.MaxContentChunks)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact StoreContentFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact StoreContentFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact StoreContentFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		util.Uint64ToBytes(fact.contentID),
+		util.Uint64ToBytes(fact.index),
+		fact.data,
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact StoreContentFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact StoreContentFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact StoreContentFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact StoreContentFact) ContentID() uint64 {
+	return fact.contentID
+}
+
+func (fact StoreContentFact) Index() uint64 {
+	return fact.index
+}
+
+func (fact StoreContentFact) Data() []byte {
+	return fact.data
+}
+
+func (fact StoreContentFact) Currency() ctypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact StoreContentFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+func (fact StoreContentFact) FeeBase() map[ctypes.CurrencyID][]common.Big {
+	required := make(map[ctypes.CurrencyID][]common.Big)
+	required[fact.Currency()] = []common.Big{common.ZeroBig}
+
+	return required
+}
+
+func (fact StoreContentFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact StoreContentFact) FeeItemCount() (uint, bool) {
+	return extras.ZeroItem, extras.HasNoItem
+}
+
+func (fact StoreContentFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact StoreContentFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact StoreContentFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact StoreContentFact) DupKey() (map[ctypes.DuplicationKeyType][]string, error) {
+	r := make(map[ctypes.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fact.sender.String()}
+	// keyed by content, not by chunk, so the content is not finalized by
+	// FinalizeContent in the same proposal.
+	r[processor.DuplicationTypeContent] = []string{processor.DuplicationKeyContent(fact.contract, fact.contentID)}
+
+	return r, nil
+}
+
+// StoreContent writes a chunk of an on-chain content of a collection. Chunks
+// can be overwritten until the content is finalized by FinalizeContent. It is
+// allowed to the collection owner and the whitelisted minters.
+type StoreContent struct {
+	extras.ExtendedOperation
+}
+
+func NewStoreContent(fact StoreContentFact) (StoreContent, error) {
+	return StoreContent{
+		ExtendedOperation: extras.NewExtendedOperation(StoreContentHint, fact),
+	}, nil
+}
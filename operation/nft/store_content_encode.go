@@ -0,0 +1,34 @@
+package nft
+
+import (
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+func (fact *StoreContentFact) unpack(
+	enc encoder.Encoder,
+	sd, ct string,
+	id, idx uint64,
+	data []byte,
+	cid string,
+) error {
+	fact.currency = ctypes.CurrencyID(cid)
+	fact.contentID = id
+	fact.index = idx
+	fact.data = data
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	contract, err := base.DecodeAddress(ct, enc)
+	if err != nil {
+		return err
+	}
+	fact.contract = contract
+
+	return nil
+}
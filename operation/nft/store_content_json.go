@@ -0,0 +1,82 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type StoreContentFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender    base.Address      `json:"sender"`
+	Contract  base.Address      `json:"contract"`
+	ContentID uint64            `json:"content_id"`
+	Index     uint64            `json:"index"`
+	Data      []byte            `json:"data"`
+	Currency  ctypes.CurrencyID `json:"currency"`
+}
+
+func (fact StoreContentFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(StoreContentFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		ContentID:             fact.contentID,
+		Index:                 fact.index,
+		Data:                  fact.data,
+		Currency:              fact.currency,
+	})
+}
+
+type StoreContentFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender    string `json:"sender"`
+	Contract  string `json:"contract"`
+	ContentID uint64 `json:"content_id"`
+	Index     uint64 `json:"index"`
+	Data      []byte `json:"data"`
+	Currency  string `json:"currency"`
+}
+
+func (fact *StoreContentFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u StoreContentFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.ContentID, u.Index, u.Data, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op StoreContent) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationMarshaler{
+		BaseOperationJSONMarshaler:           op.BaseOperation.JSONMarshaler(),
+		BaseOperationExtensionsJSONMarshaler: op.BaseOperationExtensions.JSONMarshaler(),
+	})
+}
+
+func (op *StoreContent) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	var ueo extras.BaseOperationExtensions
+	if err := ueo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperationExtensions = &ueo
+
+	return nil
+}
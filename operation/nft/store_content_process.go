@@ -0,0 +1,122 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/pkg/errors"
+)
+
+var storeContentProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(StoreContentProcessor)
+	},
+}
+
+func (StoreContent) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type StoreContentProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewStoreContentProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new StoreContentProcessor")
+
+		nopp := storeContentProcessorPool.Get()
+		opp, ok := nopp.(*StoreContentProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected StoreContentProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *StoreContentProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(StoreContentFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", StoreContentFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := checkContentWriter(fact.Contract(), fact.Sender(), opp.Height(), getStateFunc); err != nil {
+		return ctx, err, nil
+	}
+
+	switch _, found, err := getStateFunc(state.StateKeyContent(fact.Contract(), fact.ContentID())); {
+	case err != nil:
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	case found:
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("content %v of contract account %v already finalized", fact.ContentID(), fact.Contract())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *StoreContentProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, _ := op.Fact().(StoreContentFact)
+
+	switch _, found, err := getStateFunc(state.StateKeyContent(fact.Contract(), fact.ContentID())); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to get content, %v: %w", fact.ContentID(), err), nil
+	case found:
+		return nil, base.NewBaseOperationProcessReasonError("content already finalized, %v", fact.ContentID()), nil
+	}
+
+	return []base.StateMergeValue{
+		cstate.NewStateMergeValue(
+			state.StateKeyContentChunk(fact.Contract(), fact.ContentID(), fact.Index()),
+			state.NewContentChunkStateValue(fact.Data()),
+		),
+	}, nil, nil
+}
+
+func (opp *StoreContentProcessor) Close() error {
+	storeContentProcessorPool.Put(opp)
+
+	return nil
+}
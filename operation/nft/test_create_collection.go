@@ -99,7 +99,7 @@ func (t *TestCreateCollectionProcessor) SetService(
 	}
 
 	policy := types.NewCollectionPolicy(t.name, t.royalty, t.uri, whs)
-	design := types.NewDesign(contract, sender, true, 0, policy)
+	design := types.NewDesign(contract, sender, true, false, 0, policy)
 
 	st := common.NewBaseState(base.Height(1), state.NFTStateKey(design.Contract(), state.CollectionKey), state.NewCollectionStateValue(design), nil, []util.Hash{})
 	t.SetState(st, true)
@@ -139,6 +139,24 @@ func (t *TestCreateCollectionProcessor) MakeOperation(
 			t.royalty,
 			t.uri,
 			whs,
+			nil,
+			false,
+			types.MintModeSequential,
+			0,
+			"",
+			"",
+			"",
+			"",
+			"",
+			"",
+			nil,
+			types.URIRule{},
+			nil,
+			types.OracleRule{},
+			types.MembershipRule{},
+			0,
+			types.AdminRule{},
+			0,
 			currency,
 		))
 	_ = op.Sign(privatekey, t.NetworkID)
@@ -122,7 +122,7 @@ func (t *TestMintProcessor) SetService(
 	}
 
 	policy := types.NewCollectionPolicy(t.name, t.royalty, t.uri, whs)
-	design := types.NewDesign(contract, sender, true, 0, policy)
+	design := types.NewDesign(contract, sender, true, false, 0, policy)
 
 	st := common.NewBaseState(base.Height(1), state.NFTStateKey(design.Contract(), state.CollectionKey), state.NewCollectionStateValue(design), nil, []util.Hash{})
 	t.SetState(st, true)
@@ -163,7 +163,7 @@ func (t *TestMintProcessor) MakeItem(
 	target test.Account, receiver test.Account, hash, uri string, creators types.Signers, currency ctypes.CurrencyID,
 	targetItems []MintItem,
 ) *TestMintProcessor {
-	item := NewMintItem(target.Address(), receiver.Address(), types.NFTHash(hash), types.URI(uri), creators, currency)
+	item := NewMintItem(target.Address(), receiver.Address(), types.NFTHash(hash), types.URI(uri), creators, nil, 0, 0, nil, currency)
 	test.UpdateSlice[MintItem](item, targetItems)
 
 	return t
@@ -1,7 +1,6 @@
 package nft
 
 import (
-	"fmt"
 	"strconv"
 
 	"github.com/imfact-labs/currency-model/common"
@@ -20,8 +19,6 @@ var (
 	TransferHint     = hint.MustNewHint("mitum-nft-transfer-operation-v0.0.1")
 )
 
-var MaxTransferItems = 100
-
 type TransferFact struct {
 	base.BaseFact
 	sender base.Address
@@ -48,9 +45,6 @@ func (fact TransferFact) IsValid(b []byte) error {
 
 	if l := len(fact.items); l < 1 {
 		return common.ErrFactInvalid.Wrap(common.ErrArrayLen.Wrap(errors.Errorf("empty items for TransferFact")))
-	} else if l > int(MaxTransferItems) {
-		return common.ErrFactInvalid.Wrap(
-			common.ErrArrayLen.Wrap(errors.Errorf("items over allowed, %d > %d", l, MaxTransferItems)))
 	}
 
 	if err := fact.sender.IsValid(nil); err != nil {
@@ -75,7 +69,7 @@ func (fact TransferFact) IsValid(b []byte) error {
 				common.ErrDupVal.Wrap(errors.Errorf("nft idx %v in contract account %v", n, item.contract)))
 		}
 
-		founds[n] = struct{}{}
+		founds[item.contract.String()+"-"+n] = struct{}{}
 	}
 
 	if err := common.IsValidOperationFact(fact, b); err != nil {
@@ -182,7 +176,7 @@ func (fact TransferFact) DupKey() (map[types.DuplicationKeyType][]string, error)
 	r[extras.DuplicationKeyTypeSender] = []string{fact.sender.String()}
 	for _, item := range fact.items {
 		r[processor.DuplicationTypeContractNFT] = append(
-			r[processor.DuplicationTypeContractNFT], fmt.Sprintf("%s:%v", item.contract.String(), item.nftIdx))
+			r[processor.DuplicationTypeContractNFT], processor.DuplicationKeyNFT(item.contract, item.nftIdx))
 	}
 
 	return r, nil
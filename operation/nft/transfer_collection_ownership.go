@@ -0,0 +1,176 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	TransferCollectionOwnershipFactHint = hint.MustNewHint("mitum-nft-transfer-collection-ownership-operation-fact-v0.0.1")
+	TransferCollectionOwnershipHint     = hint.MustNewHint("mitum-nft-transfer-collection-ownership-operation-v0.0.1")
+)
+
+type TransferCollectionOwnershipFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	newOwner base.Address
+	currency ctypes.CurrencyID
+}
+
+func NewTransferCollectionOwnershipFact(
+	token []byte,
+	sender, contract base.Address,
+	newOwner base.Address,
+	currency ctypes.CurrencyID,
+) TransferCollectionOwnershipFact {
+	bf := base.NewBaseFact(TransferCollectionOwnershipFactHint, token)
+
+	fact := TransferCollectionOwnershipFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		newOwner: newOwner,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact TransferCollectionOwnershipFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.newOwner,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if fact.newOwner.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("new owner %v is same with contract", fact.newOwner)))
+	}
+
+	if fact.newOwner.Equal(fact.sender) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("new owner %v is same with sender", fact.newOwner)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact TransferCollectionOwnershipFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact TransferCollectionOwnershipFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact TransferCollectionOwnershipFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.newOwner.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact TransferCollectionOwnershipFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact TransferCollectionOwnershipFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact TransferCollectionOwnershipFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact TransferCollectionOwnershipFact) NewOwner() base.Address {
+	return fact.newOwner
+}
+
+func (fact TransferCollectionOwnershipFact) Currency() ctypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact TransferCollectionOwnershipFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.newOwner
+
+	return as, nil
+}
+
+func (fact TransferCollectionOwnershipFact) FeeBase() map[ctypes.CurrencyID][]common.Big {
+	required := make(map[ctypes.CurrencyID][]common.Big)
+	required[fact.Currency()] = []common.Big{common.ZeroBig}
+
+	return required
+}
+
+func (fact TransferCollectionOwnershipFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact TransferCollectionOwnershipFact) FeeItemCount() (uint, bool) {
+	return extras.ZeroItem, extras.HasNoItem
+}
+
+func (fact TransferCollectionOwnershipFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact TransferCollectionOwnershipFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact TransferCollectionOwnershipFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact TransferCollectionOwnershipFact) DupKey() (map[ctypes.DuplicationKeyType][]string, error) {
+	r := make(map[ctypes.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fact.sender.String()}
+	r[extras.DuplicationKeyTypeContractStatus] = []string{fact.contract.String()}
+
+	return r, nil
+}
+
+// TransferCollectionOwnership starts the ownership transfer of the collection
+// of the contract account to the new owner. The collection owner changes only
+// after the new owner accepts it with AcceptCollectionOwnership.
+type TransferCollectionOwnership struct {
+	extras.ExtendedOperation
+}
+
+func NewTransferCollectionOwnership(fact TransferCollectionOwnershipFact) (TransferCollectionOwnership, error) {
+	return TransferCollectionOwnership{
+		ExtendedOperation: extras.NewExtendedOperation(TransferCollectionOwnershipHint, fact),
+	}, nil
+}
@@ -0,0 +1,34 @@
+package nft
+
+import (
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+func (fact *TransferCollectionOwnershipFact) unpack(
+	enc encoder.Encoder,
+	sd, ct, nw, cid string,
+) error {
+	fact.currency = ctypes.CurrencyID(cid)
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	contract, err := base.DecodeAddress(ct, enc)
+	if err != nil {
+		return err
+	}
+	fact.contract = contract
+
+	newOwner, err := base.DecodeAddress(nw, enc)
+	if err != nil {
+		return err
+	}
+	fact.newOwner = newOwner
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type TransferCollectionOwnershipFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address      `json:"sender"`
+	Contract base.Address      `json:"contract"`
+	NewOwner base.Address      `json:"new_owner"`
+	Currency ctypes.CurrencyID `json:"currency"`
+}
+
+func (fact TransferCollectionOwnershipFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(TransferCollectionOwnershipFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		NewOwner:              fact.newOwner,
+		Currency:              fact.currency,
+	})
+}
+
+type TransferCollectionOwnershipFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string `json:"sender"`
+	Contract string `json:"contract"`
+	NewOwner string `json:"new_owner"`
+	Currency string `json:"currency"`
+}
+
+func (fact *TransferCollectionOwnershipFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u TransferCollectionOwnershipFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.NewOwner, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op TransferCollectionOwnership) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationMarshaler{
+		BaseOperationJSONMarshaler:           op.BaseOperation.JSONMarshaler(),
+		BaseOperationExtensionsJSONMarshaler: op.BaseOperationExtensions.JSONMarshaler(),
+	})
+}
+
+func (op *TransferCollectionOwnership) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	var ueo extras.BaseOperationExtensions
+	if err := ueo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperationExtensions = &ueo
+
+	return nil
+}
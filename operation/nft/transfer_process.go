@@ -36,6 +36,7 @@ type TransferItemProcessor struct {
 	h      util.Hash
 	sender base.Address
 	item   TransferItem
+	height base.Height
 }
 
 func (ipp *TransferItemProcessor) PreProcess(
@@ -64,7 +65,7 @@ func (ipp *TransferItemProcessor) PreProcess(
 				common.ErrServiceNF.Errorf("nft service state for contract account %v", it.Contract())))
 	}
 
-	design, err := state.StateCollectionValue(st)
+	design, err := state.EffectiveCollectionValue(st, ipp.height, getStateFunc)
 	if err != nil {
 		return e.Wrap(
 			common.ErrStateValInvalid.Wrap(
@@ -74,6 +75,10 @@ func (ipp *TransferItemProcessor) PreProcess(
 		return e.Wrap(common.ErrServiceNF.
 			Errorf("nft service in contract account %v has already been deactivated ", ipp.item.Contract()))
 	}
+	if design.Paused() {
+		return e.Wrap(common.ErrServiceNF.
+			Errorf("transfers of nft service in contract account %v are paused", ipp.item.Contract()))
+	}
 
 	st, err = cstate.ExistsState(state.StateKeyNFT(ipp.item.Contract(), nid), "nft", getStateFunc)
 	if err != nil {
@@ -89,6 +94,10 @@ func (ipp *TransferItemProcessor) PreProcess(
 		return e.Wrap(errors.Errorf("burned nft idx %v in contract account %v", nid, ipp.item.Contract()))
 	}
 
+	if err := checkNotDenied(it.Contract(), getStateFunc, ipp.sender, nv.Owner(), it.Receiver()); err != nil {
+		return e.Wrap(err)
+	}
+
 	if !(nv.Owner().Equal(ipp.sender) || nv.Approved().Equal(ipp.sender)) {
 		if st, err := cstate.ExistsState(
 			state.StateKeyOperators(ipp.item.Contract(), nv.Owner()), "operators", getStateFunc); err != nil {
@@ -147,7 +156,8 @@ func (ipp *TransferItemProcessor) Process(
 		return nil, errors.Errorf("nft value not found, %v: %v", nid, err)
 	}
 
-	n := types.NewNFT(nid, nv.Active(), receiver, nv.NFTHash(), nv.URI(), receiver, nv.Creators())
+	n := types.NewNFT(nid, nv.Active(), receiver, nv.NFTHash(), nv.URI(), receiver, nv.Creators()).
+		WithOptionalFields(*nv)
 	if err := n.IsValid(nil); err != nil {
 		return nil, errors.Errorf("invalid nft, %v: %v", nid, err)
 	}
@@ -165,6 +175,7 @@ func (ipp *TransferItemProcessor) Close() {
 	ipp.h = nil
 	ipp.sender = nil
 	ipp.item = TransferItem{}
+	ipp.height = 0
 
 	transferItemProcessorPool.Put(ipp)
 
@@ -205,34 +216,48 @@ func NewTransferProcessor() ctypes.GetNewProcessor {
 func (opp *TransferProcessor) PreProcess(
 	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
 ) (context.Context, base.OperationProcessReasonError, error) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
 	fact, ok := op.Fact().(TransferFact)
 	if !ok {
-		return ctx, base.NewBaseOperationProcessReasonError(
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
 			common.ErrMPreProcess.
 				Wrap(common.ErrMTypeMismatch).
 				Errorf("expected %T, not %T", TransferFact{}, op.Fact())), nil
 	}
 
 	if err := fact.IsValid(nil); err != nil {
-		return ctx, base.NewBaseOperationProcessReasonError(
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
 			common.ErrMPreProcess.
 				Errorf("%v", err)), nil
 	}
 
+	params, rErr := currentParams(getStateFunc)
+	if rErr != nil {
+		return ctx, rErr, nil
+	}
+
+	if err := params.CheckTransferItems(len(fact.Items())); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValOOR).
+				Errorf("%v", err)), nil
+	}
+
 	for _, item := range fact.Items() {
 		ip := transferItemProcessorPool.Get()
 		ipc, ok := ip.(*TransferItemProcessor)
 		if !ok {
-			return nil, base.NewBaseOperationProcessReasonError(
+			return nil, base.NewBaseOperationProcessReasonError("%s",
 				common.ErrMTypeMismatch.Errorf("expected TransferItemProcessor, not %T", ip)), nil
 		}
 
 		ipc.h = op.Hash()
 		ipc.sender = fact.Sender()
 		ipc.item = item
+		ipc.height = opp.Height()
 
 		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
-			return nil, base.NewBaseOperationProcessReasonError(
+			return nil, base.NewBaseOperationProcessReasonError("%s",
 				common.ErrMPreProcess.Errorf("%v", err),
 			), nil
 		}
@@ -247,6 +272,8 @@ func (opp *TransferProcessor) Process( // nolint:dupl
 	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
 	[]base.StateMergeValue, base.OperationProcessReasonError, error,
 ) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
 	e := util.StringError("failed to process Transfer")
 
 	fact, _ := op.Fact().(TransferFact)
@@ -261,6 +288,7 @@ func (opp *TransferProcessor) Process( // nolint:dupl
 		ipc.h = op.Hash()
 		ipc.sender = fact.Sender()
 		ipc.item = item
+		ipc.height = opp.Height()
 
 		s, err := ipc.Process(ctx, op, getStateFunc)
 		if err != nil {
@@ -0,0 +1,93 @@
+package nft
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+type testTransfer struct {
+	g                *testStateGetter
+	sender, receiver base.Address
+	contract         base.Address
+	policy           types.CollectionPolicy
+	priv             base.Privatekey
+}
+
+func newTestTransfer(t *testing.T) testTransfer {
+	t.Helper()
+
+	g := newTestStateGetter()
+
+	sender, priv := g.newAccount(t)
+	receiver, _ := g.newAccount(t)
+	policy := newTestCollectionPolicy()
+	contract := g.newCollection(t, sender, policy)
+
+	g.setNFT(contract, types.NewNFT(0, true, sender, "hash", "https://example.com/1", sender, types.NewSigners(nil)))
+
+	return testTransfer{g: g, sender: sender, receiver: receiver, contract: contract, policy: policy, priv: priv}
+}
+
+func (tt testTransfer) process(t *testing.T) error {
+	t.Helper()
+
+	op, err := NewTransfer(NewTransferFact(
+		[]byte("token"), tt.sender, []TransferItem{NewTransferItem(tt.contract, tt.receiver, 0, "MCC")}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := op.Sign(tt.priv, testNetworkID); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = processTestOperation(t, NewTransferProcessor(), op, tt.g.GetStateFunc)
+
+	return err
+}
+
+func TestTransferPaused(t *testing.T) {
+	tt := newTestTransfer(t)
+
+	if err := tt.process(t); err != nil {
+		t.Fatalf("transfer: %v", err)
+	}
+
+	tt.g.set(state.NFTStateKey(tt.contract, state.CollectionKey),
+		state.NewCollectionStateValue(types.NewDesign(tt.contract, tt.sender, true, true, 0, tt.policy)))
+
+	if err := tt.process(t); err == nil || !strings.Contains(err.Error(), "paused") {
+		t.Fatalf("transfer in paused collection: %v", err)
+	}
+}
+
+func TestTransferDenylist(t *testing.T) {
+	for _, c := range []struct {
+		name   string
+		denied func(testTransfer) base.Address
+	}{
+		{"sender", func(tt testTransfer) base.Address { return tt.sender }},
+		{"receiver", func(tt testTransfer) base.Address { return tt.receiver }},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			tt := newTestTransfer(t)
+
+			denied := c.denied(tt)
+			tt.g.set(state.StateKeyDenylist(tt.contract, denied), state.NewDenylistStateValue(true))
+
+			if err := tt.process(t); err == nil || !strings.Contains(err.Error(), "denylist") {
+				t.Fatalf("transfer with denied %v: %v", denied, err)
+			}
+
+			tt.g.set(state.StateKeyDenylist(tt.contract, denied), state.NewDenylistStateValue(false))
+
+			if err := tt.process(t); err != nil {
+				t.Fatalf("transfer with removed %v from denylist: %v", denied, err)
+			}
+		})
+	}
+}
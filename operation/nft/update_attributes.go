@@ -0,0 +1,211 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/imfact-labs/nft-model/operation/processor"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	UpdateAttributesFactHint = hint.MustNewHint("mitum-nft-update-attributes-operation-fact-v0.0.1")
+	UpdateAttributesHint     = hint.MustNewHint("mitum-nft-update-attributes-operation-v0.0.1")
+)
+
+type UpdateAttributesFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	nftIdx   uint64
+	set      types.Attributes
+	remove   []string
+	currency ctypes.CurrencyID
+}
+
+func NewUpdateAttributesFact(
+	token []byte,
+	sender, contract base.Address,
+	nftIdx uint64,
+	set types.Attributes,
+	remove []string,
+	currency ctypes.CurrencyID,
+) UpdateAttributesFact {
+	bf := base.NewBaseFact(UpdateAttributesFactHint, token)
+
+	fact := UpdateAttributesFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		nftIdx:   nftIdx,
+		set:      set,
+		remove:   remove,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateAttributesFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.set,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if len(fact.set) < 1 && len(fact.remove) < 1 {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("empty attribute update")))
+	}
+
+	if l := len(fact.remove); l > types.MaxAttributes {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrArrayLen.Wrap(errors.Errorf("remove keys over max, %d > %d", l, types.MaxAttributes)))
+	}
+
+	founds := map[string]struct{}{}
+	for _, a := range fact.set {
+		founds[a.Key()] = struct{}{}
+	}
+
+	for _, key := range fact.remove {
+		if !types.ReValidAttributeKey.MatchString(key) || len(key) > types.MaxAttributeKeyLength {
+			return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("wrong attribute key, %q", key)))
+		}
+
+		if _, found := founds[key]; found {
+			return common.ErrFactInvalid.Wrap(common.ErrDupVal.Wrap(errors.Errorf("attribute key, %v", key)))
+		}
+		founds[key] = struct{}{}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact UpdateAttributesFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateAttributesFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateAttributesFact) Bytes() []byte {
+	rs := make([][]byte, len(fact.remove))
+	for i, key := range fact.remove {
+		rs[i] = []byte(key)
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		util.Uint64ToBytes(fact.nftIdx),
+		fact.set.Bytes(),
+		types.LengthPrefixedBytes(rs...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UpdateAttributesFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateAttributesFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateAttributesFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact UpdateAttributesFact) NFT() uint64 {
+	return fact.nftIdx
+}
+
+func (fact UpdateAttributesFact) Set() types.Attributes {
+	return fact.set
+}
+
+func (fact UpdateAttributesFact) Remove() []string {
+	return fact.remove
+}
+
+func (fact UpdateAttributesFact) Currency() ctypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact UpdateAttributesFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+func (fact UpdateAttributesFact) FeeBase() map[ctypes.CurrencyID][]common.Big {
+	required := make(map[ctypes.CurrencyID][]common.Big)
+	required[fact.Currency()] = []common.Big{common.ZeroBig}
+
+	return required
+}
+
+func (fact UpdateAttributesFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateAttributesFact) FeeItemCount() (uint, bool) {
+	return extras.ZeroItem, extras.HasNoItem
+}
+
+func (fact UpdateAttributesFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateAttributesFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateAttributesFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact UpdateAttributesFact) DupKey() (map[ctypes.DuplicationKeyType][]string, error) {
+	r := make(map[ctypes.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fact.sender.String()}
+	r[processor.DuplicationTypeContractNFT] = []string{processor.DuplicationKeyNFT(fact.contract, fact.nftIdx)}
+
+	return r, nil
+}
+
+// UpdateAttributes sets and removes the attributes of a nft. It is allowed to
+// the collection owner and the attribute updater of the collection.
+type UpdateAttributes struct {
+	extras.ExtendedOperation
+}
+
+func NewUpdateAttributes(fact UpdateAttributesFact) (UpdateAttributes, error) {
+	return UpdateAttributes{
+		ExtendedOperation: extras.NewExtendedOperation(UpdateAttributesHint, fact),
+	}, nil
+}
@@ -0,0 +1,36 @@
+package nft
+
+import (
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+func (fact *UpdateAttributesFact) unpack(
+	enc encoder.Encoder,
+	sd, ct string,
+	nid uint64,
+	set types.Attributes,
+	rm []string,
+	cid string,
+) error {
+	fact.currency = ctypes.CurrencyID(cid)
+	fact.nftIdx = nid
+	fact.set = set
+	fact.remove = rm
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	contract, err := base.DecodeAddress(ct, enc)
+	if err != nil {
+		return err
+	}
+	fact.contract = contract
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+type UpdateAttributesFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address      `json:"sender"`
+	Contract base.Address      `json:"contract"`
+	NftIdx   uint64            `json:"nft_idx"`
+	Set      types.Attributes  `json:"set"`
+	Remove   []string          `json:"remove"`
+	Currency ctypes.CurrencyID `json:"currency"`
+}
+
+func (fact UpdateAttributesFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateAttributesFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		NftIdx:                fact.nftIdx,
+		Set:                   fact.set,
+		Remove:                fact.remove,
+		Currency:              fact.currency,
+	})
+}
+
+type UpdateAttributesFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string           `json:"sender"`
+	Contract string           `json:"contract"`
+	NftIdx   uint64           `json:"nft_idx"`
+	Set      types.Attributes `json:"set"`
+	Remove   []string         `json:"remove"`
+	Currency string           `json:"currency"`
+}
+
+func (fact *UpdateAttributesFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u UpdateAttributesFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.NftIdx, u.Set, u.Remove, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op UpdateAttributes) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationMarshaler{
+		BaseOperationJSONMarshaler:           op.BaseOperation.JSONMarshaler(),
+		BaseOperationExtensionsJSONMarshaler: op.BaseOperationExtensions.JSONMarshaler(),
+	})
+}
+
+func (op *UpdateAttributes) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	var ueo extras.BaseOperationExtensions
+	if err := ueo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperationExtensions = &ueo
+
+	return nil
+}
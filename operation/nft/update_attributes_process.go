@@ -0,0 +1,203 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+var updateAttributesProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(UpdateAttributesProcessor)
+	},
+}
+
+func (UpdateAttributes) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type UpdateAttributesProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewUpdateAttributesProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new UpdateAttributesProcessor")
+
+		nopp := updateAttributesProcessorPool.Get()
+		opp, ok := nopp.(*UpdateAttributesProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected UpdateAttributesProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *UpdateAttributesProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(UpdateAttributesFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", UpdateAttributesFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	st, err := cstate.ExistsState(state.NFTStateKey(fact.Contract(), state.CollectionKey), "design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service state for contract account %v", fact.Contract())), nil
+	}
+
+	design, err := state.EffectiveCollectionValue(st, opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service state value for contract account %v", fact.Contract())), nil
+	}
+
+	if !design.Active() {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service in contract account %v has already been deactivated ", fact.Contract())), nil
+	}
+
+	policy, ok := design.Policy().(types.CollectionPolicy)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", types.CollectionPolicy{}, design.Policy())), nil
+	}
+
+	owner, err := isCollectionOwner(fact.Contract(), fact.Sender(), getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).Errorf("%v", err)), nil
+	}
+
+	if updater := policy.AttributeUpdater(); !owner &&
+		!(updater != nil && updater.Equal(fact.Sender())) {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("sender %v is neither the collection owner nor the attribute updater of contract account %v",
+					fact.Sender(), fact.Contract())), nil
+	}
+
+	st, err = cstate.ExistsState(state.StateKeyNFT(fact.Contract(), fact.NFT()), "nft", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+				Errorf("nft idx %v in contract account %v", fact.NFT(), fact.Contract())), nil
+	}
+
+	nv, err := state.StateNFTValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+				Errorf("nft idx %v in contract account %v", fact.NFT(), fact.Contract())), nil
+	}
+
+	if !nv.Active() {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("burned nft idx %v in contract account %v", fact.NFT(), fact.Contract())), nil
+	}
+
+	for _, key := range fact.Remove() {
+		if _, found := nv.Attributes().Get(key); !found {
+			return nil, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+					Errorf("attribute %v not found in nft idx %v", key, fact.NFT())), nil
+		}
+	}
+
+	if err := nv.Attributes().Update(fact.Set(), fact.Remove()).IsValid(nil); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	params, rErr := currentParams(getStateFunc)
+	if rErr != nil {
+		return ctx, rErr, nil
+	}
+
+	if err := params.CheckNFT(*nv); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValOOR).
+				Errorf("nft idx %v in contract account %v: %v", fact.NFT(), fact.Contract(), err)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *UpdateAttributesProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, _ := op.Fact().(UpdateAttributesFact)
+
+	st, err := cstate.ExistsState(state.StateKeyNFT(fact.Contract(), fact.NFT()), "nft", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft not found, %v: %w", fact.NFT(), err), nil
+	}
+
+	nv, err := state.StateNFTValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft value not found, %v: %w", fact.NFT(), err), nil
+	}
+
+	n := types.NewNFT(nv.ID(), nv.Active(), nv.Owner(), nv.NFTHash(), nv.URI(), nv.Approved(), nv.Creators()).
+		WithOptionalFields(*nv).
+		WithAttributes(nv.Attributes().Update(fact.Set(), fact.Remove()))
+	if err := n.IsValid(nil); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid nft, %v: %w", fact.NFT(), err), nil
+	}
+
+	return []base.StateMergeValue{
+		cstate.NewStateMergeValue(state.StateKeyNFT(fact.Contract(), fact.NFT()), state.NewNFTStateValue(n)),
+	}, nil, nil
+}
+
+func (opp *UpdateAttributesProcessor) Close() error {
+	updateAttributesProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,227 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	UpdateDenylistFactHint = hint.MustNewHint("mitum-nft-update-denylist-operation-fact-v0.0.1")
+	UpdateDenylistHint     = hint.MustNewHint("mitum-nft-update-denylist-operation-v0.0.1")
+)
+
+var MaxDenylistAccounts = 100
+
+var (
+	DenylistAdd    = DenylistMode("add")
+	DenylistRemove = DenylistMode("remove")
+)
+
+type DenylistMode string
+
+func (mode DenylistMode) IsValid([]byte) error {
+	if !(mode == DenylistAdd || mode == DenylistRemove) {
+		return common.ErrValueInvalid.Wrap(errors.Errorf("wrong denylist mode, %v", mode))
+	}
+
+	return nil
+}
+
+func (mode DenylistMode) Bytes() []byte {
+	return []byte(mode)
+}
+
+func (mode DenylistMode) String() string {
+	return string(mode)
+}
+
+func (mode DenylistMode) Equal(cmode DenylistMode) bool {
+	return string(mode) == string(cmode)
+}
+
+type UpdateDenylistFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	mode     DenylistMode
+	accounts []base.Address
+	currency ctypes.CurrencyID
+}
+
+func NewUpdateDenylistFact(
+	token []byte,
+	sender, contract base.Address,
+	mode DenylistMode,
+	accounts []base.Address,
+	currency ctypes.CurrencyID,
+) UpdateDenylistFact {
+	bf := base.NewBaseFact(UpdateDenylistFactHint, token)
+
+	fact := UpdateDenylistFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		mode:     mode,
+		accounts: accounts,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateDenylistFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.mode,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if l := len(fact.accounts); l < 1 {
+		return common.ErrFactInvalid.Wrap(common.ErrArrayLen.Wrap(errors.Errorf("empty accounts")))
+	} else if l > MaxDenylistAccounts {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrArrayLen.Wrap(errors.Errorf("accounts over allowed, %d > %d", l, MaxDenylistAccounts)))
+	}
+
+	founds := map[string]struct{}{}
+	for _, account := range fact.accounts {
+		if err := account.IsValid(nil); err != nil {
+			return common.ErrFactInvalid.Wrap(err)
+		}
+
+		if account.Equal(fact.contract) {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("denylist account is same with contract")))
+		}
+
+		if _, found := founds[account.String()]; found {
+			return common.ErrFactInvalid.Wrap(common.ErrDupVal.Wrap(errors.Errorf("denylist account, %v", account)))
+		}
+		founds[account.String()] = struct{}{}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact UpdateDenylistFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateDenylistFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateDenylistFact) Bytes() []byte {
+	as := make([][]byte, len(fact.accounts))
+	for i, account := range fact.accounts {
+		as[i] = account.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.mode.Bytes(),
+		fact.currency.Bytes(),
+		util.ConcatBytesSlice(as...),
+	)
+}
+
+func (fact UpdateDenylistFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateDenylistFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateDenylistFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact UpdateDenylistFact) Mode() DenylistMode {
+	return fact.mode
+}
+
+func (fact UpdateDenylistFact) Accounts() []base.Address {
+	return fact.accounts
+}
+
+func (fact UpdateDenylistFact) Currency() ctypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact UpdateDenylistFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+func (fact UpdateDenylistFact) FeeBase() map[ctypes.CurrencyID][]common.Big {
+	required := make(map[ctypes.CurrencyID][]common.Big)
+	required[fact.Currency()] = []common.Big{common.ZeroBig}
+
+	return required
+}
+
+func (fact UpdateDenylistFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateDenylistFact) FeeItemCount() (uint, bool) {
+	return extras.ZeroItem, extras.HasNoItem
+}
+
+func (fact UpdateDenylistFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateDenylistFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateDenylistFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact UpdateDenylistFact) DupKey() (map[ctypes.DuplicationKeyType][]string, error) {
+	r := make(map[ctypes.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fact.sender.String()}
+	r[extras.DuplicationKeyTypeContractStatus] = []string{fact.contract.String()}
+
+	return r, nil
+}
+
+type UpdateDenylist struct {
+	extras.ExtendedOperation
+}
+
+func NewUpdateDenylist(fact UpdateDenylistFact) (UpdateDenylist, error) {
+	return UpdateDenylist{
+		ExtendedOperation: extras.NewExtendedOperation(UpdateDenylistHint, fact),
+	}, nil
+}
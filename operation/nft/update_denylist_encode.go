@@ -0,0 +1,41 @@
+package nft
+
+import (
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+func (fact *UpdateDenylistFact) unpack(
+	enc encoder.Encoder,
+	sd, ct, md string,
+	acs []string,
+	cid string,
+) error {
+	fact.currency = ctypes.CurrencyID(cid)
+	fact.mode = DenylistMode(md)
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	contract, err := base.DecodeAddress(ct, enc)
+	if err != nil {
+		return err
+	}
+	fact.contract = contract
+
+	accounts := make([]base.Address, len(acs))
+	for i, ac := range acs {
+		account, err := base.DecodeAddress(ac, enc)
+		if err != nil {
+			return err
+		}
+		accounts[i] = account
+	}
+	fact.accounts = accounts
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/pkg/errors"
+)
+
+var updateDenylistProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(UpdateDenylistProcessor)
+	},
+}
+
+func (UpdateDenylist) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type UpdateDenylistProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewUpdateDenylistProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new UpdateDenylistProcessor")
+
+		nopp := updateDenylistProcessorPool.Get()
+		opp, ok := nopp.(*UpdateDenylistProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected UpdateDenylistProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *UpdateDenylistProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(UpdateDenylistFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", UpdateDenylistFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	st, err := cstate.ExistsState(state.NFTStateKey(fact.Contract(), state.CollectionKey), "design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service state for contract account %v", fact.Contract())), nil
+	}
+
+	design, err := state.EffectiveCollectionValue(st, opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service state value for contract account %v", fact.Contract())), nil
+	}
+
+	if !design.Active() {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
+				Errorf("nft service in contract account %v has already been deactivated ", fact.Contract())), nil
+	}
+
+	if err := checkCollectionOwner(*design, fact.Sender(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("%v", err)), nil
+	}
+
+	for _, account := range fact.Accounts() {
+		denied, err := isDenied(fact.Contract(), account, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).
+					Errorf("denylist of contract account %v, %v: %v", fact.Contract(), account, err)), nil
+		}
+
+		switch {
+		case fact.Mode() == DenylistAdd && denied:
+			return nil, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+					Errorf("account %v is already in the denylist of contract account %v", account, fact.Contract())), nil
+		case fact.Mode() == DenylistRemove && !denied:
+			return nil, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+					Errorf("account %v is not in the denylist of contract account %v", account, fact.Contract())), nil
+		}
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *UpdateDenylistProcessor) Process(
+	_ context.Context, op base.Operation, _ base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+
+	fact, _ := op.Fact().(UpdateDenylistFact)
+
+	sts := make([]base.StateMergeValue, len(fact.Accounts()))
+	for i, account := range fact.Accounts() {
+		sts[i] = cstate.NewStateMergeValue(
+			state.StateKeyDenylist(fact.Contract(), account),
+			state.NewDenylistStateValue(fact.Mode() == DenylistAdd),
+		)
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *UpdateDenylistProcessor) Close() error {
+	updateDenylistProcessorPool.Put(opp)
+
+	return nil
+}
+
+func isDenied(contract, account base.Address, getStateFunc base.GetStateFunc) (bool, error) {
+	switch st, found, err := getStateFunc(state.StateKeyDenylist(contract, account)); {
+	case err != nil:
+		return false, err
+	case !found:
+		return false, nil
+	default:
+		return state.StateDenylistValue(st)
+	}
+}
+
+// checkNotDenied returns error when one of the accounts is in the denylist of
+// the collection.
+func checkNotDenied(contract base.Address, getStateFunc base.GetStateFunc, accounts ...base.Address) error {
+	for _, account := range accounts {
+		switch denied, err := isDenied(contract, account, getStateFunc); {
+		case err != nil:
+			return common.ErrStateValInvalid.Wrap(
+				errors.Errorf("denylist of contract account %v, %v: %v", contract, account, err))
+		case denied:
+			return common.ErrAccountNAth.Wrap(
+				errors.Errorf("account %v is in the denylist of contract account %v", account, contract))
+		}
+	}
+
+	return nil
+}
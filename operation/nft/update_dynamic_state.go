@@ -0,0 +1,183 @@
+package nft
+
+import (
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/imfact-labs/nft-model/operation/processor"
+	"github.com/pkg/errors"
+)
+
+var MaxUpdateDynamicStateItems = 100
+
+var (
+	UpdateDynamicStateFactHint = hint.MustNewHint("mitum-nft-update-dynamic-state-operation-fact-v0.0.1")
+	UpdateDynamicStateHint     = hint.MustNewHint("mitum-nft-update-dynamic-state-operation-v0.0.1")
+)
+
+type UpdateDynamicStateFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []UpdateDynamicStateItem
+}
+
+func NewUpdateDynamicStateFact(
+	token []byte, sender base.Address, items []UpdateDynamicStateItem,
+) UpdateDynamicStateFact {
+	bf := base.NewBaseFact(UpdateDynamicStateFactHint, token)
+	fact := UpdateDynamicStateFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateDynamicStateFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if n := len(fact.items); n < 1 {
+		return common.ErrFactInvalid.Wrap(common.ErrArrayLen.Wrap(errors.Errorf("empty items")))
+	} else if n > MaxUpdateDynamicStateItems {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrArrayLen.Wrap(errors.Errorf("items, %d over max, %d", n, MaxUpdateDynamicStateItems)))
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	founds := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return common.ErrFactInvalid.Wrap(err)
+		}
+
+		if fact.sender.Equal(item.contract) {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+		}
+
+		k := fmt.Sprintf("%s:%v", item.contract, item.nftIdx)
+		if _, found := founds[k]; found {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrDupVal.Wrap(
+					errors.Errorf("nft idx %v in contract account %v", item.nftIdx, item.contract)))
+		}
+
+		founds[k] = struct{}{}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact UpdateDynamicStateFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateDynamicStateFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateDynamicStateFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+
+	for i, item := range fact.items {
+		is[i] = item.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact UpdateDynamicStateFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateDynamicStateFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateDynamicStateFact) Items() []UpdateDynamicStateItem {
+	return fact.items
+}
+
+func (fact UpdateDynamicStateFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+func (fact UpdateDynamicStateFact) FeeBase() map[types.CurrencyID][]common.Big {
+	required := make(map[types.CurrencyID][]common.Big)
+
+	for i := range fact.items {
+		cid := fact.items[i].Currency()
+		required[cid] = append(required[cid], common.ZeroBig)
+	}
+
+	return required
+}
+
+func (fact UpdateDynamicStateFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateDynamicStateFact) FeeItemCount() (uint, bool) {
+	return uint(len(fact.items)), extras.HasItem
+}
+
+func (fact UpdateDynamicStateFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateDynamicStateFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateDynamicStateFact) ActiveContract() []base.Address {
+	var arr []base.Address
+	for i := range fact.items {
+		arr = append(arr, fact.items[i].contract)
+	}
+	return arr
+}
+
+func (fact UpdateDynamicStateFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fact.sender.String()}
+	for _, item := range fact.items {
+		r[processor.DuplicationTypeContractNFT] = append(
+			r[processor.DuplicationTypeContractNFT], processor.DuplicationKeyNFT(item.contract, item.nftIdx))
+	}
+
+	return r, nil
+}
+
+// UpdateDynamicState updates the dynamic state of nfts by an oracle of their
+// collections. The ownership of the nfts is not changed.
+type UpdateDynamicState struct {
+	extras.ExtendedOperation
+}
+
+func NewUpdateDynamicState(fact UpdateDynamicStateFact) (UpdateDynamicState, error) {
+	return UpdateDynamicState{
+		ExtendedOperation: extras.NewExtendedOperation(UpdateDynamicStateHint, fact),
+	}, nil
+}
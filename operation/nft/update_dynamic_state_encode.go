@@ -0,0 +1,34 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+func (fact *UpdateDynamicStateFact) unmarshal(enc encoder.Encoder, sd string, bit []byte) error {
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	hit, err := enc.DecodeSlice(bit)
+	if err != nil {
+		return err
+	}
+
+	items := make([]UpdateDynamicStateItem, len(hit))
+	for i, hinter := range hit {
+		item, ok := hinter.(UpdateDynamicStateItem)
+		if !ok {
+			return common.ErrTypeMismatch.Wrap(errors.Errorf("expected UpdateDynamicStateItem, not %T", hinter))
+		}
+
+		items[i] = item
+	}
+	fact.items = items
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package nft
+
+import (
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+var UpdateDynamicStateItemHint = hint.MustNewHint("mitum-nft-update-dynamic-state-item-v0.0.1")
+
+type UpdateDynamicStateItem struct {
+	hint.BaseHinter
+	contract base.Address
+	nftIdx   uint64
+	level    uint64
+	score    int64
+	variant  string
+	currency ctypes.CurrencyID
+}
+
+func NewUpdateDynamicStateItem(
+	contract base.Address, nftIdx, level uint64, score int64, variant string, currency ctypes.CurrencyID,
+) UpdateDynamicStateItem {
+	return UpdateDynamicStateItem{
+		BaseHinter: hint.NewBaseHinter(UpdateDynamicStateItemHint),
+		contract:   contract,
+		nftIdx:     nftIdx,
+		level:      level,
+		score:      score,
+		variant:    variant,
+		currency:   currency,
+	}
+}
+
+func (it UpdateDynamicStateItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		it.BaseHinter,
+		it.contract,
+		it.currency,
+	); err != nil {
+		return err
+	}
+
+	return types.IsValidDynamicVariant(it.variant)
+}
+
+func (it UpdateDynamicStateItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.contract.Bytes(),
+		util.Uint64ToBytes(it.nftIdx),
+		util.Uint64ToBytes(it.level),
+		util.Int64ToBytes(it.score),
+		[]byte(it.variant),
+		it.currency.Bytes(),
+	)
+}
+
+func (it UpdateDynamicStateItem) Contract() base.Address {
+	return it.contract
+}
+
+func (it UpdateDynamicStateItem) NFTIdx() uint64 {
+	return it.nftIdx
+}
+
+func (it UpdateDynamicStateItem) Level() uint64 {
+	return it.level
+}
+
+func (it UpdateDynamicStateItem) Score() int64 {
+	return it.score
+}
+
+func (it UpdateDynamicStateItem) Variant() string {
+	return it.variant
+}
+
+func (it UpdateDynamicStateItem) Currency() ctypes.CurrencyID {
+	return it.currency
+}
@@ -0,0 +1,49 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func (it UpdateDynamicStateItem) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    it.Hint().String(),
+			"contract": it.contract,
+			"nft_idx":  it.nftIdx,
+			"level":    it.level,
+			"score":    it.score,
+			"variant":  it.variant,
+			"currency": it.currency,
+		})
+}
+
+type UpdateDynamicStateItemBSONUnmarshaler struct {
+	Hint     string `bson:"_hint"`
+	Contract string `bson:"contract"`
+	NFTIdx   uint64 `bson:"nft_idx"`
+	Level    uint64 `bson:"level"`
+	Score    int64  `bson:"score"`
+	Variant  string `bson:"variant"`
+	Currency string `bson:"currency"`
+}
+
+func (it *UpdateDynamicStateItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	var u UpdateDynamicStateItemBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *it)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *it)
+	}
+
+	if err := it.unpack(enc, ht, u.Contract, u.NFTIdx, u.Level, u.Score, u.Variant, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *it)
+	}
+
+	return nil
+}
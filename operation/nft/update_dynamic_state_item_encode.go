@@ -0,0 +1,34 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+func (it *UpdateDynamicStateItem) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	cAdr string,
+	idx, level uint64,
+	score int64,
+	variant, cid string,
+) error {
+	it.BaseHinter = hint.NewBaseHinter(ht)
+	it.currency = types.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(cAdr, enc); {
+	case err != nil:
+		return err
+	default:
+		it.contract = a
+	}
+
+	it.nftIdx = idx
+	it.level = level
+	it.score = score
+	it.variant = variant
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+type UpdateDynamicStateItemJSONMarshaler struct {
+	hint.BaseHinter
+	Contract base.Address     `json:"contract"`
+	NFTIdx   uint64           `json:"nft_idx"`
+	Level    uint64           `json:"level"`
+	Score    int64            `json:"score"`
+	Variant  string           `json:"variant"`
+	Currency types.CurrencyID `json:"currency"`
+}
+
+func (it UpdateDynamicStateItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateDynamicStateItemJSONMarshaler{
+		BaseHinter: it.BaseHinter,
+		Contract:   it.contract,
+		NFTIdx:     it.nftIdx,
+		Level:      it.level,
+		Score:      it.score,
+		Variant:    it.variant,
+		Currency:   it.currency,
+	})
+}
+
+type UpdateDynamicStateItemJSONUnmarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Contract string    `json:"contract"`
+	NFTIdx   uint64    `json:"nft_idx"`
+	Level    uint64    `json:"level"`
+	Score    int64     `json:"score"`
+	Variant  string    `json:"variant"`
+	Currency string    `json:"currency"`
+}
+
+func (it *UpdateDynamicStateItem) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u UpdateDynamicStateItemJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *it)
+	}
+
+	if err := it.unpack(enc, u.Hint, u.Contract, u.NFTIdx, u.Level, u.Score, u.Variant, u.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *it)
+	}
+
+	return nil
+}
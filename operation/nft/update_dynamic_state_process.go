@@ -0,0 +1,280 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+var updateDynamicStateItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(UpdateDynamicStateItemProcessor)
+	},
+}
+
+var updateDynamicStateProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(UpdateDynamicStateProcessor)
+	},
+}
+
+func (UpdateDynamicState) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type UpdateDynamicStateItemProcessor struct {
+	h      util.Hash
+	sender base.Address
+	item   UpdateDynamicStateItem
+	height base.Height
+	params types.Params
+}
+
+func (ipp *UpdateDynamicStateItemProcessor) PreProcess(
+	_ context.Context, _ base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	e := util.StringError("preprocess UpdateDynamicStateItemProcessor")
+
+	st, err := cstate.ExistsState(
+		state.NFTStateKey(ipp.item.Contract(), state.CollectionKey), "design", getStateFunc)
+	if err != nil {
+		return e.Wrap(
+			common.ErrServiceNF.Wrap(
+				errors.Errorf("nft service state for contract account %v", ipp.item.Contract())))
+	}
+
+	design, err := state.EffectiveCollectionValue(st, ipp.height, getStateFunc)
+	if err != nil {
+		return e.Wrap(
+			common.ErrServiceNF.Wrap(
+				errors.Errorf("nft service state value for contract account %v", ipp.item.Contract())))
+	}
+
+	if !design.Active() {
+		return e.Wrap(
+			common.ErrServiceNF.Wrap(
+				errors.Errorf("nft service in the contract account %v has been deactived", ipp.item.Contract())))
+	}
+
+	policy, ok := design.Policy().(types.CollectionPolicy)
+	if !ok {
+		return e.Wrap(common.ErrTypeMismatch.Wrap(
+			errors.Errorf("expected %T, not %T", types.CollectionPolicy{}, design.Policy())))
+	}
+
+	rule := policy.OracleRule()
+	if !rule.IsOracle(ipp.sender) {
+		return e.Wrap(common.ErrAccountNAth.Wrap(
+			errors.Errorf("sender %v is not oracle of contract account %v", ipp.sender, ipp.item.Contract())))
+	}
+
+	st, err = cstate.ExistsState(state.StateKeyNFT(ipp.item.Contract(), ipp.item.NFTIdx()), "nft", getStateFunc)
+	if err != nil {
+		return e.Wrap(common.ErrStateNF.Wrap(
+			errors.Errorf("nft idx %v in contract account %v", ipp.item.NFTIdx(), ipp.item.Contract())))
+	}
+
+	nv, err := state.StateNFTValue(st)
+	if err != nil {
+		return e.Wrap(common.ErrStateValInvalid.Wrap(
+			errors.Errorf("nft idx %v in contract account %v", ipp.item.NFTIdx(), ipp.item.Contract())))
+	}
+
+	if !nv.Active() {
+		return e.Wrap(common.ErrStateValInvalid.Wrap(
+			errors.Errorf("burned nft idx %v in contract account %v", ipp.item.NFTIdx(), ipp.item.Contract())))
+	}
+
+	if err := ipp.params.CheckNFT(*nv); err != nil {
+		return e.Wrap(common.ErrValOOR.Wrap(
+			errors.Errorf("nft idx %v in contract account %v: %v", ipp.item.NFTIdx(), ipp.item.Contract(), err)))
+	}
+
+	switch st, found, err := getStateFunc(state.StateKeyDynamic(ipp.item.Contract(), ipp.item.NFTIdx())); {
+	case err != nil:
+		return e.Wrap(err)
+	case found:
+		d, err := state.StateDynamicValue(st)
+		if err != nil {
+			return e.Wrap(common.ErrStateValInvalid.Wrap(
+				errors.Errorf("dynamic state of nft idx %v in contract account %v", ipp.item.NFTIdx(), ipp.item.Contract())))
+		}
+
+		if !rule.CanUpdate(d.Height(), ipp.height) {
+			return e.Wrap(common.ErrValueInvalid.Wrap(
+				errors.Errorf(
+					"dynamic state of nft idx %v in contract account %v updated at height %v; interval %d",
+					ipp.item.NFTIdx(), ipp.item.Contract(), d.Height(), rule.Interval())))
+		}
+	}
+
+	return nil
+}
+
+func (ipp *UpdateDynamicStateItemProcessor) Process(
+	_ context.Context, _ base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	k := state.StateKeyDynamic(ipp.item.Contract(), ipp.item.NFTIdx())
+
+	var counter uint64
+	switch st, found, err := getStateFunc(k); {
+	case err != nil:
+		return nil, err
+	case found:
+		d, err := state.StateDynamicValue(st)
+		if err != nil {
+			return nil, util.ErrNotFound.Errorf("dynamic state value, %v: %v", ipp.item.NFTIdx(), err)
+		}
+		counter = d.Counter()
+	}
+
+	d := types.NewDynamicState(
+		ipp.item.Level(), ipp.item.Score(), ipp.item.Variant(), counter+1, ipp.height)
+	if err := d.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	return []base.StateMergeValue{cstate.NewStateMergeValue(k, state.NewDynamicStateValue(d))}, nil
+}
+
+func (ipp *UpdateDynamicStateItemProcessor) Close() {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = UpdateDynamicStateItem{}
+	ipp.height = 0
+	ipp.params = types.Params{}
+
+	updateDynamicStateItemProcessorPool.Put(ipp)
+}
+
+type UpdateDynamicStateProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewUpdateDynamicStateProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new UpdateDynamicStateProcessor")
+
+		nopp := updateDynamicStateProcessorPool.Get()
+		opp, ok := nopp.(*UpdateDynamicStateProcessor)
+		if !ok {
+			return nil, e.Errorf("expected UpdateDynamicStateProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *UpdateDynamicStateProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(UpdateDynamicStateFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", UpdateDynamicStateFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	params, rErr := currentParams(getStateFunc)
+	if rErr != nil {
+		return ctx, rErr, nil
+	}
+
+	for _, item := range fact.Items() {
+		ip := updateDynamicStateItemProcessorPool.Get()
+		ipc, ok := ip.(*UpdateDynamicStateItemProcessor)
+		if !ok {
+			return nil, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMTypeMismatch.Errorf("expected UpdateDynamicStateItemProcessor, not %T", ip)), nil
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.height = opp.Height()
+		ipc.params = params
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.Errorf("%v", err),
+			), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *UpdateDynamicStateProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	e := util.StringError("failed to process UpdateDynamicState")
+
+	fact, _ := op.Fact().(UpdateDynamicStateFact)
+
+	var sts []base.StateMergeValue // nolint:prealloc
+	for _, item := range fact.Items() {
+		ip := updateDynamicStateItemProcessorPool.Get()
+		ipc, ok := ip.(*UpdateDynamicStateItemProcessor)
+		if !ok {
+			return nil, nil, e.Errorf("expected UpdateDynamicStateItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.height = opp.Height()
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("process UpdateDynamicStateItem: %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipc.Close()
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *UpdateDynamicStateProcessor) Close() error {
+	updateDynamicStateProcessorPool.Put(opp)
+
+	return nil
+}
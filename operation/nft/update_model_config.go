@@ -19,13 +19,29 @@ var (
 
 type UpdateModelConfigFact struct {
 	base.BaseFact
-	sender    base.Address
-	contract  base.Address
-	name      types.CollectionName
-	royalty   types.PaymentParameter
-	uri       types.URI
-	whitelist []base.Address
-	currency  ctypes.CurrencyID
+	sender           base.Address
+	contract         base.Address
+	name             types.CollectionName
+	royalty          types.PaymentParameter
+	uri              types.URI
+	whitelist        []base.Address
+	pauser           base.Address
+	baseURI          types.URI
+	uriSuffix        types.URI
+	symbol           types.CollectionSymbol
+	description      types.CollectionDescription
+	externalURL      types.URI
+	contractURI      types.URI
+	hashAlgorithms   []types.HashAlgorithm
+	uriRule          types.URIRule
+	attributeUpdater base.Address
+	oracleRule       types.OracleRule
+	membershipRule   types.MembershipRule
+	maxRoyalty       types.PaymentParameter
+	adminRule        types.AdminRule
+	minUpdateDelay   uint64
+	effectiveAt      base.Height
+	currency         ctypes.CurrencyID
 }
 
 func NewUpdateModelConfigFact(
@@ -35,19 +51,49 @@ func NewUpdateModelConfigFact(
 	royalty types.PaymentParameter,
 	uri types.URI,
 	whitelist []base.Address,
+	pauser base.Address,
+	baseURI, uriSuffix types.URI,
+	symbol types.CollectionSymbol,
+	description types.CollectionDescription,
+	externalURL, contractURI types.URI,
+	hashAlgorithms []types.HashAlgorithm,
+	uriRule types.URIRule,
+	attributeUpdater base.Address,
+	oracleRule types.OracleRule,
+	membershipRule types.MembershipRule,
+	maxRoyalty types.PaymentParameter,
+	adminRule types.AdminRule,
+	minUpdateDelay uint64,
+	effectiveAt base.Height,
 	currency ctypes.CurrencyID,
 ) UpdateModelConfigFact {
 	bf := base.NewBaseFact(UpdateModelConfigFactHint, token)
 
 	fact := UpdateModelConfigFact{
-		BaseFact:  bf,
-		sender:    sender,
-		contract:  contract,
-		name:      name,
-		royalty:   royalty,
-		uri:       uri,
-		whitelist: whitelist,
-		currency:  currency,
+		BaseFact:         bf,
+		sender:           sender,
+		contract:         contract,
+		name:             name,
+		royalty:          royalty,
+		uri:              uri,
+		whitelist:        whitelist,
+		pauser:           pauser,
+		baseURI:          baseURI,
+		uriSuffix:        uriSuffix,
+		symbol:           symbol,
+		description:      description,
+		externalURL:      externalURL,
+		contractURI:      contractURI,
+		hashAlgorithms:   hashAlgorithms,
+		uriRule:          uriRule,
+		attributeUpdater: attributeUpdater,
+		oracleRule:       oracleRule,
+		membershipRule:   membershipRule,
+		maxRoyalty:       maxRoyalty,
+		adminRule:        adminRule,
+		minUpdateDelay:   minUpdateDelay,
+		effectiveAt:      effectiveAt,
+		currency:         currency,
 	}
 	fact.SetHash(fact.GenerateHash())
 
@@ -64,11 +110,6 @@ func (fact UpdateModelConfigFact) IsValid(b []byte) error {
 			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
 	}
 
-	if l := len(fact.whitelist); l > types.MaxWhitelist {
-		return common.ErrFactInvalid.Wrap(
-			common.ErrArrayLen.Wrap(errors.Errorf("whitelist over allowed, %d > %d", l, types.MaxWhitelist)))
-	}
-
 	if err := util.CheckIsValiders(
 		nil, false,
 		fact.sender,
@@ -76,11 +117,31 @@ func (fact UpdateModelConfigFact) IsValid(b []byte) error {
 		fact.name,
 		fact.royalty,
 		fact.uri,
+		fact.symbol,
+		fact.description,
+		fact.externalURL,
+		fact.contractURI,
 		fact.currency,
 	); err != nil {
 		return common.ErrFactInvalid.Wrap(err)
 	}
 
+	if err := types.IsValidBaseURI(fact.baseURI, fact.uriSuffix); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := types.IsValidHashAlgorithms(fact.hashAlgorithms); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := fact.uriRule.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := fact.uriRule.CheckURIs(fact.uri, fact.baseURI, fact.externalURL, fact.contractURI); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
 	founds := map[string]struct{}{}
 	for _, white := range fact.whitelist {
 		if err := white.IsValid(nil); err != nil {
@@ -98,6 +159,68 @@ func (fact UpdateModelConfigFact) IsValid(b []byte) error {
 		founds[white.String()] = struct{}{}
 	}
 
+	if fact.pauser != nil {
+		if err := fact.pauser.IsValid(nil); err != nil {
+			return common.ErrFactInvalid.Wrap(err)
+		}
+
+		if fact.pauser.Equal(fact.contract) {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("pauser account is same with contract")))
+		}
+	}
+
+	if fact.attributeUpdater != nil {
+		if err := fact.attributeUpdater.IsValid(nil); err != nil {
+			return common.ErrFactInvalid.Wrap(err)
+		}
+
+		if fact.attributeUpdater.Equal(fact.contract) {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("attribute updater account is same with contract")))
+		}
+	}
+
+	if err := fact.oracleRule.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	for _, oracle := range fact.oracleRule.Oracles() {
+		if oracle.Equal(fact.contract) {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("oracle %v is same with contract account", oracle)))
+		}
+	}
+
+	if err := fact.membershipRule.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if t := fact.membershipRule.Treasury(); t != nil && t.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("treasury %v is same with contract account", t)))
+	}
+
+	if err := fact.maxRoyalty.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := fact.adminRule.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	for _, admin := range fact.adminRule.Admins() {
+		if admin.Equal(fact.contract) {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrSelfTarget.Wrap(errors.Errorf("admin %v is same with contract account", admin)))
+		}
+	}
+
+	if fact.maxRoyalty > 0 && fact.royalty > fact.maxRoyalty {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrValOOR.Wrap(errors.Errorf("royalty over max royalty, %d > %d", fact.royalty, fact.maxRoyalty)))
+	}
+
 	if err := common.IsValidOperationFact(fact, b); err != nil {
 		return common.ErrFactInvalid.Wrap(err)
 	}
@@ -119,6 +242,36 @@ func (fact UpdateModelConfigFact) Bytes() []byte {
 		as[i] = white.Bytes()
 	}
 
+	var pb []byte
+	if fact.pauser != nil {
+		pb = fact.pauser.Bytes()
+	}
+
+	var ub []byte
+	if fact.attributeUpdater != nil {
+		ub = fact.attributeUpdater.Bytes()
+	}
+
+	var mb []byte
+	if fact.maxRoyalty > 0 {
+		mb = fact.maxRoyalty.Bytes()
+	}
+
+	var db []byte
+	if fact.minUpdateDelay > 0 {
+		db = util.Uint64ToBytes(fact.minUpdateDelay)
+	}
+
+	var eb []byte
+	if fact.effectiveAt > 0 {
+		eb = fact.effectiveAt.Bytes()
+	}
+
+	hs := make([][]byte, len(fact.hashAlgorithms))
+	for i, ha := range fact.hashAlgorithms {
+		hs[i] = ha.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		fact.Token(),
 		fact.sender.Bytes(),
@@ -128,6 +281,24 @@ func (fact UpdateModelConfigFact) Bytes() []byte {
 		fact.uri.Bytes(),
 		fact.currency.Bytes(),
 		util.ConcatBytesSlice(as...),
+		types.OptionalBytes(
+			pb,
+			fact.baseURI.Bytes(),
+			fact.uriSuffix.Bytes(),
+			fact.symbol.Bytes(),
+			fact.description.Bytes(),
+			fact.externalURL.Bytes(),
+			fact.contractURI.Bytes(),
+			util.ConcatBytesSlice(hs...),
+			fact.uriRule.Bytes(),
+			ub,
+			fact.oracleRule.Bytes(),
+			fact.membershipRule.Bytes(),
+			mb,
+			fact.adminRule.Bytes(),
+			db,
+			eb,
+		),
 	)
 }
 
@@ -159,6 +330,70 @@ func (fact UpdateModelConfigFact) Whitelist() []base.Address {
 	return fact.whitelist
 }
 
+func (fact UpdateModelConfigFact) Pauser() base.Address {
+	return fact.pauser
+}
+
+func (fact UpdateModelConfigFact) AttributeUpdater() base.Address {
+	return fact.attributeUpdater
+}
+
+func (fact UpdateModelConfigFact) OracleRule() types.OracleRule {
+	return fact.oracleRule
+}
+
+func (fact UpdateModelConfigFact) MembershipRule() types.MembershipRule {
+	return fact.membershipRule
+}
+
+func (fact UpdateModelConfigFact) MaxRoyalty() types.PaymentParameter {
+	return fact.maxRoyalty
+}
+
+func (fact UpdateModelConfigFact) AdminRule() types.AdminRule {
+	return fact.adminRule
+}
+
+func (fact UpdateModelConfigFact) MinUpdateDelay() uint64 {
+	return fact.minUpdateDelay
+}
+
+func (fact UpdateModelConfigFact) EffectiveAt() base.Height {
+	return fact.effectiveAt
+}
+
+func (fact UpdateModelConfigFact) BaseURI() types.URI {
+	return fact.baseURI
+}
+
+func (fact UpdateModelConfigFact) URISuffix() types.URI {
+	return fact.uriSuffix
+}
+
+func (fact UpdateModelConfigFact) Symbol() types.CollectionSymbol {
+	return fact.symbol
+}
+
+func (fact UpdateModelConfigFact) Description() types.CollectionDescription {
+	return fact.description
+}
+
+func (fact UpdateModelConfigFact) ExternalURL() types.URI {
+	return fact.externalURL
+}
+
+func (fact UpdateModelConfigFact) ContractURI() types.URI {
+	return fact.contractURI
+}
+
+func (fact UpdateModelConfigFact) HashAlgorithms() []types.HashAlgorithm {
+	return fact.hashAlgorithms
+}
+
+func (fact UpdateModelConfigFact) URIRule() types.URIRule {
+	return fact.uriRule
+}
+
 func (fact UpdateModelConfigFact) Currency() ctypes.CurrencyID {
 	return fact.currency
 }
@@ -192,8 +427,8 @@ func (fact UpdateModelConfigFact) Signer() base.Address {
 	return fact.sender
 }
 
-func (fact UpdateModelConfigFact) ActiveContractOwnerHandlerOnly() [][2]base.Address {
-	return [][2]base.Address{{fact.contract, fact.sender}}
+func (fact UpdateModelConfigFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
 }
 
 func (fact UpdateModelConfigFact) DupKey() (map[ctypes.DuplicationKeyType][]string, error) {
@@ -12,16 +12,38 @@ import (
 func (fact UpdateModelConfigFact) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
-			"_hint":            fact.Hint().String(),
-			"hash":             fact.BaseFact.Hash().String(),
-			"token":            fact.BaseFact.Token(),
-			"sender":           fact.sender,
-			"contract":         fact.contract,
-			"name":             fact.name,
-			"royalty":          fact.royalty,
-			"uri":              fact.uri,
-			"minter_whitelist": fact.whitelist,
-			"currency":         fact.currency,
+			"_hint":                   fact.Hint().String(),
+			"hash":                    fact.BaseFact.Hash().String(),
+			"token":                   fact.BaseFact.Token(),
+			"sender":                  fact.sender,
+			"contract":                fact.contract,
+			"name":                    fact.name,
+			"royalty":                 fact.royalty,
+			"uri":                     fact.uri,
+			"minter_whitelist":        fact.whitelist,
+			"pauser":                  fact.pauser,
+			"base_uri":                fact.baseURI,
+			"uri_suffix":              fact.uriSuffix,
+			"symbol":                  fact.symbol,
+			"description":             fact.description,
+			"external_url":            fact.externalURL,
+			"contract_uri":            fact.contractURI,
+			"hash_algorithms":         fact.hashAlgorithms,
+			"uri_schemes":             fact.uriRule.Schemes(),
+			"uri_hosts":               fact.uriRule.Hosts(),
+			"attribute_updater":       fact.attributeUpdater,
+			"oracles":                 fact.oracleRule.Oracles(),
+			"dynamic_update_interval": fact.oracleRule.Interval(),
+			"membership_currency":     fact.membershipRule.Currency(),
+			"membership_amount":       fact.membershipRule.AmountString(),
+			"membership_period":       fact.membershipRule.Period(),
+			"treasury":                fact.membershipRule.Treasury(),
+			"max_royalty":             fact.maxRoyalty,
+			"admins":                  fact.adminRule.Admins(),
+			"admin_threshold":         fact.adminRule.Threshold(),
+			"min_update_delay":        fact.minUpdateDelay,
+			"effective_at":            fact.effectiveAt,
+			"currency":                fact.currency,
 		})
 }
 
@@ -33,6 +55,28 @@ type UpdateModelConfigFactBSONUnmarshaler struct {
 	Royalty   uint     `bson:"royalty"`
 	URI       string   `bson:"uri"`
 	Whitelist []string `bson:"minter_whitelist"`
+	Pauser    string   `bson:"pauser"`
+	BaseURI   string   `bson:"base_uri"`
+	URISuffix string   `bson:"uri_suffix"`
+	Symbol    string   `bson:"symbol"`
+	Desc      string   `bson:"description"`
+	External  string   `bson:"external_url"`
+	CURI      string   `bson:"contract_uri"`
+	HashAlgs  []string `bson:"hash_algorithms"`
+	Schemes   []string `bson:"uri_schemes"`
+	Hosts     []string `bson:"uri_hosts"`
+	Updater   string   `bson:"attribute_updater"`
+	Oracles   []string `bson:"oracles"`
+	Dynamic   uint64   `bson:"dynamic_update_interval"`
+	MCID      string   `bson:"membership_currency"`
+	MAmount   string   `bson:"membership_amount"`
+	MPeriod   uint64   `bson:"membership_period"`
+	Treas     string   `bson:"treasury"`
+	MaxRoy    uint     `bson:"max_royalty"`
+	Admins    []string `bson:"admins"`
+	AdminTh   uint     `bson:"admin_threshold"`
+	Delay     uint64   `bson:"min_update_delay"`
+	Effective int64    `bson:"effective_at"`
 	Currency  string   `bson:"currency"`
 }
 
@@ -58,7 +102,7 @@ func (fact *UpdateModelConfigFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) er
 	}
 	fact.BaseHinter = hint.NewBaseHinter(ht)
 
-	if err := fact.unpack(enc, uf.Sender, uf.Contract, uf.Name, uf.Royalty, uf.URI, uf.Whitelist, uf.Currency); err != nil {
+	if err := fact.unpack(enc, uf.Sender, uf.Contract, uf.Name, uf.Royalty, uf.URI, uf.Whitelist, uf.Pauser, uf.BaseURI, uf.URISuffix, uf.Symbol, uf.Desc, uf.External, uf.CURI, uf.HashAlgs, uf.Schemes, uf.Hosts, uf.Updater, uf.Oracles, uf.Dynamic, uf.MCID, uf.MAmount, uf.MPeriod, uf.Treas, uf.MaxRoy, uf.Admins, uf.AdminTh, uf.Delay, uf.Effective, uf.Currency); err != nil {
 		return common.DecorateError(err, common.ErrDecodeBson, *fact)
 	}
 
@@ -1,6 +1,7 @@
 package nft
 
 import (
+	"github.com/imfact-labs/currency-model/common"
 	ctypes "github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util/encoder"
@@ -15,9 +16,28 @@ func (fact *UpdateModelConfigFact) unpack(
 	ry uint,
 	uri string,
 	bws []string,
+	ps string,
+	bu, sfx string,
+	sym, desc, ext, curi string,
+	has []string,
+	schemes, hosts []string,
+	up string,
+	ors []string,
+	interval uint64,
+	mcid, mam string,
+	period uint64,
+	treas string,
+	mry uint,
+	ads []string,
+	threshold uint,
+	delay uint64,
+	effectiveAt int64,
 	cid string,
 ) error {
 	fact.currency = ctypes.CurrencyID(cid)
+	fact.maxRoyalty = types.PaymentParameter(mry)
+	fact.minUpdateDelay = delay
+	fact.effectiveAt = base.Height(effectiveAt)
 
 	sender, err := base.DecodeAddress(sd, enc)
 	if err != nil {
@@ -34,6 +54,19 @@ func (fact *UpdateModelConfigFact) unpack(
 	fact.name = types.CollectionName(nm)
 	fact.royalty = types.PaymentParameter(ry)
 	fact.uri = types.URI(uri)
+	fact.baseURI = types.URI(bu)
+	fact.uriSuffix = types.URI(sfx)
+	fact.symbol = types.CollectionSymbol(sym)
+	fact.description = types.CollectionDescription(desc)
+	fact.externalURL = types.URI(ext)
+	fact.contractURI = types.URI(curi)
+
+	hashAlgorithms := make([]types.HashAlgorithm, len(has))
+	for i, ha := range has {
+		hashAlgorithms[i] = types.HashAlgorithm(ha)
+	}
+	fact.hashAlgorithms = hashAlgorithms
+	fact.uriRule = types.NewURIRule(schemes, hosts)
 
 	switch a, err := base.DecodeAddress(ct, enc); {
 	case err != nil:
@@ -52,5 +85,52 @@ func (fact *UpdateModelConfigFact) unpack(
 	}
 	fact.whitelist = whitelist
 
+	pauser, err := base.DecodeAddress(ps, enc)
+	if err != nil {
+		return err
+	}
+	fact.pauser = pauser
+
+	updater, err := base.DecodeAddress(up, enc)
+	if err != nil {
+		return err
+	}
+	fact.attributeUpdater = updater
+
+	var oracles []base.Address
+	for _, o := range ors {
+		oracle, err := base.DecodeAddress(o, enc)
+		if err != nil {
+			return err
+		}
+		oracles = append(oracles, oracle)
+	}
+	fact.oracleRule = types.NewOracleRule(oracles, interval)
+
+	var admins []base.Address
+	for _, ad := range ads {
+		admin, err := base.DecodeAddress(ad, enc)
+		if err != nil {
+			return err
+		}
+		admins = append(admins, admin)
+	}
+	fact.adminRule = types.NewAdminRule(admins, threshold)
+
+	amount := common.ZeroBig
+	if mam != "" {
+		a, err := common.NewBigFromString(mam)
+		if err != nil {
+			return err
+		}
+		amount = a
+	}
+
+	treasury, err := base.DecodeAddress(treas, enc)
+	if err != nil {
+		return err
+	}
+	fact.membershipRule = types.NewMembershipRule(ctypes.CurrencyID(mcid), amount, period, treasury)
+
 	return nil
 }
@@ -12,13 +12,35 @@ import (
 
 type UpdateModelConfigFactJSONMarshaler struct {
 	base.BaseFactJSONMarshaler
-	Sender    base.Address           `json:"sender"`
-	Contract  base.Address           `json:"contract"`
-	Name      types.CollectionName   `json:"name"`
-	Royalty   types.PaymentParameter `json:"royalty"`
-	URI       types.URI              `json:"uri"`
-	Whitelist []base.Address         `json:"minter_whitelist"`
-	Currency  ctypes.CurrencyID      `json:"currency"`
+	Sender           base.Address                `json:"sender"`
+	Contract         base.Address                `json:"contract"`
+	Name             types.CollectionName        `json:"name"`
+	Royalty          types.PaymentParameter      `json:"royalty"`
+	URI              types.URI                   `json:"uri"`
+	Whitelist        []base.Address              `json:"minter_whitelist"`
+	Pauser           base.Address                `json:"pauser,omitempty"`
+	BaseURI          types.URI                   `json:"base_uri,omitempty"`
+	URISuffix        types.URI                   `json:"uri_suffix,omitempty"`
+	Symbol           types.CollectionSymbol      `json:"symbol,omitempty"`
+	Description      types.CollectionDescription `json:"description,omitempty"`
+	ExternalURL      types.URI                   `json:"external_url,omitempty"`
+	ContractURI      types.URI                   `json:"contract_uri,omitempty"`
+	HashAlgorithms   []types.HashAlgorithm       `json:"hash_algorithms,omitempty"`
+	URISchemes       []string                    `json:"uri_schemes,omitempty"`
+	URIHosts         []string                    `json:"uri_hosts,omitempty"`
+	AttributeUpdater base.Address                `json:"attribute_updater,omitempty"`
+	Oracles          []base.Address              `json:"oracles,omitempty"`
+	DynamicInterval  uint64                      `json:"dynamic_update_interval,omitempty"`
+	MemberCurrency   ctypes.CurrencyID           `json:"membership_currency,omitempty"`
+	MemberAmount     string                      `json:"membership_amount,omitempty"`
+	MemberPeriod     uint64                      `json:"membership_period,omitempty"`
+	Treasury         base.Address                `json:"treasury,omitempty"`
+	MaxRoyalty       types.PaymentParameter      `json:"max_royalty,omitempty"`
+	Admins           []base.Address              `json:"admins,omitempty"`
+	AdminThreshold   uint                        `json:"admin_threshold,omitempty"`
+	MinUpdateDelay   uint64                      `json:"min_update_delay,omitempty"`
+	EffectiveAt      base.Height                 `json:"effective_at,omitempty"`
+	Currency         ctypes.CurrencyID           `json:"currency"`
 }
 
 func (fact UpdateModelConfigFact) MarshalJSON() ([]byte, error) {
@@ -30,19 +52,63 @@ func (fact UpdateModelConfigFact) MarshalJSON() ([]byte, error) {
 		Royalty:               fact.royalty,
 		URI:                   fact.uri,
 		Whitelist:             fact.whitelist,
+		Pauser:                fact.pauser,
+		BaseURI:               fact.baseURI,
+		URISuffix:             fact.uriSuffix,
+		Symbol:                fact.symbol,
+		Description:           fact.description,
+		ExternalURL:           fact.externalURL,
+		ContractURI:           fact.contractURI,
+		HashAlgorithms:        fact.hashAlgorithms,
+		URISchemes:            fact.uriRule.Schemes(),
+		URIHosts:              fact.uriRule.Hosts(),
+		AttributeUpdater:      fact.attributeUpdater,
+		Oracles:               fact.oracleRule.Oracles(),
+		DynamicInterval:       fact.oracleRule.Interval(),
+		MemberCurrency:        fact.membershipRule.Currency(),
+		MemberAmount:          fact.membershipRule.AmountString(),
+		MemberPeriod:          fact.membershipRule.Period(),
+		Treasury:              fact.membershipRule.Treasury(),
+		MaxRoyalty:            fact.maxRoyalty,
+		Admins:                fact.adminRule.Admins(),
+		AdminThreshold:        fact.adminRule.Threshold(),
+		MinUpdateDelay:        fact.minUpdateDelay,
+		EffectiveAt:           fact.effectiveAt,
 		Currency:              fact.currency,
 	})
 }
 
 type UpdateModelConfigFactJSONUnmarshaler struct {
 	base.BaseFactJSONUnmarshaler
-	Sender    string   `json:"sender"`
-	Contract  string   `json:"contract"`
-	Name      string   `json:"name"`
-	Royalty   uint     `json:"royalty"`
-	URI       string   `json:"uri"`
-	Whitelist []string `json:"minter_whitelist"`
-	Currency  string   `json:"currency"`
+	Sender           string   `json:"sender"`
+	Contract         string   `json:"contract"`
+	Name             string   `json:"name"`
+	Royalty          uint     `json:"royalty"`
+	URI              string   `json:"uri"`
+	Whitelist        []string `json:"minter_whitelist"`
+	Pauser           string   `json:"pauser"`
+	BaseURI          string   `json:"base_uri"`
+	URISuffix        string   `json:"uri_suffix"`
+	Symbol           string   `json:"symbol"`
+	Description      string   `json:"description"`
+	ExternalURL      string   `json:"external_url"`
+	ContractURI      string   `json:"contract_uri"`
+	HashAlgorithms   []string `json:"hash_algorithms"`
+	URISchemes       []string `json:"uri_schemes"`
+	URIHosts         []string `json:"uri_hosts"`
+	AttributeUpdater string   `json:"attribute_updater"`
+	Oracles          []string `json:"oracles"`
+	DynamicInterval  uint64   `json:"dynamic_update_interval"`
+	MemberCurrency   string   `json:"membership_currency"`
+	MemberAmount     string   `json:"membership_amount"`
+	MemberPeriod     uint64   `json:"membership_period"`
+	Treasury         string   `json:"treasury"`
+	MaxRoyalty       uint     `json:"max_royalty"`
+	Admins           []string `json:"admins"`
+	AdminThreshold   uint     `json:"admin_threshold"`
+	MinUpdateDelay   uint64   `json:"min_update_delay"`
+	EffectiveAt      int64    `json:"effective_at"`
+	Currency         string   `json:"currency"`
 }
 
 func (fact *UpdateModelConfigFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -53,7 +119,7 @@ func (fact *UpdateModelConfigFact) DecodeJSON(b []byte, enc encoder.Encoder) err
 
 	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
 
-	if err := fact.unpack(enc, u.Sender, u.Contract, u.Name, u.Royalty, u.URI, u.Whitelist, u.Currency); err != nil {
+	if err := fact.unpack(enc, u.Sender, u.Contract, u.Name, u.Royalty, u.URI, u.Whitelist, u.Pauser, u.BaseURI, u.URISuffix, u.Symbol, u.Description, u.ExternalURL, u.ContractURI, u.HashAlgorithms, u.URISchemes, u.URIHosts, u.AttributeUpdater, u.Oracles, u.DynamicInterval, u.MemberCurrency, u.MemberAmount, u.MemberPeriod, u.Treasury, u.MaxRoyalty, u.Admins, u.AdminThreshold, u.MinUpdateDelay, u.EffectiveAt, u.Currency); err != nil {
 		return common.DecorateError(err, common.ErrDecodeJson, *fact)
 	}
 
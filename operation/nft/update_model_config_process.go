@@ -60,16 +60,18 @@ func NewUpdateModelConfigProcessor() ctypes.GetNewProcessor {
 func (opp *UpdateModelConfigProcessor) PreProcess(
 	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
 ) (context.Context, base.OperationProcessReasonError, error) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
 	fact, ok := op.Fact().(UpdateModelConfigFact)
 	if !ok {
-		return ctx, base.NewBaseOperationProcessReasonError(
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
 			common.ErrMPreProcess.
 				Wrap(common.ErrMTypeMismatch).
 				Errorf("expected %T, not %T", UpdateModelConfigFact{}, op.Fact())), nil
 	}
 
 	if err := fact.IsValid(nil); err != nil {
-		return ctx, base.NewBaseOperationProcessReasonError(
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
 			common.ErrMPreProcess.
 				Errorf("%v", err)), nil
 	}
@@ -77,33 +79,128 @@ func (opp *UpdateModelConfigProcessor) PreProcess(
 	whitelist := fact.Whitelist()
 	for _, white := range whitelist {
 		if _, _, _, cErr := cstate.ExistsCAccount(white, "whitelist", true, false, getStateFunc); cErr != nil {
-			return ctx, base.NewBaseOperationProcessReasonError(
+			return ctx, base.NewBaseOperationProcessReasonError("%s",
 				common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
 					Errorf("%v: whitelist %v is contract account", cErr, white)), nil
 		}
 	}
 
+	if pauser := fact.Pauser(); pauser != nil {
+		if _, _, _, cErr := cstate.ExistsCAccount(pauser, "pauser", true, false, getStateFunc); cErr != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+					Errorf("%v: pauser %v is contract account", cErr, pauser)), nil
+		}
+	}
+
+	if updater := fact.AttributeUpdater(); updater != nil {
+		if _, _, _, cErr := cstate.ExistsCAccount(updater, "attribute updater", true, false, getStateFunc); cErr != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+					Errorf("%v: attribute updater %v is contract account", cErr, updater)), nil
+		}
+	}
+
+	for _, oracle := range fact.OracleRule().Oracles() {
+		if _, _, _, cErr := cstate.ExistsCAccount(oracle, "oracle", true, false, getStateFunc); cErr != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+					Errorf("%v: oracle %v is contract account", cErr, oracle)), nil
+		}
+	}
+
+	for _, admin := range fact.AdminRule().Admins() {
+		if _, _, _, cErr := cstate.ExistsCAccount(admin, "admin", true, false, getStateFunc); cErr != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+					Errorf("%v: admin %v is contract account", cErr, admin)), nil
+		}
+	}
+
+	if treasury := fact.MembershipRule().Treasury(); treasury != nil {
+		if _, _, _, cErr := cstate.ExistsCAccount(treasury, "treasury", true, false, getStateFunc); cErr != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+					Errorf("%v: treasury %v is contract account", cErr, treasury)), nil
+		}
+	}
+
+	params, rErr := currentParams(getStateFunc)
+	if rErr != nil {
+		return ctx, rErr, nil
+	}
+
+	if err := checkURIRule(
+		params, fact.URIRule(), fact.URI(), fact.BaseURI(), fact.ExternalURL(), fact.ContractURI()); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	if err := checkCollectionParams(params, fact.Whitelist(), fact.BaseURI(), fact.URISuffix(),
+		fact.URI(), fact.ExternalURL(), fact.ContractURI()); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("%v", err)), nil
+	}
+
 	st, err := cstate.ExistsState(state.NFTStateKey(fact.Contract(), state.CollectionKey), "design", getStateFunc)
 	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError(
+		return nil, base.NewBaseOperationProcessReasonError("%s",
 			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
 				Errorf("nft service state for contract account %v", fact.Contract())), nil
 
 	}
 
-	design, err := state.StateCollectionValue(st)
+	design, err := state.EffectiveCollectionValue(st, opp.Height(), getStateFunc)
 	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError(
+		return nil, base.NewBaseOperationProcessReasonError("%s",
 			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
 				Errorf("nft service state value for contract account %v", fact.Contract())), nil
 	}
 
 	if !design.Active() {
-		return nil, base.NewBaseOperationProcessReasonError(
+		return nil, base.NewBaseOperationProcessReasonError("%s",
 			common.ErrMPreProcess.Wrap(common.ErrMServiceNF).
 				Errorf("nft service in contract account %v has already been deactivated ", fact.Contract())), nil
 	}
 
+	if err := checkCollectionOwner(*design, fact.Sender(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("%v", err)), nil
+	}
+
+	// a collection can not become or stop being a membership collection.
+	if policy, ok := design.Policy().(types.CollectionPolicy); ok &&
+		policy.MembershipRule().IsMembership() != fact.MembershipRule().IsMembership() {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("membership of collection in contract account %v can not be changed", fact.Contract())), nil
+	}
+
+	// the config of a collection with admins is changed only by proposals.
+	if policy, ok := design.Policy().(types.CollectionPolicy); ok && !policy.AdminRule().IsEmpty() {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("config of collection in contract account %v is changed by admin proposals", fact.Contract())), nil
+	}
+
+	if effectiveAt := fact.EffectiveAt(); effectiveAt > 0 && effectiveAt <= opp.Height() {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValOOR).
+				Errorf("effective height %v not over current height %v", effectiveAt, opp.Height())), nil
+	}
+
+	// the new policy takes effect at least min update delay blocks later.
+	if policy, ok := design.Policy().(types.CollectionPolicy); ok && policy.MinUpdateDelay() > 0 &&
+		fact.EffectiveAt() < opp.Height()+base.Height(policy.MinUpdateDelay()) {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValOOR).
+				Errorf("effective height %v under min update delay %d of collection in contract account %v",
+					fact.EffectiveAt(), policy.MinUpdateDelay(), fact.Contract())), nil
+	}
+
 	return ctx, nil, nil
 }
 
@@ -111,6 +208,8 @@ func (opp *UpdateModelConfigProcessor) Process(
 	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
 	[]base.StateMergeValue, base.OperationProcessReasonError, error,
 ) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
 	fact, _ := op.Fact().(UpdateModelConfigFact)
 
 	st, err := cstate.ExistsState(state.NFTStateKey(fact.contract, state.CollectionKey), "design", getStateFunc)
@@ -118,7 +217,7 @@ func (opp *UpdateModelConfigProcessor) Process(
 		return nil, base.NewBaseOperationProcessReasonError("collection design not found, %v: %w", fact.Contract(), err), nil
 	}
 
-	design, err := state.StateCollectionValue(st)
+	design, err := state.EffectiveCollectionValue(st, opp.Height(), getStateFunc)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("collection design value not found, %v: %w", fact.Contract(), err), nil
 	}
@@ -134,12 +233,96 @@ func (opp *UpdateModelConfigProcessor) Process(
 		}
 	}
 
+	if pauser := fact.Pauser(); pauser != nil {
+		smv, err := cstate.CreateNotExistAccount(pauser, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		} else if smv != nil {
+			sts = append(sts, smv)
+		}
+	}
+
+	if updater := fact.AttributeUpdater(); updater != nil {
+		smv, err := cstate.CreateNotExistAccount(updater, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		} else if smv != nil {
+			sts = append(sts, smv)
+		}
+	}
+
+	for _, oracle := range fact.OracleRule().Oracles() {
+		smv, err := cstate.CreateNotExistAccount(oracle, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		} else if smv != nil {
+			sts = append(sts, smv)
+		}
+	}
+
+	for _, admin := range fact.AdminRule().Admins() {
+		smv, err := cstate.CreateNotExistAccount(admin, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		} else if smv != nil {
+			sts = append(sts, smv)
+		}
+	}
+
+	if treasury := fact.MembershipRule().Treasury(); treasury != nil {
+		smv, err := cstate.CreateNotExistAccount(treasury, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		} else if smv != nil {
+			sts = append(sts, smv)
+		}
+	}
+
+	policy, ok := design.Policy().(types.CollectionPolicy)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"expected %T, not %T", types.CollectionPolicy{}, design.Policy()), nil
+	}
+
+	newPolicy := types.NewCollectionPolicy(fact.name, fact.royalty, fact.uri, fact.whitelist).
+		WithPauser(fact.pauser).
+		WithClawback(policy.ClawbackEnabled()).
+		WithMintMode(policy.MintMode(), policy.MaxSupply()).
+		WithBaseURI(fact.baseURI, fact.uriSuffix).
+		WithMetadata(fact.symbol, fact.description, fact.externalURL, fact.contractURI).
+		WithHashAlgorithms(fact.hashAlgorithms).
+		WithURIRule(fact.uriRule).
+		WithAttributeUpdater(fact.attributeUpdater).
+		WithOracleRule(fact.oracleRule).
+		WithMembershipRule(fact.membershipRule).
+		WithMaxRoyalty(fact.maxRoyalty).
+		WithAdminRule(fact.adminRule).
+		WithMinUpdateDelay(fact.minUpdateDelay)
+
+	// a scheduled policy is kept in the pending policy state; the design is
+	// written with the effective policy, so a due policy replaced by this one
+	// is not lost.
+	if fact.effectiveAt > 0 {
+		sts = append(sts,
+			cstate.NewStateMergeValue(state.NFTStateKey(fact.contract, state.CollectionKey), state.NewCollectionStateValue(*design)),
+			cstate.NewStateMergeValue(
+				state.StateKeyPendingPolicy(fact.contract), state.NewPendingPolicyStateValue(newPolicy, fact.effectiveAt)),
+		)
+
+		return sts, nil, nil
+	}
+
 	de := types.NewDesign(
-		design.Contract(), design.Creator(), design.Active(), design.Count(),
-		types.NewCollectionPolicy(fact.name, fact.royalty, fact.uri, fact.whitelist),
+		design.Contract(), design.Creator(), design.Active(), design.Paused(), design.Count(), newPolicy,
 	)
 	sts = append(sts, cstate.NewStateMergeValue(state.NFTStateKey(fact.contract, state.CollectionKey), state.NewCollectionStateValue(de)))
 
+	if smv, err := clearPendingPolicy(fact.contract, getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("pending policy not found, %v: %w", fact.Contract(), err), nil
+	} else if smv != nil {
+		sts = append(sts, smv)
+	}
+
 	return sts, nil, nil
 }
 
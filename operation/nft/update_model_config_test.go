@@ -0,0 +1,44 @@
+package nft
+
+import (
+	"testing"
+
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+func newTestUpdateModelConfigFact(
+	sender, contract base.Address,
+	maxRoyalty types.PaymentParameter,
+	minUpdateDelay uint64,
+	effectiveAt base.Height,
+) UpdateModelConfigFact {
+	return NewUpdateModelConfigFact(
+		[]byte("token"), sender, contract,
+		types.CollectionName("collection"), types.PaymentParameter(10), types.URI("https://example.com"), nil,
+		nil, "", "", "", "", "", "", nil, types.URIRule{}, nil, types.OracleRule{}, types.MembershipRule{},
+		maxRoyalty, types.AdminRule{}, minUpdateDelay, effectiveAt,
+		ctypes.CurrencyID("MCC"),
+	)
+}
+
+func TestUpdateModelConfigFactBytesOptionalFields(t *testing.T) {
+	sender, contract := newTestAddress(t), newTestAddress(t)
+
+	facts := map[string]UpdateModelConfigFact{
+		"max royalty":  newTestUpdateModelConfigFact(sender, contract, 5, 0, 0),
+		"delay":        newTestUpdateModelConfigFact(sender, contract, 0, 5, 0),
+		"effective at": newTestUpdateModelConfigFact(sender, contract, 0, 0, 5),
+	}
+
+	founds := map[string]string{}
+	for name, fact := range facts {
+		k := fact.Hash().String()
+		if found, ok := founds[k]; ok {
+			t.Fatalf("same fact hash of %s and %s", found, name)
+		}
+
+		founds[k] = name
+	}
+}
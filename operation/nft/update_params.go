@@ -0,0 +1,86 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/imfact-labs/nft-model/operation/processor"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+var (
+	UpdateParamsFactHint = hint.MustNewHint("mitum-nft-update-params-operation-fact-v0.0.1")
+	UpdateParamsHint     = hint.MustNewHint("mitum-nft-update-params-operation-v0.0.1")
+)
+
+// UpdateParamsFact replaces the chain-level nft params. It is signed by the
+// suffrage nodes like the currency policy updates.
+type UpdateParamsFact struct {
+	base.BaseFact
+	params types.Params
+}
+
+func NewUpdateParamsFact(token []byte, params types.Params) UpdateParamsFact {
+	fact := UpdateParamsFact{
+		BaseFact: base.NewBaseFact(UpdateParamsFactHint, token),
+		params:   params,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateParamsFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateParamsFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.params.Bytes(),
+	)
+}
+
+func (fact UpdateParamsFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false, fact.BaseHinter, fact.params); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact UpdateParamsFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateParamsFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateParamsFact) Params() types.Params {
+	return fact.params
+}
+
+func (fact UpdateParamsFact) DupKey() (map[ctypes.DuplicationKeyType][]string, error) {
+	r := make(map[ctypes.DuplicationKeyType][]string)
+	r[processor.DuplicationTypeParams] = []string{UpdateParamsFactHint.Type().String()}
+
+	return r, nil
+}
+
+type UpdateParams struct {
+	common.BaseNodeOperation
+}
+
+func NewUpdateParams(fact UpdateParamsFact) (UpdateParams, error) {
+	return UpdateParams{
+		BaseNodeOperation: common.NewBaseNodeOperation(UpdateParamsHint, fact),
+	}, nil
+}
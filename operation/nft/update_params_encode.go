@@ -0,0 +1,20 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+func (fact *UpdateParamsFact) unpack(enc encoder.Encoder, bp []byte) error {
+	if hinter, err := enc.Decode(bp); err != nil {
+		return err
+	} else if params, ok := hinter.(types.Params); !ok {
+		return common.ErrTypeMismatch.Wrap(errors.Errorf("expected Params, not %T", hinter))
+	} else {
+		fact.params = params
+	}
+
+	return nil
+}
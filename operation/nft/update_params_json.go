@@ -0,0 +1,54 @@
+package nft
+
+import (
+	"encoding/json"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+type UpdateParamsFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Params types.Params `json:"params"`
+}
+
+func (fact UpdateParamsFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateParamsFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Params:                fact.params,
+	})
+}
+
+type UpdateParamsFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Params json.RawMessage `json:"params"`
+}
+
+func (fact *UpdateParamsFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u UpdateParamsFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, u.Params); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op *UpdateParams) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseNodeOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseNodeOperation = ubo
+
+	return nil
+}
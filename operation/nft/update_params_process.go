@@ -0,0 +1,140 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/isaac"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/pkg/errors"
+)
+
+var updateParamsProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(UpdateParamsProcessor)
+	},
+}
+
+func (UpdateParams) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type UpdateParamsProcessor struct {
+	*base.BaseOperationProcessor
+	suffrage  base.Suffrage
+	threshold base.Threshold
+}
+
+func NewUpdateParamsProcessor(threshold base.Threshold) ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new UpdateParamsProcessor")
+
+		nopp := updateParamsProcessorPool.Get()
+		opp, ok := nopp.(*UpdateParamsProcessor)
+		if !ok {
+			return nil, e.Errorf("expected UpdateParamsProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.threshold = threshold
+
+		switch i, found, err := getStateFunc(isaac.SuffrageStateKey); {
+		case err != nil:
+			return nil, e.Wrap(err)
+		case !found, i == nil:
+			return nil, e.Errorf("empty suffrage state")
+		default:
+			sufstv, ok := i.Value().(base.SuffrageNodesStateValue)
+			if !ok {
+				return nil, e.Errorf("expected SuffrageNodesStateValue, not %T", i.Value())
+			}
+
+			suf, err := sufstv.Suffrage()
+			if err != nil {
+				return nil, e.Errorf("failed to get suffrage from state")
+			}
+
+			opp.suffrage = suf
+		}
+
+		return opp, nil
+	}
+}
+
+func (opp *UpdateParamsProcessor) PreProcess(
+	ctx context.Context, op base.Operation, _ base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	nop, ok := op.(UpdateParams)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", UpdateParams{}, op)), nil
+	}
+
+	if err := base.CheckFactSignsBySuffrage(opp.suffrage, opp.threshold, nop.NodeSigns()); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Wrap(common.ErrMSignInvalid).
+				Errorf("%v", common.ErrSignNE)), nil
+	}
+
+	fact, ok := op.Fact().(UpdateParamsFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", UpdateParamsFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+// Process replaces the params. The states written under the former params
+// are kept; the new params are checked by the following operations.
+func (opp *UpdateParamsProcessor) Process(
+	_ context.Context, op base.Operation, _ base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, ok := op.Fact().(UpdateParamsFact)
+	if !ok {
+		return nil, nil, errors.Errorf("expected %T, not %T", UpdateParamsFact{}, op.Fact())
+	}
+
+	return []base.StateMergeValue{
+		cstate.NewStateMergeValue(state.StateKeyParams, state.NewParamsStateValue(fact.Params())),
+	}, nil, nil
+}
+
+func (opp *UpdateParamsProcessor) Close() error {
+	opp.suffrage = nil
+	opp.threshold = 0
+
+	updateParamsProcessorPool.Put(opp)
+
+	return nil
+}
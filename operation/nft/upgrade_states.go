@@ -0,0 +1,189 @@
+package nft
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/imfact-labs/nft-model/operation/processor"
+	"github.com/pkg/errors"
+)
+
+var (
+	UpgradeStatesFactHint = hint.MustNewHint("mitum-nft-upgrade-states-operation-fact-v0.0.1")
+	UpgradeStatesHint     = hint.MustNewHint("mitum-nft-upgrade-states-operation-v0.0.1")
+)
+
+// MaxUpgradeNFTs is the most nfts upgraded by an UpgradeStates.
+var MaxUpgradeNFTs = 100
+
+type UpgradeStatesFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	nftIdxes []uint64
+	currency ctypes.CurrencyID
+}
+
+func NewUpgradeStatesFact(
+	token []byte,
+	sender, contract base.Address,
+	nftIdxes []uint64,
+	currency ctypes.CurrencyID,
+) UpgradeStatesFact {
+	bf := base.NewBaseFact(UpgradeStatesFactHint, token)
+
+	fact := UpgradeStatesFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		nftIdxes: nftIdxes,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpgradeStatesFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract", fact.sender)))
+	}
+
+	if l := len(fact.nftIdxes); l > MaxUpgradeNFTs {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrArrayLen.Wrap(errors.Errorf("nft idxes over allowed, %d > %d", l, MaxUpgradeNFTs)))
+	}
+
+	founds := map[uint64]struct{}{}
+	for _, idx := range fact.nftIdxes {
+		if _, found := founds[idx]; found {
+			return common.ErrFactInvalid.Wrap(common.ErrDupVal.Wrap(errors.Errorf("nft idx %v", idx)))
+		}
+		founds[idx] = struct{}{}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact UpgradeStatesFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpgradeStatesFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpgradeStatesFact) Bytes() []byte {
+	ib := make([][]byte, len(fact.nftIdxes))
+	for i := range fact.nftIdxes {
+		ib[i] = util.Uint64ToBytes(fact.nftIdxes[i])
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		util.ConcatBytesSlice(ib...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UpgradeStatesFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpgradeStatesFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UpgradeStatesFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact UpgradeStatesFact) NFTs() []uint64 {
+	return fact.nftIdxes
+}
+
+func (fact UpgradeStatesFact) Currency() ctypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact UpgradeStatesFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+func (fact UpgradeStatesFact) FeeBase() map[ctypes.CurrencyID][]common.Big {
+	required := make(map[ctypes.CurrencyID][]common.Big)
+	required[fact.Currency()] = []common.Big{common.ZeroBig}
+
+	return required
+}
+
+func (fact UpgradeStatesFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact UpgradeStatesFact) FeeItemCount() (uint, bool) {
+	return extras.ZeroItem, extras.HasNoItem
+}
+
+func (fact UpgradeStatesFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact UpgradeStatesFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact UpgradeStatesFact) ActiveContract() []base.Address {
+	return []base.Address{fact.contract}
+}
+
+func (fact UpgradeStatesFact) DupKey() (map[ctypes.DuplicationKeyType][]string, error) {
+	r := make(map[ctypes.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fact.sender.String()}
+	r[extras.DuplicationKeyTypeContractStatus] = []string{fact.contract.String()}
+	for _, idx := range fact.nftIdxes {
+		r[processor.DuplicationTypeContractNFT] = append(
+			r[processor.DuplicationTypeContractNFT], processor.DuplicationKeyNFT(fact.contract, idx))
+	}
+
+	return r, nil
+}
+
+// UpgradeStates rewrites the collection design and the nfts of a collection
+// stored in the older versions in the latest version. The values are not
+// changed, so anyone can upgrade them.
+type UpgradeStates struct {
+	extras.ExtendedOperation
+}
+
+func NewUpgradeStates(fact UpgradeStatesFact) (UpgradeStates, error) {
+	return UpgradeStates{
+		ExtendedOperation: extras.NewExtendedOperation(UpgradeStatesHint, fact),
+	}, nil
+}
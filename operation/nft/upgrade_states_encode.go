@@ -0,0 +1,31 @@
+package nft
+
+import (
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+func (fact *UpgradeStatesFact) unpack(
+	enc encoder.Encoder,
+	sd, ct string,
+	nids []uint64,
+	cid string,
+) error {
+	fact.currency = ctypes.CurrencyID(cid)
+	fact.nftIdxes = nids
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+	fact.sender = sender
+
+	contract, err := base.DecodeAddress(ct, enc)
+	if err != nil {
+		return err
+	}
+	fact.contract = contract
+
+	return nil
+}
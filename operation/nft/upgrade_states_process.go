@@ -0,0 +1,163 @@
+package nft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	cstate "github.com/imfact-labs/currency-model/state"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/nft-model/state"
+	"github.com/pkg/errors"
+)
+
+var upgradeStatesProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(UpgradeStatesProcessor)
+	},
+}
+
+func (UpgradeStates) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type UpgradeStatesProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewUpgradeStatesProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new UpgradeStatesProcessor")
+
+		nopp := upgradeStatesProcessorPool.Get()
+		opp, ok := nopp.(*UpgradeStatesProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected UpgradeStatesProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *UpgradeStatesProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, ok := op.Fact().(UpgradeStatesFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", UpgradeStatesFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	params, rErr := currentParams(getStateFunc)
+	if rErr != nil {
+		return ctx, rErr, nil
+	}
+
+	var upgrades int
+	for _, k := range upgradeStateKeys(fact) {
+		st, err := cstate.ExistsState(k, "upgrade", getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%s",
+				common.ErrMPreProcess.Wrap(common.ErrMStateNF).
+					Errorf("state %v of contract account %v", k, fact.Contract())), nil
+		}
+
+		v, upgraded := state.UpgradeStateValue(st.Value())
+		if !upgraded {
+			continue
+		}
+
+		if nv, ok := v.(state.NFTStateValue); ok {
+			if err := params.CheckNFT(nv.NFT); err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("%s",
+					common.ErrMPreProcess.Wrap(common.ErrMValOOR).
+						Errorf("state %v of contract account %v: %v", k, fact.Contract(), err)), nil
+			}
+		}
+
+		upgrades++
+	}
+
+	if upgrades < 1 {
+		return nil, base.NewBaseOperationProcessReasonError("%s",
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("states of contract account %v already in the latest version", fact.Contract())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *UpgradeStatesProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	getStateFunc = cachedGetStateFunc(getStateFunc)
+
+	fact, _ := op.Fact().(UpgradeStatesFact)
+
+	var sts []base.StateMergeValue
+	for _, k := range upgradeStateKeys(fact) {
+		st, err := cstate.ExistsState(k, "upgrade", getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("state not found, %v: %w", k, err), nil
+		}
+
+		v, upgraded := state.UpgradeStateValue(st.Value())
+		if !upgraded {
+			continue
+		}
+
+		if err := v.IsValid(nil); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("invalid upgraded state, %v: %w", k, err), nil
+		}
+
+		sts = append(sts, cstate.NewStateMergeValue(k, v))
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *UpgradeStatesProcessor) Close() error {
+	upgradeStatesProcessorPool.Put(opp)
+
+	return nil
+}
+
+// upgradeStateKeys returns the keys of the collection design and the nfts of
+// the fact.
+func upgradeStateKeys(fact UpgradeStatesFact) []string {
+	keys := make([]string, len(fact.NFTs())+1)
+	keys[0] = state.NFTStateKey(fact.Contract(), state.CollectionKey)
+	for i, idx := range fact.NFTs() {
+		keys[i+1] = state.StateKeyNFT(fact.Contract(), idx)
+	}
+
+	return keys
+}
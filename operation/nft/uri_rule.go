@@ -0,0 +1,22 @@
+package nft
+
+import (
+	"github.com/imfact-labs/nft-model/types"
+)
+
+// checkURIRule checks the uris against the uri rule of the collection or,
+// when the collection does not declare one, the default uri rule of the nft
+// params.
+func checkURIRule(params types.Params, rule types.URIRule, uris ...types.URI) error {
+	if rule.IsEmpty() {
+		rule = params.URIRule()
+	}
+
+	return rule.CheckURIs(uris...)
+}
+
+func designURIRule(design types.Design) types.URIRule {
+	policy, _ := design.Policy().(types.CollectionPolicy)
+
+	return policy.URIRule()
+}
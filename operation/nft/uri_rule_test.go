@@ -0,0 +1,56 @@
+package nft
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/imfact-labs/nft-model/types"
+)
+
+func TestMintDefaultURIRule(t *testing.T) {
+	params := types.DefaultParams().WithURIRule(types.NewURIRule([]string{"ipfs"}, nil))
+
+	cases := []struct {
+		name     string
+		params   types.Params
+		rule     types.URIRule
+		uri      types.URI
+		expected string
+	}{
+		{"no rule", types.DefaultParams(), types.URIRule{}, "https://example.com/1", ""},
+		{"default rule", params, types.URIRule{}, "ipfs://nft", ""},
+		{"out of default rule", params, types.URIRule{}, "https://example.com/1", "not allowed"},
+		{"collection rule over default rule", params, types.NewURIRule([]string{"https"}, nil), "https://example.com/1", ""},
+		{"out of collection rule", params, types.NewURIRule([]string{"https"}, nil), "ipfs://nft", "not allowed"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			g := newTestStateGetter()
+			g.setParams(c.params)
+
+			sender, priv := g.newAccount(t)
+			contract := g.newCollection(t, sender, types.NewCollectionPolicy("collection", 0, "", nil).WithURIRule(c.rule))
+
+			op, err := NewMint(NewMintFact([]byte("token"), sender, []MintItem{NewMintItem(
+				contract, sender, "hash", c.uri, types.NewSigners(nil), nil, 0, 0, nil, "MCC",
+			)}))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := op.Sign(priv, testNetworkID); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = processTestOperation(t, NewMintProcessor(), op, g.GetStateFunc)
+
+			switch {
+			case c.expected == "" && err != nil:
+				t.Fatalf("mint: %v", err)
+			case c.expected != "" && (err == nil || !strings.Contains(err.Error(), c.expected)):
+				t.Fatalf("expected %q, not %v", c.expected, err)
+			}
+		})
+	}
+}
@@ -1,10 +1,96 @@
 package processor
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/imfact-labs/currency-model/operation/extras"
+	cprocessor "github.com/imfact-labs/currency-model/operation/processor"
 	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/pkg/errors"
 )
 
 const (
 	DuplicationTypeContractNFT ctypes.DuplicationKeyType = "nft-id"
 	DuplicationTypeNFTApprove  ctypes.DuplicationKeyType = "nft-approve"
+	DuplicationTypeContent     ctypes.DuplicationKeyType = "nft-content"
+	DuplicationTypeSeries      ctypes.DuplicationKeyType = "nft-series"
+	DuplicationTypeParams      ctypes.DuplicationKeyType = "nft-params"
 )
+
+// collectionDuplicationTypes are the duplication keys scoped to a collection.
+// The nfts are keyed by contract and nft idx, and the collection design,
+// including the nft idx allocation of Mint, by contract. The chain-level nft
+// params have their own key.
+var collectionDuplicationTypes = []ctypes.DuplicationKeyType{
+	extras.DuplicationKeyTypeContractStatus,
+	DuplicationTypeContractNFT,
+	DuplicationTypeNFTApprove,
+	DuplicationTypeContent,
+	DuplicationTypeSeries,
+	DuplicationTypeParams,
+}
+
+// CheckDuplication rejects the operations of a proposal which update the same
+// collection states as a former operation. Every nft fact must have at least
+// one collection duplication key; the other facts are checked by the currency
+// CheckDuplication.
+func CheckDuplication(opr *cprocessor.OperationProcessor, op base.Operation) error {
+	fact := op.Fact()
+
+	if ht, ok := fact.(hint.Hinter); ok && strings.HasPrefix(ht.Hint().Type().String(), "mitum-nft-") {
+		keyer, ok := fact.(extras.DeDupeKeyer)
+		if !ok {
+			return errors.Errorf("%T not implemented DeDupeKeyer", fact)
+		}
+
+		keys, err := keyer.DupKey()
+		if err != nil {
+			return err
+		}
+
+		if !hasCollectionDuplicationKey(keys) {
+			return errors.Errorf("%T without collection duplication key", fact)
+		}
+	}
+
+	return cprocessor.CheckDuplication(opr, op)
+}
+
+// The currency CheckDuplication compares the duplication keys regardless of
+// their types, so every collection duplication key starts with its kind.
+
+// DuplicationKeyNFT is the duplication key of the nft of the collection.
+func DuplicationKeyNFT(contract base.Address, idx uint64) string {
+	return fmt.Sprintf("nft:%s:%d", contract, idx)
+}
+
+// DuplicationKeyOperators is the duplication key of the operators of the
+// account in the collection.
+func DuplicationKeyOperators(contract, account base.Address) string {
+	return fmt.Sprintf("operators:%s:%s", contract, account)
+}
+
+// DuplicationKeyContent is the duplication key of the content of the
+// collection.
+func DuplicationKeyContent(contract base.Address, id uint64) string {
+	return fmt.Sprintf("content:%s:%d", contract, id)
+}
+
+// DuplicationKeySeries is the duplication key of the series of the
+// collection.
+func DuplicationKeySeries(contract base.Address, id uint64) string {
+	return fmt.Sprintf("series:%s:%d", contract, id)
+}
+
+func hasCollectionDuplicationKey(keys map[ctypes.DuplicationKeyType][]string) bool {
+	for _, t := range collectionDuplicationTypes {
+		if len(keys[t]) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,130 @@
+package processor_test
+
+import (
+	"testing"
+
+	cprocessor "github.com/imfact-labs/currency-model/operation/processor"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/nft-model/operation/nft"
+	"github.com/imfact-labs/nft-model/operation/processor"
+)
+
+func newTestAddress(t *testing.T) base.Address {
+	t.Helper()
+
+	k, err := ctypes.NewBaseAccountKey(base.NewMPrivatekey().Publickey(), 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := ctypes.NewBaseAccountKeys([]ctypes.AccountKey{k}, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := ctypes.NewAddressFromKeys(keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return a
+}
+
+func newTestTransfer(t *testing.T, contract base.Address, idx uint64) base.Operation {
+	t.Helper()
+
+	item := nft.NewTransferItem(contract, newTestAddress(t), idx, "MCC")
+	op, err := nft.NewTransfer(nft.NewTransferFact([]byte("token"), newTestAddress(t), []nft.TransferItem{item}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return op
+}
+
+func newTestCreateSeries(t *testing.T, contract base.Address, id uint64) base.Operation {
+	t.Helper()
+
+	op, err := nft.NewCreateSeries(nft.NewCreateSeriesFact(
+		[]byte("token"), newTestAddress(t), contract, id, "series", "https://example.com", 10, 0, nil, "MCC",
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return op
+}
+
+func newTestStoreContent(t *testing.T, contract base.Address, id uint64) base.Operation {
+	t.Helper()
+
+	op, err := nft.NewStoreContent(nft.NewStoreContentFact(
+		[]byte("token"), newTestAddress(t), contract, id, 0, []byte("content"), "MCC",
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return op
+}
+
+func TestCheckDuplication(t *testing.T) {
+	contract := newTestAddress(t)
+
+	cases := []struct {
+		name      string
+		ops       []base.Operation
+		duplicate bool
+	}{
+		{
+			name:      "same nft",
+			ops:       []base.Operation{newTestTransfer(t, contract, 1), newTestTransfer(t, contract, 1)},
+			duplicate: true,
+		},
+		{
+			name: "different nfts",
+			ops:  []base.Operation{newTestTransfer(t, contract, 1), newTestTransfer(t, contract, 2)},
+		},
+		{
+			name: "same nft of different collections",
+			ops:  []base.Operation{newTestTransfer(t, contract, 1), newTestTransfer(t, newTestAddress(t), 1)},
+		},
+		{
+			name:      "same series",
+			ops:       []base.Operation{newTestCreateSeries(t, contract, 1), newTestCreateSeries(t, contract, 1)},
+			duplicate: true,
+		},
+		{
+			name:      "same content",
+			ops:       []base.Operation{newTestStoreContent(t, contract, 1), newTestStoreContent(t, contract, 1)},
+			duplicate: true,
+		},
+		{
+			name: "nft, series and content of the same id",
+			ops: []base.Operation{
+				newTestTransfer(t, contract, 1), newTestCreateSeries(t, contract, 1), newTestStoreContent(t, contract, 1),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			opr := cprocessor.NewOperationProcessor()
+
+			for i := range c.ops[:len(c.ops)-1] {
+				if err := processor.CheckDuplication(opr, c.ops[i]); err != nil {
+					t.Fatalf("operation %d: %v", i, err)
+				}
+			}
+
+			err := processor.CheckDuplication(opr, c.ops[len(c.ops)-1])
+			switch {
+			case c.duplicate && err == nil:
+				t.Fatal("duplicated operation not rejected")
+			case !c.duplicate && err != nil:
+				t.Fatalf("operation rejected, %v", err)
+			}
+		})
+	}
+}
@@ -39,3 +39,36 @@ func DefaultImportPS() *ps.PS {
 
 	return pps
 }
+
+// DefaultINITPS is the init process of the currency with the nft genesis
+// block generator. The hinters are not added; the init command adds the
+// hinters of the composed modules.
+func DefaultINITPS() *ps.PS {
+	pps := ps.NewPS("cmd-init")
+
+	_ = pps.
+		AddOK(launch.PNameEncoder, csteps.PEncoder, nil).
+		AddOK(launch.PNameDesign, launch.PLoadDesign, nil, launch.PNameEncoder).
+		AddOK(csteps.PNameDigestDesign, csteps.PLoadDigestDesign, nil, launch.PNameEncoder).
+		AddOK(launch.PNameTimeSyncer, launch.PStartTimeSyncer, launch.PCloseTimeSyncer, launch.PNameDesign).
+		AddOK(launch.PNameLocal, launch.PLocal, nil, launch.PNameDesign).
+		AddOK(launch.PNameBlockItemReaders, launch.PBlockItemReaders, nil, launch.PNameDesign).
+		AddOK(launch.PNameStorage, launch.PStorage, launch.PCloseStorage, launch.PNameLocal).
+		AddOK(steps.PNameGenerateGenesis, steps.PGenerateGenesis, nil, launch.PNameStorage, launch.PNameDesign)
+
+	_ = pps.POK(launch.PNameDesign).
+		PostAddOK(launch.PNameCheckDesign, launch.PCheckDesign).
+		PostAddOK(launch.PNameINITObjectCache, launch.PINITObjectCache).
+		PostAddOK(launch.PNameGenesisDesign, launch.PGenesisDesign)
+
+	_ = pps.POK(launch.PNameBlockItemReaders).
+		PreAddOK(launch.PNameBlockItemReadersDecompressFunc, launch.PBlockItemReadersDecompressFunc).
+		PostAddOK(launch.PNameRemotesBlockItemReaderFunc, launch.PRemotesBlockItemReaderFunc)
+
+	_ = pps.POK(launch.PNameStorage).
+		PreAddOK(launch.PNameCleanStorage, launch.PCleanStorage).
+		PreAddOK(launch.PNameCreateLocalFS, launch.PCreateLocalFS).
+		PreAddOK(launch.PNameLoadDatabase, launch.PLoadDatabase)
+
+	return pps
+}
@@ -12,9 +12,17 @@ var AddedHinters = []encoder.DecodeDetail{
 	{Hint: types.SignerHint, Instance: types.Signer{}},
 	{Hint: types.SignersHint, Instance: types.Signers{}},
 	{Hint: types.NFTHint, Instance: types.NFT{}},
+	{Hint: types.NFTV1Hint, Instance: types.NFT{}},
 	{Hint: types.DesignHint, Instance: types.Design{}},
 	{Hint: types.AllApprovedBookHint, Instance: types.AllApprovedBook{}},
 	{Hint: types.CollectionPolicyHint, Instance: types.CollectionPolicy{}},
+	{Hint: types.CollectionPolicyV1Hint, Instance: types.CollectionPolicy{}},
+	{Hint: types.RevealHint, Instance: types.Reveal{}},
+	{Hint: types.DynamicStateHint, Instance: types.DynamicState{}},
+	{Hint: types.ContentInfoHint, Instance: types.ContentInfo{}},
+	{Hint: types.SeriesHint, Instance: types.Series{}},
+	{Hint: types.ConfigProposalHint, Instance: types.ConfigProposal{}},
+	{Hint: types.ParamsHint, Instance: types.Params{}},
 
 	{Hint: nft.RegisterModelHint, Instance: nft.RegisterModel{}},
 	{Hint: nft.UpdateModelConfigHint, Instance: nft.UpdateModelConfig{}},
@@ -28,11 +36,46 @@ var AddedHinters = []encoder.DecodeDetail{
 	{Hint: nft.ApproveHint, Instance: nft.Approve{}},
 	{Hint: nft.AddSignatureItemHint, Instance: nft.AddSignatureItem{}},
 	{Hint: nft.AddSignatureHint, Instance: nft.AddSignature{}},
+	{Hint: nft.PauseHint, Instance: nft.Pause{}},
+	{Hint: nft.UpdateDenylistHint, Instance: nft.UpdateDenylist{}},
+	{Hint: nft.ForceTransferHint, Instance: nft.ForceTransfer{}},
+	{Hint: nft.CommitRevealHint, Instance: nft.CommitReveal{}},
+	{Hint: nft.RevealHint, Instance: nft.Reveal{}},
+	{Hint: nft.UpdateAttributesHint, Instance: nft.UpdateAttributes{}},
+	{Hint: nft.UpdateDynamicStateItemHint, Instance: nft.UpdateDynamicStateItem{}},
+	{Hint: nft.UpdateDynamicStateHint, Instance: nft.UpdateDynamicState{}},
+	{Hint: nft.StoreContentHint, Instance: nft.StoreContent{}},
+	{Hint: nft.FinalizeContentHint, Instance: nft.FinalizeContent{}},
+	{Hint: nft.RenewHint, Instance: nft.Renew{}},
+	{Hint: nft.CreateSeriesHint, Instance: nft.CreateSeries{}},
+	{Hint: nft.ProposeModelConfigHint, Instance: nft.ProposeModelConfig{}},
+	{Hint: nft.ApproveModelConfigHint, Instance: nft.ApproveModelConfig{}},
+	{Hint: nft.TransferCollectionOwnershipHint, Instance: nft.TransferCollectionOwnership{}},
+	{Hint: nft.AcceptCollectionOwnershipHint, Instance: nft.AcceptCollectionOwnership{}},
+	{Hint: nft.UpgradeStatesHint, Instance: nft.UpgradeStates{}},
+	{Hint: nft.GenesisNFTItemHint, Instance: nft.GenesisNFTItem{}},
+	{Hint: nft.GenesisCollectionFactHint, Instance: nft.GenesisCollectionFact{}},
+	{Hint: nft.GenesisCollectionHint, Instance: nft.GenesisCollection{}},
+	{Hint: nft.GenesisParamsFactHint, Instance: nft.GenesisParamsFact{}},
+	{Hint: nft.GenesisParamsHint, Instance: nft.GenesisParams{}},
+	{Hint: nft.UpdateParamsHint, Instance: nft.UpdateParams{}},
 
 	{Hint: state.LastNFTIndexStateValueHint, Instance: state.LastNFTIndexStateValue{}},
 	{Hint: state.NFTStateValueHint, Instance: state.NFTStateValue{}},
 	{Hint: state.OperatorsBookStateValueHint, Instance: state.OperatorsBookStateValue{}},
 	{Hint: state.CollectionStateValueHint, Instance: state.CollectionStateValue{}},
+	{Hint: state.DenylistStateValueHint, Instance: state.DenylistStateValue{}},
+	{Hint: state.ClawbackStateValueHint, Instance: state.ClawbackStateValue{}},
+	{Hint: state.RevealStateValueHint, Instance: state.RevealStateValue{}},
+	{Hint: state.MintPoolStateValueHint, Instance: state.MintPoolStateValue{}},
+	{Hint: state.DynamicStateValueHint, Instance: state.DynamicStateValue{}},
+	{Hint: state.ContentChunkStateValueHint, Instance: state.ContentChunkStateValue{}},
+	{Hint: state.ContentStateValueHint, Instance: state.ContentStateValue{}},
+	{Hint: state.SeriesStateValueHint, Instance: state.SeriesStateValue{}},
+	{Hint: state.ProposalsStateValueHint, Instance: state.ProposalsStateValue{}},
+	{Hint: state.PendingPolicyStateValueHint, Instance: state.PendingPolicyStateValue{}},
+	{Hint: state.OwnershipStateValueHint, Instance: state.OwnershipStateValue{}},
+	{Hint: state.ParamsStateValueHint, Instance: state.ParamsStateValue{}},
 }
 
 var AddedSupportedHinters = []encoder.DecodeDetail{
@@ -43,4 +86,21 @@ var AddedSupportedHinters = []encoder.DecodeDetail{
 	{Hint: nft.ApproveAllFactHint, Instance: nft.ApproveAllFact{}},
 	{Hint: nft.ApproveFactHint, Instance: nft.ApproveFact{}},
 	{Hint: nft.AddSignatureFactHint, Instance: nft.AddSignatureFact{}},
+	{Hint: nft.PauseFactHint, Instance: nft.PauseFact{}},
+	{Hint: nft.UpdateDenylistFactHint, Instance: nft.UpdateDenylistFact{}},
+	{Hint: nft.ForceTransferFactHint, Instance: nft.ForceTransferFact{}},
+	{Hint: nft.CommitRevealFactHint, Instance: nft.CommitRevealFact{}},
+	{Hint: nft.RevealFactHint, Instance: nft.RevealFact{}},
+	{Hint: nft.UpdateAttributesFactHint, Instance: nft.UpdateAttributesFact{}},
+	{Hint: nft.UpdateDynamicStateFactHint, Instance: nft.UpdateDynamicStateFact{}},
+	{Hint: nft.StoreContentFactHint, Instance: nft.StoreContentFact{}},
+	{Hint: nft.FinalizeContentFactHint, Instance: nft.FinalizeContentFact{}},
+	{Hint: nft.RenewFactHint, Instance: nft.RenewFact{}},
+	{Hint: nft.CreateSeriesFactHint, Instance: nft.CreateSeriesFact{}},
+	{Hint: nft.ProposeModelConfigFactHint, Instance: nft.ProposeModelConfigFact{}},
+	{Hint: nft.ApproveModelConfigFactHint, Instance: nft.ApproveModelConfigFact{}},
+	{Hint: nft.TransferCollectionOwnershipFactHint, Instance: nft.TransferCollectionOwnershipFact{}},
+	{Hint: nft.AcceptCollectionOwnershipFactHint, Instance: nft.AcceptCollectionOwnershipFact{}},
+	{Hint: nft.UpgradeStatesFactHint, Instance: nft.UpgradeStatesFact{}},
+	{Hint: nft.UpdateParamsFactHint, Instance: nft.UpdateParamsFact{}},
 }
@@ -0,0 +1,536 @@
+package steps
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/imfact-labs/currency-model/operation/currency"
+	isaacoperation "github.com/imfact-labs/currency-model/operation/isaac"
+	currencytypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/isaac"
+	"github.com/imfact-labs/mitum2/launch"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/logging"
+	"github.com/imfact-labs/nft-model/operation/nft"
+	nfttypes "github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// GenesisBlockGenerator generates the genesis block from the currency facts
+// and the nft genesis facts of the genesis design. The genesis block
+// generators of mitum2 and the currency model make the operations of their own
+// facts only and do not take the operations of the other models, so the
+// generator makes the currency operations like the currency model does and
+// adds the nft genesis operations.
+type GenesisBlockGenerator struct {
+	local                base.LocalNode
+	encs                 *encoder.Encoders
+	db                   isaac.Database
+	proposal             base.ProposalSignFact
+	ivp                  base.INITVoteproof
+	avp                  base.ACCEPTVoteproof
+	loadImportedBlockMap func() (base.BlockMap, bool, error)
+	*logging.Logging
+	dataroot  string
+	networkID base.NetworkID
+	facts     []base.Fact
+	ops       []base.Operation
+	ctx       context.Context
+}
+
+func NewGenesisBlockGenerator(
+	local base.LocalNode,
+	networkID base.NetworkID,
+	encs *encoder.Encoders,
+	db isaac.Database,
+	dataroot string,
+	facts []base.Fact,
+	loadImportedBlockMap func() (base.BlockMap, bool, error),
+	ctx context.Context,
+) *GenesisBlockGenerator {
+	return &GenesisBlockGenerator{
+		Logging: logging.NewLogging(func(zctx zerolog.Context) zerolog.Context {
+			return zctx.Str("module", "genesis-block-generator")
+		}),
+		local:                local,
+		networkID:            networkID,
+		encs:                 encs,
+		db:                   db,
+		dataroot:             dataroot,
+		facts:                facts,
+		loadImportedBlockMap: loadImportedBlockMap,
+		ctx:                  ctx,
+	}
+}
+
+func (g *GenesisBlockGenerator) Generate() (base.BlockMap, error) {
+	e := util.StringError("generate genesis block")
+
+	if err := g.generateOperations(); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	if err := g.newProposal(nil); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	if err := g.process(); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	switch blockmap, found, err := g.loadImportedBlockMap(); {
+	case err != nil:
+		return nil, e.Wrap(err)
+	case !found:
+		return nil, util.ErrNotFound.Errorf("Blockmap")
+	default:
+		if err := blockmap.IsValid(g.networkID); err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		g.Log().Info().Interface("blockmap", blockmap).Msg("genesis block generated")
+
+		if err := g.closeDatabase(); err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		return blockmap, nil
+	}
+}
+
+func (g *GenesisBlockGenerator) generateOperations() error {
+	g.ops = make([]base.Operation, len(g.facts))
+
+	types := map[string]struct{}{}
+
+	params, err := g.genesisParams()
+	if err != nil {
+		return err
+	}
+
+	contracts, err := g.genesisContracts()
+	if err != nil {
+		return err
+	}
+
+	for i := range g.facts {
+		fact := g.facts[i]
+
+		var err error
+
+		hinter, ok := fact.(hint.Hinter)
+		if !ok {
+			return errors.Errorf("Fact does not support Hinter")
+		}
+
+		switch ht := hinter.Hint(); {
+		case ht.IsCompatible(isaacoperation.SuffrageGenesisJoinFactHint):
+			if _, found := types[ht.String()]; found {
+				return errors.Errorf("Multiple join operation found")
+			}
+
+			g.ops[i], err = g.joinOperation(fact)
+		case ht.IsCompatible(isaacoperation.GenesisNetworkPolicyFactHint):
+			if _, found := types[ht.String()]; found {
+				return errors.Errorf("Multiple network policy operation found")
+			}
+
+			g.ops[i], err = g.networkPolicyOperation(fact)
+		case ht.IsCompatible(currency.RegisterGenesisCurrencyFactHint):
+			if _, found := types[ht.String()]; found {
+				return errors.Errorf("Multiple RegisterGenesisCurrency operation found")
+			}
+
+			g.ops[i], err = g.registerGenesisCurrencyOperation(fact, g.networkID)
+		case ht.IsCompatible(nft.GenesisParamsFactHint):
+			if _, found := types[ht.String()]; found {
+				return errors.Errorf("Multiple GenesisParams operation found")
+			}
+
+			g.ops[i], err = g.genesisParamsOperation(fact)
+		case ht.IsCompatible(nft.GenesisCollectionFactHint):
+			g.ops[i], err = g.genesisCollectionOperation(fact, contracts, params)
+		default:
+			err = errors.Errorf("Unknown genesis fact, %v", ht)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		types[hinter.Hint().String()] = struct{}{}
+	}
+
+	// the genesis operations are processed in order; the genesis collections
+	// come first, so the accounts of the other genesis operations replace the
+	// accounts created by the collections.
+	sort.SliceStable(g.ops, func(i, j int) bool {
+		_, a := g.ops[i].(nft.GenesisCollection)
+		_, b := g.ops[j].(nft.GenesisCollection)
+
+		return a && !b
+	})
+
+	return nil
+}
+
+func (g *GenesisBlockGenerator) joinOperation(i base.Fact) (base.Operation, error) {
+	e := util.StringError("make join operation")
+
+	basefact, ok := i.(isaacoperation.SuffrageGenesisJoinFact)
+	if !ok {
+		return nil, e.WithMessage(nil, "expected SuffrageGenesisJoinFact, not %T", i)
+	}
+
+	fact := isaacoperation.NewSuffrageGenesisJoinFact(basefact.Nodes(), g.networkID)
+
+	if err := fact.IsValid(g.networkID); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	op := isaacoperation.NewSuffrageGenesisJoin(fact)
+	if err := op.Sign(g.local.Privatekey(), g.networkID); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	g.Log().Debug().Interface("operation", op).Msg("genesis join operation created")
+
+	return op, nil
+}
+
+func (g *GenesisBlockGenerator) networkPolicyOperation(i base.Fact) (base.Operation, error) {
+	e := util.StringError("make networkPolicy operation")
+
+	basefact, ok := i.(isaacoperation.GenesisNetworkPolicyFact)
+	if !ok {
+		return nil, e.WithMessage(nil, "expected GenesisNetworkPolicyFact, not %T", i)
+	}
+
+	fact := isaacoperation.NewGenesisNetworkPolicyFact(basefact.Policy())
+
+	if err := fact.IsValid(nil); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	op := isaacoperation.NewGenesisNetworkPolicy(fact)
+	if err := op.Sign(g.local.Privatekey(), g.networkID); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	g.Log().Debug().Interface("operation", op).Msg("genesis network policy operation created")
+
+	return op, nil
+}
+
+func (g *GenesisBlockGenerator) registerGenesisCurrencyOperation(i base.Fact, token []byte) (base.Operation, error) {
+	e := util.StringError("make registerGenesisCurrency operation")
+
+	basefact, ok := i.(currency.RegisterGenesisCurrencyFact)
+	if !ok {
+		return nil, e.WithMessage(nil, "expected RegisterGenesisCurrencyFact, not %T", i)
+	}
+	acks, err := currencytypes.NewBaseAccountKeys(basefact.Keys().Keys(), basefact.Keys().Threshold())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	var design launch.NodeDesign
+	err = util.LoadFromContextOK(g.ctx,
+		launch.DesignContextKey, &design,
+	)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	if !basefact.GenesisNodeKey().Equal(design.Privatekey.Publickey()) {
+		return nil, e.Errorf(
+			"GenesisNodeKey, %v is not match with local node key, %v",
+			basefact.GenesisNodeKey().String(),
+			design.Privatekey.Publickey().String(),
+		)
+	}
+
+	fact := currency.NewRegisterGenesisCurrencyFact(token, basefact.GenesisNodeKey(), acks, basefact.Currencies())
+	if err := fact.IsValid(g.networkID); err != nil {
+		return nil, e.Wrap(err)
+	}
+	op := currency.NewRegisterGenesisCurrency(fact)
+	if err := op.Sign(g.local.Privatekey(), g.networkID); err != nil {
+		return nil, e.Wrap(err)
+	}
+	g.Log().Debug().Interface("operation", op).Msg("genesis join operation created")
+
+	return op, nil
+}
+
+// genesisParams returns the nft params of the genesis design, or the default
+// params without them.
+func (g *GenesisBlockGenerator) genesisParams() (nfttypes.Params, error) {
+	for i := range g.facts {
+		if fact, ok := g.facts[i].(nft.GenesisParamsFact); ok {
+			if err := fact.Params().IsValid(nil); err != nil {
+				return nfttypes.Params{}, errors.WithMessage(err, "invalid genesis params")
+			}
+
+			return fact.Params(), nil
+		}
+	}
+
+	return nfttypes.DefaultParams(), nil
+}
+
+// genesisContracts returns the contract accounts of the genesis collections.
+// The genesis operations can not read the states of each other, so the
+// collections should not share the contract account.
+func (g *GenesisBlockGenerator) genesisContracts() (map[string]struct{}, error) {
+	contracts := map[string]struct{}{}
+
+	for i := range g.facts {
+		fact, ok := g.facts[i].(nft.GenesisCollectionFact)
+		if !ok {
+			continue
+		}
+
+		if _, found := contracts[fact.Contract().String()]; found {
+			return nil, errors.Errorf(
+				"Multiple GenesisCollection operation found for contract account, %v", fact.Contract())
+		}
+		contracts[fact.Contract().String()] = struct{}{}
+	}
+
+	return contracts, nil
+}
+
+func (g *GenesisBlockGenerator) genesisParamsOperation(i base.Fact) (base.Operation, error) {
+	e := util.StringError("make genesisParams operation")
+
+	basefact, ok := i.(nft.GenesisParamsFact)
+	if !ok {
+		return nil, e.WithMessage(nil, "expected GenesisParamsFact, not %T", i)
+	}
+
+	fact := nft.NewGenesisParamsFact(g.networkID, basefact.Params())
+	if err := fact.IsValid(g.networkID); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	op := nft.NewGenesisParams(fact)
+	if err := op.Sign(g.local.Privatekey(), g.networkID); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	g.Log().Debug().Interface("operation", op).Msg("genesis params operation created")
+
+	return op, nil
+}
+
+func (g *GenesisBlockGenerator) genesisCollectionOperation(
+	i base.Fact, contracts map[string]struct{}, params nfttypes.Params,
+) (base.Operation, error) {
+	e := util.StringError("make genesisCollection operation")
+
+	basefact, ok := i.(nft.GenesisCollectionFact)
+	if !ok {
+		return nil, e.WithMessage(nil, "expected GenesisCollectionFact, not %T", i)
+	}
+
+	// the accounts created by the collection would replace the contract
+	// account of another genesis collection.
+	for _, a := range basefact.Addresses() {
+		if _, found := contracts[a.String()]; found {
+			return nil, e.Errorf("account %v is the contract account of genesis collection", a)
+		}
+	}
+
+	fact := nft.NewGenesisCollectionFact(
+		g.networkID, basefact.Contract(), basefact.Creator(), basefact.Policy(), basefact.Items())
+	if err := fact.IsValid(g.networkID); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	if err := fact.CheckParams(params); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	op := nft.NewGenesisCollection(fact)
+	if err := op.Sign(g.local.Privatekey(), g.networkID); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	g.Log().Debug().Interface("operation", op).Msg("genesis collection operation created")
+
+	return op, nil
+}
+
+func (g *GenesisBlockGenerator) newProposal(ops [][2]util.Hash) error {
+	e := util.StringError("make genesis proposal")
+
+	nops := make([][2]util.Hash, len(ops)+len(g.ops))
+	copy(nops[:len(ops)], ops)
+
+	for i := range g.ops {
+		nops[i+len(ops)][0] = g.ops[i].Hash()
+		nops[i+len(ops)][1] = g.ops[i].Fact().Hash()
+	}
+
+	fact := isaac.NewProposalFact(base.GenesisPoint, g.local.Address(), nil, nops)
+	sign := isaac.NewProposalSignFact(fact)
+
+	if err := sign.Sign(g.local.Privatekey(), g.networkID); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := sign.IsValid(g.networkID); err != nil {
+		return e.Wrap(err)
+	}
+
+	g.proposal = sign
+
+	g.Log().Debug().Interface("proposal", sign).Msg("proposal created for genesis")
+
+	return nil
+}
+
+func (g *GenesisBlockGenerator) initVoetproof() error {
+	e := util.StringError("make genesis init voteproof")
+
+	fact := isaac.NewINITBallotFact(base.GenesisPoint, nil, g.proposal.Fact().Hash(), nil)
+	if err := fact.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	sf := isaac.NewINITBallotSignFact(fact)
+	if err := sf.NodeSign(g.local.Privatekey(), g.networkID, g.local.Address()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := sf.IsValid(g.networkID); err != nil {
+		return e.Wrap(err)
+	}
+
+	vp := isaac.NewINITVoteproof(fact.Point().Point)
+	vp.
+		SetMajority(fact).
+		SetSignFacts([]base.BallotSignFact{sf}).
+		SetThreshold(base.MaxThreshold).
+		Finish()
+
+	if err := vp.IsValid(g.networkID); err != nil {
+		return e.Wrap(err)
+	}
+
+	g.ivp = vp
+
+	g.Log().Debug().Interface("init_voteproof", vp).Msg("init voteproof created for genesis")
+
+	return nil
+}
+
+func (g *GenesisBlockGenerator) acceptVoteproof(proposal, newblock util.Hash) error {
+	e := util.StringError("make genesis accept voteproof")
+
+	fact := isaac.NewACCEPTBallotFact(base.GenesisPoint, proposal, newblock, nil)
+	if err := fact.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	sf := isaac.NewACCEPTBallotSignFact(fact)
+	if err := sf.NodeSign(g.local.Privatekey(), g.networkID, g.local.Address()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := sf.IsValid(g.networkID); err != nil {
+		return e.Wrap(err)
+	}
+
+	vp := isaac.NewACCEPTVoteproof(fact.Point().Point)
+	vp.
+		SetMajority(fact).
+		SetSignFacts([]base.BallotSignFact{sf}).
+		SetThreshold(base.MaxThreshold).
+		Finish()
+
+	if err := vp.IsValid(g.networkID); err != nil {
+		return e.Wrap(err)
+	}
+
+	g.avp = vp
+
+	g.Log().Debug().Interface("init_voteproof", vp).Msg("accept voteproof created for genesis")
+
+	return nil
+}
+
+func (g *GenesisBlockGenerator) process() error {
+	e := util.StringError("process blockgenerator")
+
+	if err := g.initVoetproof(); err != nil {
+		return e.Wrap(err)
+	}
+
+	pp, err := g.newProposalProcessor()
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	_ = pp.SetLogging(g.Logging)
+
+	switch m, err := pp.Process(context.Background(), g.ivp); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		if err := m.IsValid(g.networkID); err != nil {
+			return e.Wrap(err)
+		}
+
+		g.Log().Info().Interface("manifest", m).Msg("genesis block generated")
+
+		if err := g.acceptVoteproof(g.proposal.Fact().Hash(), m.Hash()); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	if _, err := pp.Save(context.Background(), g.avp); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (g *GenesisBlockGenerator) closeDatabase() error {
+	e := util.StringError("close database")
+
+	if err := g.db.MergeAllPermanent(); err != nil {
+		return e.WithMessage(err, "merge temps")
+	}
+
+	return nil
+}
+
+func (g *GenesisBlockGenerator) newProposalProcessor() (*isaac.DefaultProposalProcessor, error) {
+	args := isaac.NewDefaultProposalProcessorArgs()
+	// the operations are processed one by one in the order of the proposal.
+	args.MaxWorkerSize = 1
+	args.NewWriterFunc = launch.NewBlockWriterFunc(
+		g.local, g.networkID, g.dataroot, g.encs.JSON(), g.encs.Default(), g.db, math.MaxInt16, 0)
+	args.GetStateFunc = func(key string) (base.State, bool, error) {
+		return nil, false, nil
+	}
+	args.GetOperationFunc = func(_ context.Context, operationhash, _ util.Hash) (base.Operation, error) {
+		for i := range g.ops {
+			op := g.ops[i]
+			if operationhash.Equal(op.Hash()) {
+				return op, nil
+			}
+		}
+
+		return nil, util.ErrNotFound.Errorf("Operation not found")
+	}
+
+	return isaac.NewDefaultProposalProcessor(g.proposal, nil, args)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/imfact-labs/mitum2/util/hint"
 	"github.com/imfact-labs/mitum2/util/ps"
 	"github.com/imfact-labs/nft-model/operation/nft"
+	"github.com/imfact-labs/nft-model/operation/processor"
 	"github.com/imfact-labs/nft-model/runtime/contracts"
 )
 
@@ -37,11 +38,11 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 		return pctx, err
 	}
 
-	//err := opr.SetCheckDuplicationFunc(processor.CheckDuplication)
-	//if err != nil {
-	//	return pctx, err
-	//}
-	err := opr.SetGetNewProcessorFunc(cprocessor.GetNewProcessor)
+	err := opr.SetCheckDuplicationFunc(processor.CheckDuplication)
+	if err != nil {
+		return pctx, err
+	}
+	err = opr.SetGetNewProcessorFunc(cprocessor.GetNewProcessor)
 	if err != nil {
 		return pctx, err
 	}
@@ -54,6 +55,23 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 		{nft.ApproveAllHint, nft.NewDelegateProcessor()},
 		{nft.ApproveHint, nft.NewApproveProcessor()},
 		{nft.AddSignatureHint, nft.NewSignProcessor()},
+		{nft.PauseHint, nft.NewPauseProcessor()},
+		{nft.UpdateDenylistHint, nft.NewUpdateDenylistProcessor()},
+		{nft.ForceTransferHint, nft.NewForceTransferProcessor()},
+		{nft.CommitRevealHint, nft.NewCommitRevealProcessor()},
+		{nft.RevealHint, nft.NewRevealProcessor()},
+		{nft.UpdateAttributesHint, nft.NewUpdateAttributesProcessor()},
+		{nft.UpdateDynamicStateHint, nft.NewUpdateDynamicStateProcessor()},
+		{nft.StoreContentHint, nft.NewStoreContentProcessor()},
+		{nft.FinalizeContentHint, nft.NewFinalizeContentProcessor()},
+		{nft.RenewHint, nft.NewRenewProcessor()},
+		{nft.CreateSeriesHint, nft.NewCreateSeriesProcessor()},
+		{nft.ProposeModelConfigHint, nft.NewProposeModelConfigProcessor()},
+		{nft.ApproveModelConfigHint, nft.NewApproveModelConfigProcessor()},
+		{nft.TransferCollectionOwnershipHint, nft.NewTransferCollectionOwnershipProcessor()},
+		{nft.AcceptCollectionOwnershipHint, nft.NewAcceptCollectionOwnershipProcessor()},
+		{nft.UpgradeStatesHint, nft.NewUpgradeStatesProcessor()},
+		{nft.UpdateParamsHint, nft.NewUpdateParamsProcessor(isaacParams.Threshold())},
 	}
 
 	for i := range processors {
@@ -55,7 +55,36 @@ func StateCollectionValue(st base.State) (*types.Design, error) {
 		return nil, errors.Errorf("invalid collection value found, %T", v)
 	}
 
-	return &d.Design, nil
+	design := d.Design.Upgrade()
+
+	return &design, nil
+}
+
+// EffectiveCollectionValue returns the collection design with the scheduled
+// policy applied when it takes effect at or before height.
+func EffectiveCollectionValue(
+	st base.State, height base.Height, getStateFunc base.GetStateFunc,
+) (*types.Design, error) {
+	design, err := StateCollectionValue(st)
+	if err != nil {
+		return nil, err
+	}
+
+	switch pst, found, err := getStateFunc(StateKeyPendingPolicy(design.Contract())); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return design, nil
+	default:
+		pending, err := StatePendingPolicyValue(pst)
+		if err != nil {
+			return nil, err
+		}
+
+		de := pending.Apply(*design, height)
+
+		return &de, nil
+	}
 }
 
 var LastNFTIndexStateValueHint = hint.MustNewHint("collection-last-nft-index-state-value-v0.0.1")
@@ -153,7 +182,9 @@ func StateNFTValue(st base.State) (*types.NFT, error) {
 		return nil, errors.Errorf("invalid nft value found, %T", v)
 	}
 
-	return &ns.NFT, nil
+	n := ns.NFT.Upgrade()
+
+	return &n, nil
 }
 
 var OperatorsBookStateValueHint = hint.MustNewHint("operators-book-state-value-v0.0.1")
@@ -205,3 +236,723 @@ func StateOperatorsBookValue(st base.State) (*types.AllApprovedBook, error) {
 
 	return &ob.Operators, nil
 }
+
+var DenylistStateValueHint = hint.MustNewHint("denylist-state-value-v0.0.1")
+
+// DenylistStateValue marks whether an account is denied in a collection. Each
+// account has its own state, so the denylist is not bounded by a single value.
+type DenylistStateValue struct {
+	hint.BaseHinter
+	denied bool
+}
+
+func NewDenylistStateValue(denied bool) DenylistStateValue {
+	return DenylistStateValue{
+		BaseHinter: hint.NewBaseHinter(DenylistStateValueHint),
+		denied:     denied,
+	}
+}
+
+func (ds DenylistStateValue) Hint() hint.Hint {
+	return ds.BaseHinter.Hint()
+}
+
+func (ds DenylistStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid DenylistStateValue")
+
+	if err := ds.BaseHinter.IsValid(DenylistStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ds DenylistStateValue) HashBytes() []byte {
+	if ds.denied {
+		return []byte{1}
+	}
+
+	return []byte{0}
+}
+
+func (ds DenylistStateValue) Denied() bool {
+	return ds.denied
+}
+
+func StateDenylistValue(st base.State) (bool, error) {
+	v := st.Value()
+	if v == nil {
+		return false, util.ErrNotFound.Errorf("denylist not found in State")
+	}
+
+	ds, ok := v.(DenylistStateValue)
+	if !ok {
+		return false, errors.Errorf("invalid denylist value found, %T", v)
+	}
+
+	return ds.denied, nil
+}
+
+var ClawbackStateValueHint = hint.MustNewHint("clawback-state-value-v0.0.1")
+
+// ClawbackStateValue records a forced transfer of a nft with the reason given
+// by the issuer; see StateKeyClawback.
+type ClawbackStateValue struct {
+	hint.BaseHinter
+	from   base.Address
+	to     base.Address
+	reason string
+}
+
+func NewClawbackStateValue(from, to base.Address, reason string) ClawbackStateValue {
+	return ClawbackStateValue{
+		BaseHinter: hint.NewBaseHinter(ClawbackStateValueHint),
+		from:       from,
+		to:         to,
+		reason:     reason,
+	}
+}
+
+func (cs ClawbackStateValue) Hint() hint.Hint {
+	return cs.BaseHinter.Hint()
+}
+
+func (cs ClawbackStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid ClawbackStateValue")
+
+	if err := cs.BaseHinter.IsValid(ClawbackStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, cs.from, cs.to); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (cs ClawbackStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(cs.from.Bytes(), cs.to.Bytes(), []byte(cs.reason))
+}
+
+func (cs ClawbackStateValue) From() base.Address {
+	return cs.from
+}
+
+func (cs ClawbackStateValue) To() base.Address {
+	return cs.to
+}
+
+func (cs ClawbackStateValue) Reason() string {
+	return cs.reason
+}
+
+func StateClawbackValue(st base.State) (*ClawbackStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("clawback not found in State")
+	}
+
+	cs, ok := v.(ClawbackStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid clawback value found, %T", v)
+	}
+
+	return &cs, nil
+}
+
+var RevealStateValueHint = hint.MustNewHint("reveal-state-value-v0.0.1")
+
+type RevealStateValue struct {
+	hint.BaseHinter
+	Reveal types.Reveal
+}
+
+func NewRevealStateValue(reveal types.Reveal) RevealStateValue {
+	return RevealStateValue{
+		BaseHinter: hint.NewBaseHinter(RevealStateValueHint),
+		Reveal:     reveal,
+	}
+}
+
+func (rs RevealStateValue) Hint() hint.Hint {
+	return rs.BaseHinter.Hint()
+}
+
+func (rs RevealStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid RevealStateValue")
+
+	if err := rs.BaseHinter.IsValid(RevealStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := rs.Reveal.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (rs RevealStateValue) HashBytes() []byte {
+	return rs.Reveal.Bytes()
+}
+
+func StateRevealValue(st base.State) (*types.Reveal, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("reveal not found in State")
+	}
+
+	r, ok := v.(RevealStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid reveal value found, %T", v)
+	}
+
+	return &r.Reveal, nil
+}
+
+var DynamicStateValueHint = hint.MustNewHint("dynamic-state-value-v0.0.1")
+
+type DynamicStateValue struct {
+	hint.BaseHinter
+	Dynamic types.DynamicState
+}
+
+func NewDynamicStateValue(dynamic types.DynamicState) DynamicStateValue {
+	return DynamicStateValue{
+		BaseHinter: hint.NewBaseHinter(DynamicStateValueHint),
+		Dynamic:    dynamic,
+	}
+}
+
+func (ds DynamicStateValue) Hint() hint.Hint {
+	return ds.BaseHinter.Hint()
+}
+
+func (ds DynamicStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid DynamicStateValue")
+
+	if err := ds.BaseHinter.IsValid(DynamicStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := ds.Dynamic.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ds DynamicStateValue) HashBytes() []byte {
+	return ds.Dynamic.Bytes()
+}
+
+func StateDynamicValue(st base.State) (*types.DynamicState, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("dynamic state not found in State")
+	}
+
+	d, ok := v.(DynamicStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid dynamic state value found, %T", v)
+	}
+
+	return &d.Dynamic, nil
+}
+
+var MintPoolStateValueHint = hint.MustNewHint("mint-pool-state-value-v0.0.1")
+
+type MintPoolStateValue struct {
+	hint.BaseHinter
+	id uint64
+}
+
+func NewMintPoolStateValue(id uint64) MintPoolStateValue {
+	return MintPoolStateValue{
+		BaseHinter: hint.NewBaseHinter(MintPoolStateValueHint),
+		id:         id,
+	}
+}
+
+func (ms MintPoolStateValue) Hint() hint.Hint {
+	return ms.BaseHinter.Hint()
+}
+
+func (ms MintPoolStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid MintPoolStateValue")
+
+	if err := ms.BaseHinter.IsValid(MintPoolStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ms MintPoolStateValue) HashBytes() []byte {
+	return util.Uint64ToBytes(ms.id)
+}
+
+func StateMintPoolValue(st base.State) (uint64, error) {
+	v := st.Value()
+	if v == nil {
+		return 0, util.ErrNotFound.Errorf("mint pool slot not found in State")
+	}
+
+	ms, ok := v.(MintPoolStateValue)
+	if !ok {
+		return 0, errors.Errorf("invalid mint pool slot value found, %T", v)
+	}
+
+	return ms.id, nil
+}
+
+var ContentChunkStateValueHint = hint.MustNewHint("content-chunk-state-value-v0.0.1")
+
+type ContentChunkStateValue struct {
+	hint.BaseHinter
+	data []byte
+}
+
+func NewContentChunkStateValue(data []byte) ContentChunkStateValue {
+	return ContentChunkStateValue{
+		BaseHinter: hint.NewBaseHinter(ContentChunkStateValueHint),
+		data:       data,
+	}
+}
+
+func (cs ContentChunkStateValue) Hint() hint.Hint {
+	return cs.BaseHinter.Hint()
+}
+
+func (cs ContentChunkStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid ContentChunkStateValue")
+
+	if err := cs.BaseHinter.IsValid(ContentChunkStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if l := len(cs.data); l < 1 || l > types.MaxContentChunkSize {
+		return e.Wrap(errors.Errorf("content chunk size out of range, %d", l))
+	}
+
+	return nil
+}
+
+func (cs ContentChunkStateValue) HashBytes() []byte {
+	return cs.data
+}
+
+func StateContentChunkValue(st base.State) ([]byte, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("content chunk not found in State")
+	}
+
+	c, ok := v.(ContentChunkStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid content chunk value found, %T", v)
+	}
+
+	return c.data, nil
+}
+
+var ContentStateValueHint = hint.MustNewHint("content-state-value-v0.0.1")
+
+type ContentStateValue struct {
+	hint.BaseHinter
+	Content types.ContentInfo
+}
+
+func NewContentStateValue(content types.ContentInfo) ContentStateValue {
+	return ContentStateValue{
+		BaseHinter: hint.NewBaseHinter(ContentStateValueHint),
+		Content:    content,
+	}
+}
+
+func (cs ContentStateValue) Hint() hint.Hint {
+	return cs.BaseHinter.Hint()
+}
+
+func (cs ContentStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid ContentStateValue")
+
+	if err := cs.BaseHinter.IsValid(ContentStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := cs.Content.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (cs ContentStateValue) HashBytes() []byte {
+	return cs.Content.Bytes()
+}
+
+func StateContentValue(st base.State) (*types.ContentInfo, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("content info not found in State")
+	}
+
+	c, ok := v.(ContentStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid content info value found, %T", v)
+	}
+
+	return &c.Content, nil
+}
+
+var SeriesStateValueHint = hint.MustNewHint("series-state-value-v0.0.1")
+
+type SeriesStateValue struct {
+	hint.BaseHinter
+	Series types.Series
+}
+
+func NewSeriesStateValue(series types.Series) SeriesStateValue {
+	return SeriesStateValue{
+		BaseHinter: hint.NewBaseHinter(SeriesStateValueHint),
+		Series:     series,
+	}
+}
+
+func (ss SeriesStateValue) Hint() hint.Hint {
+	return ss.BaseHinter.Hint()
+}
+
+func (ss SeriesStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid SeriesStateValue")
+
+	if err := ss.BaseHinter.IsValid(SeriesStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := ss.Series.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ss SeriesStateValue) HashBytes() []byte {
+	return ss.Series.Bytes()
+}
+
+func StateSeriesValue(st base.State) (*types.Series, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("series not found in State")
+	}
+
+	d, ok := v.(SeriesStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid series value found, %T", v)
+	}
+
+	return &d.Series, nil
+}
+
+var ProposalsStateValueHint = hint.MustNewHint("proposals-state-value-v0.0.1")
+
+type ProposalsStateValue struct {
+	hint.BaseHinter
+	Proposals []types.ConfigProposal
+}
+
+func NewProposalsStateValue(proposals []types.ConfigProposal) ProposalsStateValue {
+	return ProposalsStateValue{
+		BaseHinter: hint.NewBaseHinter(ProposalsStateValueHint),
+		Proposals:  proposals,
+	}
+}
+
+func (ps ProposalsStateValue) Hint() hint.Hint {
+	return ps.BaseHinter.Hint()
+}
+
+func (ps ProposalsStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid ProposalsStateValue")
+
+	if err := ps.BaseHinter.IsValid(ProposalsStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if l := len(ps.Proposals); l > types.MaxProposals {
+		return e.Wrap(errors.Errorf("proposals over max, %d > %d", l, types.MaxProposals))
+	}
+
+	founds := map[uint64]struct{}{}
+	for _, p := range ps.Proposals {
+		if err := p.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+
+		if _, found := founds[p.ID()]; found {
+			return e.Wrap(errors.Errorf("duplicate proposal id, %d", p.ID()))
+		}
+		founds[p.ID()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (ps ProposalsStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(ps.Proposals))
+	for i, p := range ps.Proposals {
+		bs[i] = p.Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func StateProposalsValue(st base.State) ([]types.ConfigProposal, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("proposals not found in State")
+	}
+
+	p, ok := v.(ProposalsStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid proposals value found, %T", v)
+	}
+
+	return p.Proposals, nil
+}
+
+var PendingPolicyStateValueHint = hint.MustNewHint("pending-policy-state-value-v0.0.1")
+
+// PendingPolicyStateValue is the policy of a collection scheduled to take
+// effect at EffectiveAt. The zero EffectiveAt means nothing is scheduled.
+type PendingPolicyStateValue struct {
+	hint.BaseHinter
+	Policy      types.CollectionPolicy
+	EffectiveAt base.Height
+}
+
+func NewPendingPolicyStateValue(policy types.CollectionPolicy, effectiveAt base.Height) PendingPolicyStateValue {
+	return PendingPolicyStateValue{
+		BaseHinter:  hint.NewBaseHinter(PendingPolicyStateValueHint),
+		Policy:      policy,
+		EffectiveAt: effectiveAt,
+	}
+}
+
+// NewClearedPendingPolicyStateValue is the value of a collection without the
+// scheduled policy.
+func NewClearedPendingPolicyStateValue() PendingPolicyStateValue {
+	return PendingPolicyStateValue{
+		BaseHinter: hint.NewBaseHinter(PendingPolicyStateValueHint),
+	}
+}
+
+func (ps PendingPolicyStateValue) Hint() hint.Hint {
+	return ps.BaseHinter.Hint()
+}
+
+func (ps PendingPolicyStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid PendingPolicyStateValue")
+
+	if err := ps.BaseHinter.IsValid(PendingPolicyStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if !ps.IsScheduled() {
+		return nil
+	}
+
+	if err := ps.Policy.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ps PendingPolicyStateValue) HashBytes() []byte {
+	if !ps.IsScheduled() {
+		return nil
+	}
+
+	return util.ConcatBytesSlice(ps.Policy.Bytes(), ps.EffectiveAt.Bytes())
+}
+
+func (ps PendingPolicyStateValue) IsScheduled() bool {
+	return ps.EffectiveAt > 0
+}
+
+// Apply returns the design with the scheduled policy when it takes effect at
+// or before height.
+func (ps PendingPolicyStateValue) Apply(design types.Design, height base.Height) types.Design {
+	if !ps.IsScheduled() || ps.EffectiveAt > height {
+		return design
+	}
+
+	return types.NewDesign(
+		design.Contract(), design.Creator(), design.Active(), design.Paused(), design.Count(), ps.Policy,
+	)
+}
+
+func StatePendingPolicyValue(st base.State) (*PendingPolicyStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("pending policy not found in State")
+	}
+
+	p, ok := v.(PendingPolicyStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid pending policy value found, %T", v)
+	}
+
+	return &p, nil
+}
+
+var OwnershipStateValueHint = hint.MustNewHint("ownership-state-value-v0.0.1")
+
+// OwnershipStateValue records the latest ownership transfer of a collection.
+// The transfer is pending until the new owner accepts it.
+type OwnershipStateValue struct {
+	hint.BaseHinter
+	from     base.Address
+	to       base.Address
+	accepted bool
+}
+
+func NewOwnershipStateValue(from, to base.Address, accepted bool) OwnershipStateValue {
+	return OwnershipStateValue{
+		BaseHinter: hint.NewBaseHinter(OwnershipStateValueHint),
+		from:       from,
+		to:         to,
+		accepted:   accepted,
+	}
+}
+
+func (os OwnershipStateValue) Hint() hint.Hint {
+	return os.BaseHinter.Hint()
+}
+
+func (os OwnershipStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid OwnershipStateValue")
+
+	if err := os.BaseHinter.IsValid(OwnershipStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, os.from, os.to); err != nil {
+		return e.Wrap(err)
+	}
+
+	if os.from.Equal(os.to) {
+		return e.Wrap(errors.Errorf("new owner is same with the owner, %v", os.to))
+	}
+
+	return nil
+}
+
+func (os OwnershipStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(os.from.Bytes(), os.to.Bytes(), util.BoolToBytes(os.accepted))
+}
+
+func (os OwnershipStateValue) From() base.Address {
+	return os.from
+}
+
+func (os OwnershipStateValue) To() base.Address {
+	return os.to
+}
+
+func (os OwnershipStateValue) Accepted() bool {
+	return os.accepted
+}
+
+// IsPending returns true when the transfer waits for the acceptance of the
+// new owner.
+func (os OwnershipStateValue) IsPending() bool {
+	return !os.accepted
+}
+
+func StateOwnershipValue(st base.State) (*OwnershipStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("ownership not found in State")
+	}
+
+	os, ok := v.(OwnershipStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid ownership value found, %T", v)
+	}
+
+	return &os, nil
+}
+
+var ParamsStateValueHint = hint.MustNewHint("params-state-value-v0.0.1")
+
+type ParamsStateValue struct {
+	hint.BaseHinter
+	Params types.Params
+}
+
+func NewParamsStateValue(params types.Params) ParamsStateValue {
+	return ParamsStateValue{
+		BaseHinter: hint.NewBaseHinter(ParamsStateValueHint),
+		Params:     params,
+	}
+}
+
+func (ps ParamsStateValue) Hint() hint.Hint {
+	return ps.BaseHinter.Hint()
+}
+
+func (ps ParamsStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid ParamsStateValue")
+
+	if err := ps.BaseHinter.IsValid(ParamsStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := ps.Params.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ps ParamsStateValue) HashBytes() []byte {
+	return ps.Params.Bytes()
+}
+
+func StateParamsValue(st base.State) (*types.Params, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("params not found in State")
+	}
+
+	p, ok := v.(ParamsStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid params value found, %T", v)
+	}
+
+	return &p.Params, nil
+}
+
+// CurrentParams returns the nft params in the state. The networks started
+// before the params state was added use the default params until the suffrage
+// updates them.
+func CurrentParams(getStateFunc base.GetStateFunc) (types.Params, error) {
+	switch st, found, err := getStateFunc(StateKeyParams); {
+	case err != nil:
+		return types.Params{}, err
+	case !found:
+		return types.DefaultParams(), nil
+	default:
+		p, err := StateParamsValue(st)
+		if err != nil {
+			return types.Params{}, err
+		}
+
+		return *p, nil
+	}
+}
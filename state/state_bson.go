@@ -151,3 +151,433 @@ func (s *OperatorsBookStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) err
 
 	return nil
 }
+
+func (s DenylistStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  s.Hint().String(),
+			"denied": s.denied,
+		},
+	)
+}
+
+type DenylistStateValueBSONUnmarshaler struct {
+	Hint   string `bson:"_hint"`
+	Denied bool   `bson:"denied"`
+}
+
+func (s *DenylistStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of DenylistStateValue")
+
+	var u DenylistStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	s.denied = u.Denied
+
+	return nil
+}
+
+func (s ClawbackStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  s.Hint().String(),
+			"from":   s.from,
+			"to":     s.to,
+			"reason": s.reason,
+		},
+	)
+}
+
+type ClawbackStateValueBSONUnmarshaler struct {
+	Hint   string `bson:"_hint"`
+	From   string `bson:"from"`
+	To     string `bson:"to"`
+	Reason string `bson:"reason"`
+}
+
+func (s *ClawbackStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ClawbackStateValue")
+
+	var u ClawbackStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	return s.unpack(enc, u.From, u.To, u.Reason)
+}
+
+func (s RevealStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  s.Hint().String(),
+			"reveal": s.Reveal,
+		},
+	)
+}
+
+type RevealStateValueBSONUnmarshaler struct {
+	Hint   string   `bson:"_hint"`
+	Reveal bson.Raw `bson:"reveal"`
+}
+
+func (s *RevealStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of RevealStateValue")
+
+	var u RevealStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var r types.Reveal
+	if err := r.DecodeBSON(u.Reveal, enc); err != nil {
+		return e.Wrap(err)
+	}
+	s.Reveal = r
+
+	return nil
+}
+
+func (s DynamicStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   s.Hint().String(),
+			"dynamic": s.Dynamic,
+		},
+	)
+}
+
+type DynamicStateValueBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	Dynamic bson.Raw `bson:"dynamic"`
+}
+
+func (s *DynamicStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of DynamicStateValue")
+
+	var u DynamicStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var d types.DynamicState
+	if err := d.DecodeBSON(u.Dynamic, enc); err != nil {
+		return e.Wrap(err)
+	}
+	s.Dynamic = d
+
+	return nil
+}
+
+func (s MintPoolStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": s.Hint().String(),
+			"index": s.id,
+		},
+	)
+}
+
+type MintPoolStateValueBSONUnmarshaler struct {
+	Hint  string `bson:"_hint"`
+	Index uint64 `bson:"index"`
+}
+
+func (s *MintPoolStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of MintPoolStateValue")
+
+	var u MintPoolStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	s.id = u.Index
+
+	return nil
+}
+
+func (s ContentChunkStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": s.Hint().String(),
+			"data":  s.data,
+		},
+	)
+}
+
+type ContentChunkStateValueBSONUnmarshaler struct {
+	Hint string `bson:"_hint"`
+	Data []byte `bson:"data"`
+}
+
+func (s *ContentChunkStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ContentChunkStateValue")
+
+	var u ContentChunkStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	s.data = u.Data
+
+	return nil
+}
+
+func (s ContentStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   s.Hint().String(),
+			"content": s.Content,
+		},
+	)
+}
+
+type ContentStateValueBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	Content bson.Raw `bson:"content"`
+}
+
+func (s *ContentStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ContentStateValue")
+
+	var u ContentStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var c types.ContentInfo
+	if err := c.DecodeBSON(u.Content, enc); err != nil {
+		return e.Wrap(err)
+	}
+	s.Content = c
+
+	return nil
+}
+
+func (s SeriesStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  s.Hint().String(),
+			"series": s.Series,
+		},
+	)
+}
+
+type SeriesStateValueBSONUnmarshaler struct {
+	Hint   string   `bson:"_hint"`
+	Series bson.Raw `bson:"series"`
+}
+
+func (s *SeriesStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of SeriesStateValue")
+
+	var u SeriesStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var d types.Series
+	if err := d.DecodeBSON(u.Series, enc); err != nil {
+		return e.Wrap(err)
+	}
+	s.Series = d
+
+	return nil
+}
+
+func (s ProposalsStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     s.Hint().String(),
+			"proposals": s.Proposals,
+		},
+	)
+}
+
+type ProposalsStateValueBSONUnmarshaler struct {
+	Hint      string   `bson:"_hint"`
+	Proposals bson.Raw `bson:"proposals"`
+}
+
+func (s *ProposalsStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ProposalsStateValue")
+
+	var u ProposalsStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	proposals, err := decodeProposals(u.Proposals, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.Proposals = proposals
+
+	return nil
+}
+
+func (s PendingPolicyStateValue) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"_hint":        s.Hint().String(),
+		"effective_at": s.EffectiveAt,
+	}
+
+	if s.IsScheduled() {
+		m["policy"] = s.Policy
+	}
+
+	return bsonenc.Marshal(m)
+}
+
+type PendingPolicyStateValueBSONUnmarshaler struct {
+	Hint        string   `bson:"_hint"`
+	Policy      bson.Raw `bson:"policy,omitempty"`
+	EffectiveAt int64    `bson:"effective_at"`
+}
+
+func (s *PendingPolicyStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of PendingPolicyStateValue")
+
+	var u PendingPolicyStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := s.unpack(enc, ht, u.Policy, u.EffectiveAt); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (s OwnershipStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    s.Hint().String(),
+			"from":     s.from,
+			"to":       s.to,
+			"accepted": s.accepted,
+		},
+	)
+}
+
+type OwnershipStateValueBSONUnmarshaler struct {
+	Hint     string `bson:"_hint"`
+	From     string `bson:"from"`
+	To       string `bson:"to"`
+	Accepted bool   `bson:"accepted"`
+}
+
+func (s *OwnershipStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of OwnershipStateValue")
+
+	var u OwnershipStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	return s.unpack(enc, u.From, u.To, u.Accepted)
+}
+
+func (s ParamsStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  s.Hint().String(),
+			"params": s.Params,
+		},
+	)
+}
+
+type ParamsStateValueBSONUnmarshaler struct {
+	Hint   string   `bson:"_hint"`
+	Params bson.Raw `bson:"params"`
+}
+
+func (s *ParamsStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ParamsStateValue")
+
+	var u ParamsStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var p types.Params
+	if err := p.DecodeBSON(u.Params, enc); err != nil {
+		return e.Wrap(err)
+	}
+	s.Params = p
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package state
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/nft-model/types"
+	"github.com/pkg/errors"
+)
+
+func (s *ClawbackStateValue) unpack(enc encoder.Encoder, fr, to, reason string) error {
+	from, err := base.DecodeAddress(fr, enc)
+	if err != nil {
+		return err
+	}
+	s.from = from
+
+	receiver, err := base.DecodeAddress(to, enc)
+	if err != nil {
+		return err
+	}
+	s.to = receiver
+	s.reason = reason
+
+	return nil
+}
+
+func decodeProposals(b []byte, enc encoder.Encoder) ([]types.ConfigProposal, error) {
+	hinters, err := enc.DecodeSlice(b)
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := make([]types.ConfigProposal, len(hinters))
+	for i, hinter := range hinters {
+		p, ok := hinter.(types.ConfigProposal)
+		if !ok {
+			return nil, common.ErrTypeMismatch.Wrap(errors.Errorf("expected ConfigProposal, not %T", hinter))
+		}
+
+		proposals[i] = p
+	}
+
+	return proposals, nil
+}
+
+func (s *PendingPolicyStateValue) unpack(enc encoder.Encoder, ht hint.Hint, bpo []byte, effectiveAt int64) error {
+	s.BaseHinter = hint.NewBaseHinter(ht)
+	s.EffectiveAt = base.Height(effectiveAt)
+
+	if !s.IsScheduled() {
+		return nil
+	}
+
+	hinter, err := enc.Decode(bpo)
+	if err != nil {
+		return err
+	}
+
+	policy, ok := hinter.(types.CollectionPolicy)
+	if !ok {
+		return common.ErrTypeMismatch.Wrap(errors.Errorf("expected CollectionPolicy, not %T", hinter))
+	}
+	s.Policy = policy
+
+	return nil
+}
+
+func (s *OwnershipStateValue) unpack(enc encoder.Encoder, fr, to string, accepted bool) error {
+	from, err := base.DecodeAddress(fr, enc)
+	if err != nil {
+		return err
+	}
+	s.from = from
+
+	owner, err := base.DecodeAddress(to, enc)
+	if err != nil {
+		return err
+	}
+	s.to = owner
+	s.accepted = accepted
+
+	return nil
+}
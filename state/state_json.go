@@ -3,6 +3,7 @@ package state
 import (
 	"encoding/json"
 
+	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
 	"github.com/imfact-labs/mitum2/util/encoder"
 	"github.com/imfact-labs/mitum2/util/hint"
@@ -147,3 +148,430 @@ func (s *OperatorsBookStateValue) DecodeJSON(b []byte, enc encoder.Encoder) erro
 
 	return nil
 }
+
+type DenylistStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Denied bool `json:"denied"`
+}
+
+func (s DenylistStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		DenylistStateValueJSONMarshaler{
+			BaseHinter: s.BaseHinter,
+			Denied:     s.denied,
+		},
+	)
+}
+
+type DenylistStateValueJSONUnmarshaler struct {
+	Hint   hint.Hint `json:"_hint"`
+	Denied bool      `json:"denied"`
+}
+
+func (s *DenylistStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of DenylistStateValue")
+
+	var u DenylistStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+	s.denied = u.Denied
+
+	return nil
+}
+
+type ClawbackStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	From   base.Address `json:"from"`
+	To     base.Address `json:"to"`
+	Reason string       `json:"reason"`
+}
+
+func (s ClawbackStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		ClawbackStateValueJSONMarshaler{
+			BaseHinter: s.BaseHinter,
+			From:       s.from,
+			To:         s.to,
+			Reason:     s.reason,
+		},
+	)
+}
+
+type ClawbackStateValueJSONUnmarshaler struct {
+	Hint   hint.Hint `json:"_hint"`
+	From   string    `json:"from"`
+	To     string    `json:"to"`
+	Reason string    `json:"reason"`
+}
+
+func (s *ClawbackStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of ClawbackStateValue")
+
+	var u ClawbackStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	return s.unpack(enc, u.From, u.To, u.Reason)
+}
+
+type RevealStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Reveal types.Reveal `json:"reveal"`
+}
+
+func (s RevealStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		RevealStateValueJSONMarshaler(s),
+	)
+}
+
+type RevealStateValueJSONUnmarshaler struct {
+	Hint   hint.Hint       `json:"_hint"`
+	Reveal json.RawMessage `json:"reveal"`
+}
+
+func (s *RevealStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of RevealStateValue")
+
+	var u RevealStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var r types.Reveal
+	if err := r.DecodeJSON(u.Reveal, enc); err != nil {
+		return e.Wrap(err)
+	}
+	s.Reveal = r
+
+	return nil
+}
+
+type DynamicStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Dynamic types.DynamicState `json:"dynamic"`
+}
+
+func (s DynamicStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		DynamicStateValueJSONMarshaler(s),
+	)
+}
+
+type DynamicStateValueJSONUnmarshaler struct {
+	Hint    hint.Hint       `json:"_hint"`
+	Dynamic json.RawMessage `json:"dynamic"`
+}
+
+func (s *DynamicStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of DynamicStateValue")
+
+	var u DynamicStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var d types.DynamicState
+	if err := d.DecodeJSON(u.Dynamic, enc); err != nil {
+		return e.Wrap(err)
+	}
+	s.Dynamic = d
+
+	return nil
+}
+
+type MintPoolStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Index uint64 `json:"index"`
+}
+
+func (s MintPoolStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		MintPoolStateValueJSONMarshaler{
+			BaseHinter: s.BaseHinter,
+			Index:      s.id,
+		},
+	)
+}
+
+type MintPoolStateValueJSONUnmarshaler struct {
+	Hint  hint.Hint `json:"_hint"`
+	Index uint64    `json:"index"`
+}
+
+func (s *MintPoolStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of MintPoolStateValue")
+
+	var u MintPoolStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	s.id = u.Index
+
+	return nil
+}
+
+type ContentChunkStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Data []byte `json:"data"`
+}
+
+func (s ContentChunkStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		ContentChunkStateValueJSONMarshaler{
+			BaseHinter: s.BaseHinter,
+			Data:       s.data,
+		},
+	)
+}
+
+type ContentChunkStateValueJSONUnmarshaler struct {
+	Hint hint.Hint `json:"_hint"`
+	Data []byte    `json:"data"`
+}
+
+func (s *ContentChunkStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of ContentChunkStateValue")
+
+	var u ContentChunkStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	s.data = u.Data
+
+	return nil
+}
+
+type ContentStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Content types.ContentInfo `json:"content"`
+}
+
+func (s ContentStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		ContentStateValueJSONMarshaler(s),
+	)
+}
+
+type ContentStateValueJSONUnmarshaler struct {
+	Hint    hint.Hint       `json:"_hint"`
+	Content json.RawMessage `json:"content"`
+}
+
+func (s *ContentStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of ContentStateValue")
+
+	var u ContentStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var c types.ContentInfo
+	if err := c.DecodeJSON(u.Content, enc); err != nil {
+		return e.Wrap(err)
+	}
+	s.Content = c
+
+	return nil
+}
+
+type SeriesStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Series types.Series `json:"series"`
+}
+
+func (s SeriesStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		SeriesStateValueJSONMarshaler(s),
+	)
+}
+
+type SeriesStateValueJSONUnmarshaler struct {
+	Hint   hint.Hint       `json:"_hint"`
+	Series json.RawMessage `json:"series"`
+}
+
+func (s *SeriesStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of SeriesStateValue")
+
+	var u SeriesStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var d types.Series
+	if err := d.DecodeJSON(u.Series, enc); err != nil {
+		return e.Wrap(err)
+	}
+	s.Series = d
+
+	return nil
+}
+
+type ProposalsStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Proposals []types.ConfigProposal `json:"proposals"`
+}
+
+func (s ProposalsStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		ProposalsStateValueJSONMarshaler(s),
+	)
+}
+
+type ProposalsStateValueJSONUnmarshaler struct {
+	Hint      hint.Hint       `json:"_hint"`
+	Proposals json.RawMessage `json:"proposals"`
+}
+
+func (s *ProposalsStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of ProposalsStateValue")
+
+	var u ProposalsStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	proposals, err := decodeProposals(u.Proposals, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	s.Proposals = proposals
+
+	return nil
+}
+
+type PendingPolicyStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Policy      *types.CollectionPolicy `json:"policy,omitempty"`
+	EffectiveAt base.Height             `json:"effective_at"`
+}
+
+func (s PendingPolicyStateValue) MarshalJSON() ([]byte, error) {
+	m := PendingPolicyStateValueJSONMarshaler{
+		BaseHinter:  s.BaseHinter,
+		EffectiveAt: s.EffectiveAt,
+	}
+
+	if s.IsScheduled() {
+		m.Policy = &s.Policy
+	}
+
+	return util.MarshalJSON(m)
+}
+
+type PendingPolicyStateValueJSONUnmarshaler struct {
+	Hint        hint.Hint       `json:"_hint"`
+	Policy      json.RawMessage `json:"policy"`
+	EffectiveAt int64           `json:"effective_at"`
+}
+
+func (s *PendingPolicyStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of PendingPolicyStateValue")
+
+	var u PendingPolicyStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := s.unpack(enc, u.Hint, u.Policy, u.EffectiveAt); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+type OwnershipStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	From     base.Address `json:"from"`
+	To       base.Address `json:"to"`
+	Accepted bool         `json:"accepted"`
+}
+
+func (s OwnershipStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		OwnershipStateValueJSONMarshaler{
+			BaseHinter: s.BaseHinter,
+			From:       s.from,
+			To:         s.to,
+			Accepted:   s.accepted,
+		},
+	)
+}
+
+type OwnershipStateValueJSONUnmarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	From     string    `json:"from"`
+	To       string    `json:"to"`
+	Accepted bool      `json:"accepted"`
+}
+
+func (s *OwnershipStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of OwnershipStateValue")
+
+	var u OwnershipStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	return s.unpack(enc, u.From, u.To, u.Accepted)
+}
+
+type ParamsStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Params types.Params `json:"params"`
+}
+
+func (s ParamsStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		ParamsStateValueJSONMarshaler(s),
+	)
+}
+
+type ParamsStateValueJSONUnmarshaler struct {
+	Hint   hint.Hint       `json:"_hint"`
+	Params json.RawMessage `json:"params"`
+}
+
+func (s *ParamsStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of ParamsStateValue")
+
+	var u ParamsStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var p types.Params
+	if err := p.DecodeJSON(u.Params, enc); err != nil {
+		return e.Wrap(err)
+	}
+	s.Params = p
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
 	"github.com/pkg/errors"
 )
 
@@ -17,6 +18,18 @@ const (
 	OperatorsKey
 	LastIDXKey
 	NFTKey
+	DenylistKey
+	ClawbackKey
+	RevealKey
+	MintPoolKey
+	DynamicKey
+	ContentKey
+	ContentChunkKey
+	SeriesKey
+	ProposalsKey
+	PendingPolicyKey
+	OwnershipKey
+	ParamsKey
 )
 
 var (
@@ -25,8 +38,23 @@ var (
 	StateKeyOperatorsSuffix  = "operators"
 	StateKeyLastNFTIDXSuffix = "lastnftidx"
 	StateKeyNFTSuffix        = "nft"
+	StateKeyDenylistSuffix   = "denylist"
+	StateKeyClawbackSuffix   = "clawback"
+	StateKeyRevealSuffix     = "reveal"
+	StateKeyMintPoolSuffix   = "mintpool"
+	StateKeyDynamicSuffix    = "dynamic"
+	StateKeyContentInfix     = "content"
+	StateKeyContentSuffix    = "info"
+	StateKeySeriesSuffix     = "series"
+	StateKeyProposalsSuffix  = "proposals"
+	StateKeyPendingSuffix    = "pendingpolicy"
+	StateKeyOwnershipSuffix  = "ownership"
+	StateKeyParamsSuffix     = "params"
 )
 
+// StateKeyParams is the key of the chain-level nft params.
+var StateKeyParams = fmt.Sprintf("%s:%s", NFTPrefix, StateKeyParamsSuffix)
+
 func StateKeyNFTPrefix(addr base.Address) string {
 	return fmt.Sprintf("%s:%s", NFTPrefix, addr.String())
 }
@@ -42,6 +70,8 @@ func NFTStateKey(
 		stateKey = fmt.Sprintf("%s:%s", prefix, StateKeyCollectionSuffix)
 	case LastIDXKey:
 		stateKey = fmt.Sprintf("%s:%s", prefix, StateKeyLastNFTIDXSuffix)
+	case RevealKey:
+		stateKey = fmt.Sprintf("%s:%s", prefix, StateKeyRevealSuffix)
 	}
 
 	return stateKey
@@ -51,14 +81,81 @@ func StateKeyOperators(contract base.Address, addr base.Address) string {
 	return fmt.Sprintf("%s:%s:%s", StateKeyNFTPrefix(contract), addr.String(), StateKeyOperatorsSuffix)
 }
 
+func StateKeyDenylist(contract base.Address, addr base.Address) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyNFTPrefix(contract), addr.String(), StateKeyDenylistSuffix)
+}
+
 func StateKeyNFT(contract base.Address, id uint64) string {
 	return fmt.Sprintf("%s:%s:%s", StateKeyNFTPrefix(contract), strconv.FormatUint(id, 10), StateKeyNFTSuffix)
 }
 
+// StateKeyClawback is the key of the clawback record of a forced transfer. It
+// has the hash of the operation, so every forced transfer of a nft keeps its
+// own record.
+func StateKeyClawback(contract base.Address, id uint64, op util.Hash) string {
+	return fmt.Sprintf("%s:%s:%s:%s",
+		StateKeyNFTPrefix(contract), strconv.FormatUint(id, 10), op.String(), StateKeyClawbackSuffix)
+}
+
+// StateKeyMintPool is the key of a slot of the random mint pool of a
+// collection; a slot without state holds its own position as nft idx.
+func StateKeyMintPool(contract base.Address, slot uint64) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyNFTPrefix(contract), strconv.FormatUint(slot, 10), StateKeyMintPoolSuffix)
+}
+
+// StateKeyDynamic is the key of the oracle-updated dynamic state of a nft.
+func StateKeyDynamic(contract base.Address, id uint64) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyNFTPrefix(contract), strconv.FormatUint(id, 10), StateKeyDynamicSuffix)
+}
+
+// StateKeySeries is the key of a series of a collection.
+func StateKeySeries(contract base.Address, id uint64) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyNFTPrefix(contract), strconv.FormatUint(id, 10), StateKeySeriesSuffix)
+}
+
+// StateKeyProposals is the key of the pending config proposals of a
+// collection.
+func StateKeyProposals(contract base.Address) string {
+	return fmt.Sprintf("%s:%s", StateKeyNFTPrefix(contract), StateKeyProposalsSuffix)
+}
+
+// StateKeyPendingPolicy is the key of the scheduled policy of a collection.
+func StateKeyPendingPolicy(contract base.Address) string {
+	return fmt.Sprintf("%s:%s", StateKeyNFTPrefix(contract), StateKeyPendingSuffix)
+}
+
+// StateKeyOwnership is the key of the latest ownership transfer of a
+// collection.
+func StateKeyOwnership(contract base.Address) string {
+	return fmt.Sprintf("%s:%s", StateKeyNFTPrefix(contract), StateKeyOwnershipSuffix)
+}
+
+// StateKeyContentChunk is the key of the n-th chunk of an on-chain content.
+func StateKeyContentChunk(contract base.Address, id, n uint64) string {
+	return fmt.Sprintf("%s:%s:%s:%s",
+		StateKeyNFTPrefix(contract), StateKeyContentInfix, strconv.FormatUint(id, 10), strconv.FormatUint(n, 10))
+}
+
+// StateKeyContent is the key of the info of a finalized on-chain content.
+func StateKeyContent(contract base.Address, id uint64) string {
+	return fmt.Sprintf("%s:%s:%s:%s",
+		StateKeyNFTPrefix(contract), StateKeyContentInfix, strconv.FormatUint(id, 10), StateKeyContentSuffix)
+}
+
 func ParseNFTStateKey(key string) (StateKey, error) {
 	if !strings.HasPrefix(key, NFTPrefix) {
 		return NilKey, errors.Errorf("invalid NFT State Key, %s", key)
 	}
+
+	// content keys end with the chunk number, so they are checked first.
+	if strings.Contains(key, ":"+StateKeyContentInfix+":") {
+		if strings.HasSuffix(key, ":"+StateKeyContentSuffix) {
+			return ContentKey, nil
+		}
+
+		return ContentChunkKey, nil
+	}
+
 	switch {
 	case strings.HasSuffix(key, StateKeyCollectionSuffix):
 		return CollectionKey, nil
@@ -68,6 +165,26 @@ func ParseNFTStateKey(key string) (StateKey, error) {
 		return LastIDXKey, nil
 	case strings.HasSuffix(key, StateKeyOperatorsSuffix):
 		return OperatorsKey, nil
+	case strings.HasSuffix(key, StateKeyDenylistSuffix):
+		return DenylistKey, nil
+	case strings.HasSuffix(key, StateKeyClawbackSuffix):
+		return ClawbackKey, nil
+	case strings.HasSuffix(key, StateKeyRevealSuffix):
+		return RevealKey, nil
+	case strings.HasSuffix(key, StateKeyMintPoolSuffix):
+		return MintPoolKey, nil
+	case strings.HasSuffix(key, StateKeyDynamicSuffix):
+		return DynamicKey, nil
+	case strings.HasSuffix(key, StateKeySeriesSuffix):
+		return SeriesKey, nil
+	case strings.HasSuffix(key, StateKeyProposalsSuffix):
+		return ProposalsKey, nil
+	case strings.HasSuffix(key, StateKeyPendingSuffix):
+		return PendingPolicyKey, nil
+	case strings.HasSuffix(key, StateKeyOwnershipSuffix):
+		return OwnershipKey, nil
+	case key == StateKeyParams:
+		return ParamsKey, nil
 	default:
 		return NilKey, errors.Errorf("invalid NFT State Key, %s", key)
 	}
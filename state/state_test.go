@@ -0,0 +1,49 @@
+package state
+
+import (
+	"testing"
+
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/nft-model/types"
+)
+
+func TestPendingPolicyStateValueApply(t *testing.T) {
+	a, err := ctypes.NewAddressFromString("0x4526f3D0EdC63D9EaeCD94D56551e0f061CFCa47fca")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	policy := types.NewCollectionPolicy("collection", 10, "https://example.com", nil)
+	design := types.NewDesign(a, a, true, false, 0, policy)
+
+	scheduled := types.NewCollectionPolicy("scheduled", 10, "https://example.com", nil)
+	pending := NewPendingPolicyStateValue(scheduled, base.Height(10))
+
+	cases := []struct {
+		name    string
+		pending PendingPolicyStateValue
+		height  base.Height
+		policy  types.CollectionName
+	}{
+		{name: "before effective", pending: pending, height: 9, policy: "collection"},
+		{name: "at effective", pending: pending, height: 10, policy: "scheduled"},
+		{name: "after effective", pending: pending, height: 11, policy: "scheduled"},
+		{name: "cleared", pending: NewClearedPendingPolicyStateValue(), height: 11, policy: "collection"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			de := c.pending.Apply(design, c.height)
+
+			p, ok := de.Policy().(types.CollectionPolicy)
+			if !ok {
+				t.Fatalf("expected %T, not %T", types.CollectionPolicy{}, de.Policy())
+			}
+
+			if p.Name() != c.policy {
+				t.Fatalf("expected policy %q, not %q", c.policy, p.Name())
+			}
+		})
+	}
+}
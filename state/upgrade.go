@@ -0,0 +1,29 @@
+package state
+
+import (
+	"github.com/imfact-labs/mitum2/base"
+)
+
+// UpgradeStateValue returns the state value with its nft or collection design
+// in the latest version and whether it was upgraded. StateNFTValue and
+// StateCollectionValue upgrade the values on read, so the older values are
+// rewritten lazily by the next operation updating them; UpgradeStates
+// rewrites them at once.
+func UpgradeStateValue(v base.StateValue) (base.StateValue, bool) {
+	switch t := v.(type) {
+	case NFTStateValue:
+		if t.NFT.IsLatest() {
+			return v, false
+		}
+
+		return NewNFTStateValue(t.NFT.Upgrade()), true
+	case CollectionStateValue:
+		if t.Design.IsLatest() {
+			return v, false
+		}
+
+		return NewCollectionStateValue(t.Design.Upgrade()), true
+	default:
+		return v, false
+	}
+}
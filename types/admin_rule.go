@@ -0,0 +1,111 @@
+package types
+
+import (
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+)
+
+var MaxAdmins = 10
+
+// AdminRule designates the admins of a collection. Once admins are set, the
+// collection config is changed only by the proposals approved by threshold
+// admins.
+type AdminRule struct {
+	admins    []base.Address
+	threshold uint
+}
+
+func NewAdminRule(admins []base.Address, threshold uint) AdminRule {
+	return AdminRule{admins: admins, threshold: threshold}
+}
+
+func (r AdminRule) IsValid([]byte) error {
+	if l := len(r.admins); l > MaxAdmins {
+		return util.ErrInvalid.Errorf("admins over max, %d > %d", l, MaxAdmins)
+	}
+
+	switch l := uint(len(r.admins)); {
+	case l < 1 && r.threshold > 0:
+		return util.ErrInvalid.Errorf("admin threshold without admins")
+	case l > 0 && (r.threshold < 1 || r.threshold > l):
+		return util.ErrInvalid.Errorf("admin threshold out of range, 1 <= %d <= %d", r.threshold, l)
+	}
+
+	founds := map[string]struct{}{}
+	for _, admin := range r.admins {
+		if err := admin.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[admin.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate admin, %v", admin)
+		}
+		founds[admin.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (r AdminRule) Bytes() []byte {
+	if r.IsEmpty() {
+		return nil
+	}
+
+	bs := make([][]byte, len(r.admins)+1)
+	for i, admin := range r.admins {
+		bs[i] = admin.Bytes()
+	}
+
+	bs[len(r.admins)] = util.UintToBytes(r.threshold)
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (r AdminRule) Admins() []base.Address {
+	return r.admins
+}
+
+func (r AdminRule) Threshold() uint {
+	return r.threshold
+}
+
+func (r AdminRule) IsEmpty() bool {
+	return len(r.admins) < 1 && r.threshold < 1
+}
+
+func (r AdminRule) IsAdmin(a base.Address) bool {
+	for _, admin := range r.admins {
+		if admin.Equal(a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Approved reports whether the approvals of the current admins reach the
+// threshold. Approvals of the accounts no longer admins are not counted.
+func (r AdminRule) Approved(approvals []base.Address) bool {
+	var count uint
+	for _, a := range approvals {
+		if r.IsAdmin(a) {
+			count++
+		}
+	}
+
+	return count >= r.threshold
+}
+
+func (r AdminRule) Equal(b AdminRule) bool {
+	if len(r.admins) != len(b.admins) || r.threshold != b.threshold {
+		return false
+	}
+
+	for i := range r.admins {
+		if !r.admins[i].Equal(b.admins[i]) {
+			return false
+		}
+	}
+
+	return true
+}
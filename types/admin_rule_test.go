@@ -0,0 +1,30 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/mitum2/base"
+)
+
+func TestAdminRuleApproved(t *testing.T) {
+	as := newTestAddresses(t, 4)
+	rule := NewAdminRule(as[:3], 2)
+
+	cases := []struct {
+		name      string
+		approvals []base.Address
+		expected  bool
+	}{
+		{"under threshold", as[:1], false},
+		{"threshold", as[:2], true},
+		{"non admin", []base.Address{as[0], as[3]}, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if rule.Approved(c.approvals) != c.expected {
+				t.Fatalf("expected %v", c.expected)
+			}
+		})
+	}
+}
@@ -12,8 +12,6 @@ import (
 	"github.com/pkg/errors"
 )
 
-var MaxAllApproved = 10
-
 var AllApprovedBookHint = hint.MustNewHint("mitum-nft-all-approved-book-v0.0.1")
 
 type AllApprovedBook struct {
@@ -127,10 +125,6 @@ func (ob *AllApprovedBook) Append(ag base.Address) error {
 		return errors.Errorf("account already in operators book, %v", ag)
 	}
 
-	if len(ob.allApproved) >= MaxAllApproved {
-		return errors.Errorf("max operators, %v", ag)
-	}
-
 	ob.allApproved = append(ob.allApproved, ag)
 
 	return nil
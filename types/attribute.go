@@ -0,0 +1,239 @@
+package types
+
+import (
+	"regexp"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/imfact-labs/mitum2/util"
+)
+
+var (
+	AttributeTypeString = AttributeType("string")
+	AttributeTypeInt    = AttributeType("int")
+	AttributeTypeBool   = AttributeType("bool")
+)
+
+// AttributeType is the type of an attribute value.
+type AttributeType string
+
+func (t AttributeType) IsValid([]byte) error {
+	switch t {
+	case AttributeTypeString, AttributeTypeInt, AttributeTypeBool:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("wrong attribute type, %v", t)
+	}
+}
+
+func (t AttributeType) Bytes() []byte {
+	return []byte(t)
+}
+
+func (t AttributeType) String() string {
+	return string(t)
+}
+
+var (
+	MaxAttributes           = 32
+	MaxAttributeKeyLength   = 64
+	MaxAttributeValueLength = 256
+	ReValidAttributeKey     = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_\-.]*$`)
+)
+
+// Attribute is an on-chain key-value trait of a nft. The value is kept in its
+// canonical text form; see Value for the typed value.
+type Attribute struct {
+	key   string
+	typ   AttributeType
+	value string
+}
+
+// NewAttribute returns the attribute of the value in text form. The text is
+// checked against the type by IsValid.
+func NewAttribute(key string, typ AttributeType, value string) Attribute {
+	return Attribute{key: key, typ: typ, value: value}
+}
+
+func NewStringAttribute(key, value string) Attribute {
+	return NewAttribute(key, AttributeTypeString, value)
+}
+
+func NewIntAttribute(key string, value int64) Attribute {
+	return NewAttribute(key, AttributeTypeInt, strconv.FormatInt(value, 10))
+}
+
+func NewBoolAttribute(key string, value bool) Attribute {
+	return NewAttribute(key, AttributeTypeBool, strconv.FormatBool(value))
+}
+
+func (a Attribute) IsValid([]byte) error {
+	if l := len(a.key); l < 1 || l > MaxAttributeKeyLength {
+		return util.ErrInvalid.Errorf("attribute key length out of range, %d", l)
+	}
+
+	if !ReValidAttributeKey.MatchString(a.key) {
+		return util.ErrInvalid.Errorf("wrong attribute key, %q", a.key)
+	}
+
+	if err := a.typ.IsValid(nil); err != nil {
+		return err
+	}
+
+	switch a.typ {
+	case AttributeTypeInt:
+		i, err := strconv.ParseInt(a.value, 10, 64)
+		if err != nil || strconv.FormatInt(i, 10) != a.value {
+			return util.ErrInvalid.Errorf("wrong int attribute value, %v: %q", a.key, a.value)
+		}
+	case AttributeTypeBool:
+		if a.value != "true" && a.value != "false" {
+			return util.ErrInvalid.Errorf("wrong bool attribute value, %v: %q", a.key, a.value)
+		}
+	default:
+		if !utf8.ValidString(a.value) {
+			return util.ErrInvalid.Errorf("attribute value is not valid utf-8, %v", a.key)
+		}
+
+		if l := utf8.RuneCountInString(a.value); l > MaxAttributeValueLength {
+			return util.ErrInvalid.Errorf(
+				"attribute value length over max, %v: %d > %d", a.key, l, MaxAttributeValueLength)
+		}
+	}
+
+	return nil
+}
+
+func (a Attribute) Bytes() []byte {
+	return LengthPrefixedBytes(
+		[]byte(a.key),
+		a.typ.Bytes(),
+		[]byte(a.value),
+	)
+}
+
+func (a Attribute) Key() string {
+	return a.key
+}
+
+func (a Attribute) Type() AttributeType {
+	return a.typ
+}
+
+// Text returns the canonical text form of the value.
+func (a Attribute) Text() string {
+	return a.value
+}
+
+// Value returns the typed value; string, int64 or bool.
+func (a Attribute) Value() interface{} {
+	switch a.typ {
+	case AttributeTypeInt:
+		i, _ := strconv.ParseInt(a.value, 10, 64)
+
+		return i
+	case AttributeTypeBool:
+		return a.value == "true"
+	default:
+		return a.value
+	}
+}
+
+func (a Attribute) Equal(b Attribute) bool {
+	return a.key == b.key && a.typ == b.typ && a.value == b.value
+}
+
+// Attributes is the bounded list of attributes of a nft. Keys are unique.
+type Attributes []Attribute
+
+func (as Attributes) IsValid([]byte) error {
+	if l := len(as); l > MaxAttributes {
+		return util.ErrInvalid.Errorf("attributes over max, %d > %d", l, MaxAttributes)
+	}
+
+	founds := map[string]struct{}{}
+	for _, a := range as {
+		if err := a.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[a.key]; found {
+			return util.ErrInvalid.Errorf("duplicate attribute key, %v", a.key)
+		}
+		founds[a.key] = struct{}{}
+	}
+
+	return nil
+}
+
+func (as Attributes) Bytes() []byte {
+	bs := make([][]byte, len(as))
+	for i, a := range as {
+		bs[i] = a.Bytes()
+	}
+
+	return LengthPrefixedBytes(bs...)
+}
+
+func (as Attributes) Get(key string) (Attribute, bool) {
+	for _, a := range as {
+		if a.key == key {
+			return a, true
+		}
+	}
+
+	return Attribute{}, false
+}
+
+// Update returns the attributes with the keys in remove deleted and the
+// attributes in set added or replaced. The order of the existing attributes
+// is kept and new ones are appended.
+func (as Attributes) Update(set Attributes, remove []string) Attributes {
+	removes := map[string]struct{}{}
+	for _, key := range remove {
+		removes[key] = struct{}{}
+	}
+
+	sets := map[string]Attribute{}
+	for _, a := range set {
+		sets[a.key] = a
+	}
+
+	var n Attributes
+	for _, a := range as {
+		if _, found := removes[a.key]; found {
+			continue
+		}
+
+		if s, found := sets[a.key]; found {
+			n = append(n, s)
+			delete(sets, a.key)
+
+			continue
+		}
+
+		n = append(n, a)
+	}
+
+	for _, a := range set {
+		if _, found := sets[a.key]; found {
+			n = append(n, a)
+		}
+	}
+
+	return n
+}
+
+func (as Attributes) Equal(b Attributes) bool {
+	if len(as) != len(b) {
+		return false
+	}
+
+	for i := range as {
+		if !as[i].Equal(b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/util"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func (a Attribute) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"key":   a.key,
+		"type":  a.typ,
+		"value": a.Value(),
+	})
+}
+
+type AttributeBSONUnmarshaler struct {
+	Key   string        `bson:"key"`
+	Type  string        `bson:"type"`
+	Value bson.RawValue `bson:"value"`
+}
+
+func (a *Attribute) UnmarshalBSON(b []byte) error {
+	e := util.StringError("failed to decode bson of Attribute")
+
+	var u AttributeBSONUnmarshaler
+	if err := bson.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	var v interface{}
+
+	switch AttributeType(u.Type) {
+	case AttributeTypeInt:
+		i, ok := u.Value.AsInt64OK()
+		if !ok {
+			return e.Errorf("expected int attribute value, %v", u.Key)
+		}
+		v = i
+	case AttributeTypeBool:
+		f, ok := u.Value.BooleanOK()
+		if !ok {
+			return e.Errorf("expected bool attribute value, %v", u.Key)
+		}
+		v = f
+	default:
+		s, ok := u.Value.StringValueOK()
+		if !ok {
+			return e.Errorf("expected string attribute value, %v", u.Key)
+		}
+		v = s
+	}
+
+	return a.unpack(u.Key, u.Type, v)
+}
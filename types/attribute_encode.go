@@ -0,0 +1,25 @@
+package types
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+func (a *Attribute) unpack(key, typ string, v interface{}) error {
+	a.key = key
+	a.typ = AttributeType(typ)
+
+	switch i := v.(type) {
+	case int64:
+		a.value = strconv.FormatInt(i, 10)
+	case bool:
+		a.value = strconv.FormatBool(i)
+	case string:
+		a.value = i
+	default:
+		return errors.Errorf("unknown attribute value type, %T", v)
+	}
+
+	return nil
+}
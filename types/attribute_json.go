@@ -0,0 +1,61 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/imfact-labs/mitum2/util"
+)
+
+type AttributeJSONMarshaler struct {
+	Key   string        `json:"key"`
+	Type  AttributeType `json:"type"`
+	Value interface{}   `json:"value"`
+}
+
+func (a Attribute) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AttributeJSONMarshaler{
+		Key:   a.key,
+		Type:  a.typ,
+		Value: a.Value(),
+	})
+}
+
+type AttributeJSONUnmarshaler struct {
+	Key   string          `json:"key"`
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+func (a *Attribute) UnmarshalJSON(b []byte) error {
+	e := util.StringError("failed to decode json of Attribute")
+
+	var u AttributeJSONUnmarshaler
+	if err := util.UnmarshalJSON(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	var v interface{}
+
+	switch AttributeType(u.Type) {
+	case AttributeTypeInt:
+		var i int64
+		if err := util.UnmarshalJSON(u.Value, &i); err != nil {
+			return e.Wrap(err)
+		}
+		v = i
+	case AttributeTypeBool:
+		var f bool
+		if err := util.UnmarshalJSON(u.Value, &f); err != nil {
+			return e.Wrap(err)
+		}
+		v = f
+	default:
+		var s string
+		if err := util.UnmarshalJSON(u.Value, &s); err != nil {
+			return e.Wrap(err)
+		}
+		v = s
+	}
+
+	return a.unpack(u.Key, u.Type, v)
+}
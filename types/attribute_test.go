@@ -0,0 +1,69 @@
+package types
+
+import "testing"
+
+func TestAttributesIsValid(t *testing.T) {
+	cases := []struct {
+		name  string
+		as    Attributes
+		valid bool
+	}{
+		{"typed", Attributes{NewStringAttribute("color", "red"), NewIntAttribute("level", -3), NewBoolAttribute("rare", true)}, true},
+		{"duplicate key", Attributes{NewStringAttribute("color", "red"), NewStringAttribute("color", "blue")}, false},
+		{"wrong key", Attributes{NewStringAttribute("-color", "red")}, false},
+		{"wrong int", Attributes{NewAttribute("level", AttributeTypeInt, "03")}, false},
+		{"wrong bool", Attributes{NewAttribute("rare", AttributeTypeBool, "yes")}, false},
+		{"unknown type", Attributes{NewAttribute("color", AttributeType("float"), "1.5")}, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if err := c.as.IsValid(nil); (err == nil) != c.valid {
+				t.Fatalf("expected valid %v, not %v", c.valid, err)
+			}
+		})
+	}
+}
+
+func TestAttributesUpdate(t *testing.T) {
+	as := Attributes{NewStringAttribute("a", "1"), NewStringAttribute("b", "2"), NewStringAttribute("c", "3")}
+
+	updated := as.Update(
+		Attributes{NewStringAttribute("d", "4"), NewStringAttribute("b", "5")},
+		[]string{"a"},
+	)
+
+	expected := Attributes{NewStringAttribute("b", "5"), NewStringAttribute("c", "3"), NewStringAttribute("d", "4")}
+	if !updated.Equal(expected) {
+		t.Fatalf("expected %v, not %v", expected, updated)
+	}
+
+	if !as.Equal(Attributes{NewStringAttribute("a", "1"), NewStringAttribute("b", "2"), NewStringAttribute("c", "3")}) {
+		t.Fatal("attributes changed by update")
+	}
+}
+
+func TestAttributesBytes(t *testing.T) {
+	if b := (Attributes{}).Bytes(); len(b) > 0 {
+		t.Fatalf("bytes of empty attributes, %x", b)
+	}
+
+	cases := map[string]Attributes{
+		"key":        {NewStringAttribute("ab", "c")},
+		"split key":  {NewStringAttribute("a", "bc")},
+		"one":        {NewStringAttribute("a", "bcstringd")},
+		"two":        {NewStringAttribute("a", "b"), NewStringAttribute("c", "d")},
+		"typed":      {NewAttribute("a", AttributeTypeInt, "1")},
+		"typed text": {NewStringAttribute("a", "1")},
+	}
+
+	founds := map[string]string{}
+	for name, as := range cases {
+		k := string(as.Bytes())
+		if found, ok := founds[k]; ok {
+			t.Fatalf("same bytes of %s and %s", found, name)
+		}
+
+		founds[k] = name
+	}
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"github.com/imfact-labs/mitum2/util"
+)
+
+// OptionalBytes returns the bytes of the optional fields added to a value
+// after its first version; a field with empty bytes is not set. Without any
+// field set it is empty, so the bytes of the values stored before the fields were
+// added are unchanged. Otherwise every field has a presence byte and the set
+// fields are length-prefixed, so the same value in different fields never
+// gives the same bytes.
+func OptionalBytes(fields ...[]byte) []byte {
+	var set bool
+	for i := range fields {
+		if len(fields[i]) > 0 {
+			set = true
+
+			break
+		}
+	}
+
+	if !set {
+		return nil
+	}
+
+	bs := make([][]byte, 0, len(fields)*3)
+	for i := range fields {
+		if len(fields[i]) < 1 {
+			bs = append(bs, []byte{0})
+
+			continue
+		}
+
+		bs = append(bs, []byte{1}, util.Uint64ToBytes(uint64(len(fields[i]))), fields[i])
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+// LengthPrefixedBytes returns the count of the fields followed by each
+// field with its length, so the fields never run into each other. Without
+// any field it is empty.
+func LengthPrefixedBytes(fields ...[]byte) []byte {
+	if len(fields) < 1 {
+		return nil
+	}
+
+	bs := make([][]byte, 0, 1+len(fields)*2)
+
+	bs = append(bs, util.Uint64ToBytes(uint64(len(fields))))
+	for i := range fields {
+		bs = append(bs, util.Uint64ToBytes(uint64(len(fields[i]))), fields[i])
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestOptionalBytes(t *testing.T) {
+	if b := OptionalBytes(nil, []byte{}); b != nil {
+		t.Fatalf("expected no bytes without fields, %x", b)
+	}
+
+	cases := [][]byte{
+		OptionalBytes([]byte{5}, nil),
+		OptionalBytes(nil, []byte{5}),
+		OptionalBytes([]byte{5}, []byte{5}),
+		OptionalBytes([]byte{5, 5}, nil),
+	}
+
+	for i := range cases {
+		for j := range cases {
+			if i != j && bytes.Equal(cases[i], cases[j]) {
+				t.Fatalf("same bytes of fields %d and %d, %x", i, j, cases[i])
+			}
+		}
+	}
+}
+
+func TestLengthPrefixedBytes(t *testing.T) {
+	if b := LengthPrefixedBytes(); b != nil {
+		t.Fatalf("expected no bytes without fields, %x", b)
+	}
+
+	cases := [][]byte{
+		LengthPrefixedBytes([]byte("ab"), []byte("c")),
+		LengthPrefixedBytes([]byte("a"), []byte("bc")),
+		LengthPrefixedBytes([]byte("abc")),
+		LengthPrefixedBytes([]byte("abc"), nil),
+	}
+
+	for i := range cases {
+		for j := range cases {
+			if i != j && bytes.Equal(cases[i], cases[j]) {
+				t.Fatalf("same bytes of fields %d and %d, %x", i, j, cases[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var MaxProposals = 10
+
+var ConfigProposalHint = hint.MustNewHint("mitum-nft-config-proposal-v0.0.1")
+
+// ConfigProposal is a pending change of the collection policy. It is applied
+// when the approvals of the collection admins reach the threshold before the
+// expiry height.
+type ConfigProposal struct {
+	hint.BaseHinter
+	id        uint64
+	proposer  base.Address
+	policy    CollectionPolicy
+	expiresAt base.Height
+	approvals []base.Address
+}
+
+func NewConfigProposal(
+	id uint64,
+	proposer base.Address,
+	policy CollectionPolicy,
+	expiresAt base.Height,
+	approvals []base.Address,
+) ConfigProposal {
+	return ConfigProposal{
+		BaseHinter: hint.NewBaseHinter(ConfigProposalHint),
+		id:         id,
+		proposer:   proposer,
+		policy:     policy,
+		expiresAt:  expiresAt,
+		approvals:  approvals,
+	}
+}
+
+func (p ConfigProposal) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		p.BaseHinter,
+		p.proposer,
+		p.policy,
+	); err != nil {
+		return err
+	}
+
+	if p.id < 1 {
+		return common.ErrValOOR.Wrap(errors.Errorf("proposal id under one, %d", p.id))
+	}
+
+	if p.expiresAt < 1 {
+		return common.ErrValOOR.Wrap(errors.Errorf("wrong proposal expiry height, %v", p.expiresAt))
+	}
+
+	if l := len(p.approvals); l > MaxAdmins {
+		return common.ErrArrayLen.Wrap(errors.Errorf("proposal approvals over max, %d > %d", l, MaxAdmins))
+	}
+
+	founds := map[string]struct{}{}
+	for _, a := range p.approvals {
+		if err := a.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[a.String()]; found {
+			return common.ErrDupVal.Wrap(errors.Errorf("duplicate approval, %v", a))
+		}
+		founds[a.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (p ConfigProposal) Bytes() []byte {
+	as := make([][]byte, len(p.approvals))
+	for i, a := range p.approvals {
+		as[i] = a.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		util.Uint64ToBytes(p.id),
+		p.proposer.Bytes(),
+		p.policy.Bytes(),
+		p.expiresAt.Bytes(),
+		util.ConcatBytesSlice(as...),
+	)
+}
+
+// Digest is the hash of the proposal without its approvals. An approval names
+// the digest with the id, so it can not count for another proposal proposed
+// again with the same id.
+func (p ConfigProposal) Digest() util.Hash {
+	return valuehash.NewSHA256(util.ConcatBytesSlice(
+		util.Uint64ToBytes(p.id),
+		p.proposer.Bytes(),
+		p.policy.Bytes(),
+		p.expiresAt.Bytes(),
+	))
+}
+
+func (p ConfigProposal) ID() uint64 {
+	return p.id
+}
+
+func (p ConfigProposal) Proposer() base.Address {
+	return p.proposer
+}
+
+func (p ConfigProposal) Policy() CollectionPolicy {
+	return p.policy
+}
+
+func (p ConfigProposal) ExpiresAt() base.Height {
+	return p.expiresAt
+}
+
+func (p ConfigProposal) Approvals() []base.Address {
+	return p.approvals
+}
+
+// IsExpired reports whether the proposal can not be approved at height.
+func (p ConfigProposal) IsExpired(height base.Height) bool {
+	return height >= p.expiresAt
+}
+
+func (p ConfigProposal) IsApprovedBy(a base.Address) bool {
+	for _, approval := range p.approvals {
+		if approval.Equal(a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PendingConfigProposals returns the proposals not expired at height. The
+// applied proposals are removed from the proposals when they are applied.
+func PendingConfigProposals(proposals []ConfigProposal, height base.Height) []ConfigProposal {
+	var pending []ConfigProposal
+	for _, p := range proposals {
+		if !p.IsExpired(height) {
+			pending = append(pending, p)
+		}
+	}
+
+	return pending
+}
+
+// WithApproval returns the proposal approved by a.
+func (p ConfigProposal) WithApproval(a base.Address) ConfigProposal {
+	approvals := make([]base.Address, len(p.approvals), len(p.approvals)+1)
+	copy(approvals, p.approvals)
+	p.approvals = append(approvals, a)
+
+	return p
+}
+
+// KeepFixed returns the policy with the fields that can not be changed after
+// registration taken from the current policy.
+func (policy CollectionPolicy) KeepFixed(current CollectionPolicy) CollectionPolicy {
+	policy.clawback = current.clawback
+	policy.mintMode = current.mintMode
+	policy.maxSupply = current.maxSupply
+
+	return policy
+}
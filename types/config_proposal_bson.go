@@ -0,0 +1,48 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func (p ConfigProposal) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"_hint":       p.Hint().String(),
+		"proposal_id": p.id,
+		"proposer":    p.proposer,
+		"policy":      p.policy,
+		"expires_at":  p.expiresAt,
+		"approvals":   p.approvals,
+	})
+}
+
+type ConfigProposalBSONUnmarshaler struct {
+	Hint      string   `bson:"_hint"`
+	ID        uint64   `bson:"proposal_id"`
+	Proposer  string   `bson:"proposer"`
+	Policy    bson.Raw `bson:"policy"`
+	ExpiresAt int64    `bson:"expires_at"`
+	Approvals []string `bson:"approvals"`
+}
+
+func (p *ConfigProposal) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ConfigProposal")
+
+	var u ConfigProposalBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := p.unpack(enc, ht, u.ID, u.Proposer, u.Policy, u.ExpiresAt, u.Approvals); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+func (p *ConfigProposal) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	id uint64,
+	pr string,
+	bpo []byte,
+	expiresAt int64,
+	aps []string,
+) error {
+	p.BaseHinter = hint.NewBaseHinter(ht)
+	p.id = id
+	p.expiresAt = base.Height(expiresAt)
+
+	proposer, err := base.DecodeAddress(pr, enc)
+	if err != nil {
+		return err
+	}
+	p.proposer = proposer
+
+	if hinter, err := enc.Decode(bpo); err != nil {
+		return err
+	} else if policy, ok := hinter.(CollectionPolicy); !ok {
+		return common.ErrTypeMismatch.Wrap(errors.Errorf("expected CollectionPolicy, not %T", hinter))
+	} else {
+		p.policy = policy
+	}
+
+	approvals := make([]base.Address, len(aps))
+	for i, ap := range aps {
+		a, err := base.DecodeAddress(ap, enc)
+		if err != nil {
+			return err
+		}
+		approvals[i] = a
+	}
+	p.approvals = approvals
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+type ConfigProposalJSONMarshaler struct {
+	hint.BaseHinter
+	ID        uint64           `json:"proposal_id"`
+	Digest    util.Hash        `json:"digest"`
+	Proposer  base.Address     `json:"proposer"`
+	Policy    CollectionPolicy `json:"policy"`
+	ExpiresAt base.Height      `json:"expires_at"`
+	Approvals []base.Address   `json:"approvals"`
+}
+
+func (p ConfigProposal) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ConfigProposalJSONMarshaler{
+		BaseHinter: p.BaseHinter,
+		ID:         p.id,
+		Digest:     p.Digest(),
+		Proposer:   p.proposer,
+		Policy:     p.policy,
+		ExpiresAt:  p.expiresAt,
+		Approvals:  p.approvals,
+	})
+}
+
+type ConfigProposalJSONUnmarshaler struct {
+	Hint      hint.Hint       `json:"_hint"`
+	ID        uint64          `json:"proposal_id"`
+	Proposer  string          `json:"proposer"`
+	Policy    json.RawMessage `json:"policy"`
+	ExpiresAt int64           `json:"expires_at"`
+	Approvals []string        `json:"approvals"`
+}
+
+func (p *ConfigProposal) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of ConfigProposal")
+
+	var u ConfigProposalJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := p.unpack(enc, u.Hint, u.ID, u.Proposer, u.Policy, u.ExpiresAt, u.Approvals); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/mitum2/base"
+)
+
+func TestPendingConfigProposals(t *testing.T) {
+	a := newTestAddress(t)
+
+	proposals := []ConfigProposal{
+		NewConfigProposal(0, a, CollectionPolicy{}, base.Height(10), nil),
+		NewConfigProposal(1, a, CollectionPolicy{}, base.Height(20), nil),
+		NewConfigProposal(2, a, CollectionPolicy{}, base.Height(30), nil),
+	}
+
+	cases := []struct {
+		height   base.Height
+		expected []uint64
+	}{
+		{base.Height(9), []uint64{0, 1, 2}},
+		{base.Height(10), []uint64{1, 2}},
+		{base.Height(25), []uint64{2}},
+		{base.Height(30), nil},
+	}
+
+	for _, c := range cases {
+		t.Run(c.height.String(), func(t *testing.T) {
+			pending := PendingConfigProposals(proposals, c.height)
+
+			ids := make([]uint64, len(pending))
+			for i := range pending {
+				ids[i] = pending[i].ID()
+			}
+
+			if len(ids) != len(c.expected) {
+				t.Fatalf("expected %v, not %v", c.expected, ids)
+			}
+
+			for i := range ids {
+				if ids[i] != c.expected[i] {
+					t.Fatalf("expected %v, not %v", c.expected, ids)
+				}
+			}
+		})
+	}
+}
+
+func TestConfigProposalDigest(t *testing.T) {
+	a, b := newTestAddress(t), newTestAddress(t)
+
+	p := NewConfigProposal(1, a, NewCollectionPolicy("collection", 0, "https://example.com", nil), base.Height(10), nil)
+
+	if !p.Digest().Equal(p.WithApproval(b).Digest()) {
+		t.Fatal("digest changed by approval")
+	}
+
+	other := NewConfigProposal(1, a, NewCollectionPolicy("other", 0, "https://example.com", nil), base.Height(10), nil)
+	if p.Digest().Equal(other.Digest()) {
+		t.Fatal("same digest of proposals with different policies")
+	}
+}
@@ -0,0 +1,139 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	MaxContentChunkSize  = 8192
+	MaxContentChunks     = uint64(256)
+	MaxContentTypeLength = 128
+	ReValidContentType   = regexp.MustCompile(
+		`^[a-zA-Z0-9][a-zA-Z0-9!#$&^_.+\-]*/[a-zA-Z0-9][a-zA-Z0-9!#$&^_.+\-]*(;[ -~]*)?$`)
+	OnChainURIScheme = "onchain"
+)
+
+// IsValidContentType checks the mime type of an on-chain content.
+func IsValidContentType(t string) error {
+	if l := len(t); l < 1 || l > MaxContentTypeLength {
+		return util.ErrInvalid.Errorf("content type length out of range, %d", l)
+	}
+
+	if !ReValidContentType.MatchString(t) {
+		return util.ErrInvalid.Errorf("wrong content type, %q", t)
+	}
+
+	return nil
+}
+
+var ContentInfoHint = hint.MustNewHint("mitum-nft-content-info-v0.0.1")
+
+// ContentInfo describes a finalized on-chain content. The content is the
+// concatenation of the chunks 0 to chunks-1 in order and hash is its sha256
+// hash. Once it is recorded, the chunks can not be changed.
+type ContentInfo struct {
+	hint.BaseHinter
+	chunks      uint64
+	size        uint64
+	hash        util.Hash
+	contentType string
+}
+
+func NewContentInfo(chunks, size uint64, hash util.Hash, contentType string) ContentInfo {
+	return ContentInfo{
+		BaseHinter:  hint.NewBaseHinter(ContentInfoHint),
+		chunks:      chunks,
+		size:        size,
+		hash:        hash,
+		contentType: contentType,
+	}
+}
+
+func (c ContentInfo) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		c.BaseHinter,
+		c.hash,
+	); err != nil {
+		return err
+	}
+
+	if c.chunks < 1 || c.chunks > MaxContentChunks {
+		return common.ErrValOOR.Wrap(
+			errors.Errorf("content chunks out of range, %d, 1 <= chunks <= %d", c.chunks, MaxContentChunks))
+	}
+
+	if c.size > c.chunks*uint64(MaxContentChunkSize) {
+		return util.ErrInvalid.Errorf("content size over max, %d > %d", c.size, c.chunks*uint64(MaxContentChunkSize))
+	}
+
+	return IsValidContentType(c.contentType)
+}
+
+func (c ContentInfo) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		util.Uint64ToBytes(c.chunks),
+		util.Uint64ToBytes(c.size),
+		c.hash.Bytes(),
+		[]byte(c.contentType),
+	)
+}
+
+func (c ContentInfo) Chunks() uint64 {
+	return c.chunks
+}
+
+func (c ContentInfo) Size() uint64 {
+	return c.size
+}
+
+func (c ContentInfo) Hash() util.Hash {
+	return c.hash
+}
+
+func (c ContentInfo) ContentType() string {
+	return c.contentType
+}
+
+// ContentDigest is the sha256 hash of the chunks concatenated in order.
+func ContentDigest(chunks ...[]byte) util.Hash {
+	return valuehash.NewSHA256(bytes.Join(chunks, nil))
+}
+
+// OnChainURI returns the uri of the on-chain content id stored in the
+// contract account, onchain://<contract>/<id>.
+func OnChainURI(contract fmt.Stringer, id uint64) URI {
+	return URI(fmt.Sprintf("%s://%s/%s", OnChainURIScheme, contract.String(), strconv.FormatUint(id, 10)))
+}
+
+// IsOnChainURI reports whether the uri has the on-chain scheme.
+func IsOnChainURI(uri URI) bool {
+	u, err := url.Parse(uri.String())
+
+	return err == nil && strings.EqualFold(u.Scheme, OnChainURIScheme)
+}
+
+// ParseOnChainURI returns the contract and content id of an on-chain uri.
+func ParseOnChainURI(uri URI) (string, uint64, error) {
+	u, err := url.Parse(uri.String())
+	if err != nil || !strings.EqualFold(u.Scheme, OnChainURIScheme) {
+		return "", 0, util.ErrInvalid.Errorf("not on-chain uri, %v", uri)
+	}
+
+	id, err := strconv.ParseUint(strings.TrimPrefix(u.Path, "/"), 10, 64)
+	if err != nil || u.Host == "" {
+		return "", 0, util.ErrInvalid.Errorf("wrong on-chain uri, %v", uri)
+	}
+
+	return u.Host, id, nil
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func (c ContentInfo) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"_hint":        c.Hint().String(),
+		"chunks":       c.chunks,
+		"size":         c.size,
+		"hash":         c.hash.String(),
+		"content_type": c.contentType,
+	})
+}
+
+type ContentInfoBSONUnmarshaler struct {
+	Hint        string `bson:"_hint"`
+	Chunks      uint64 `bson:"chunks"`
+	Size        uint64 `bson:"size"`
+	Hash        string `bson:"hash"`
+	ContentType string `bson:"content_type"`
+}
+
+func (c *ContentInfo) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ContentInfo")
+
+	var u ContentInfoBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	c.unpack(ht, u.Chunks, u.Size, u.Hash, u.ContentType)
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+)
+
+func (c *ContentInfo) unpack(
+	ht hint.Hint,
+	chunks, size uint64,
+	hs, ct string,
+) {
+	c.BaseHinter = hint.NewBaseHinter(ht)
+	c.chunks = chunks
+	c.size = size
+	c.hash = valuehash.NewBytesFromString(hs)
+	c.contentType = ct
+}
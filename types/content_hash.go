@@ -0,0 +1,205 @@
+package types
+
+import (
+	"encoding/base32"
+	"encoding/binary"
+	"strings"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/imfact-labs/mitum2/util"
+)
+
+var (
+	HashAlgorithmSHA2256    = HashAlgorithm("sha2-256")
+	HashAlgorithmSHA2512    = HashAlgorithm("sha2-512")
+	HashAlgorithmSHA3256    = HashAlgorithm("sha3-256")
+	HashAlgorithmSHA3512    = HashAlgorithm("sha3-512")
+	HashAlgorithmKeccak256  = HashAlgorithm("keccak-256")
+	HashAlgorithmBlake2b256 = HashAlgorithm("blake2b-256")
+)
+
+type hashAlgorithmSpec struct {
+	code uint64
+	size int
+}
+
+// hashAlgorithms maps the supported algorithms to their multihash code and
+// digest length.
+var hashAlgorithms = map[HashAlgorithm]hashAlgorithmSpec{
+	HashAlgorithmSHA2256:    {code: 0x12, size: 32},
+	HashAlgorithmSHA2512:    {code: 0x13, size: 64},
+	HashAlgorithmSHA3512:    {code: 0x14, size: 64},
+	HashAlgorithmSHA3256:    {code: 0x16, size: 32},
+	HashAlgorithmKeccak256:  {code: 0x1b, size: 32},
+	HashAlgorithmBlake2b256: {code: 0xb220, size: 32},
+}
+
+var MaxHashAlgorithms = 10
+
+// HashAlgorithm is the multihash name of a content hash algorithm.
+type HashAlgorithm string
+
+func (ha HashAlgorithm) IsValid([]byte) error {
+	if _, found := hashAlgorithms[ha]; !found {
+		return util.ErrInvalid.Errorf("unknown hash algorithm, %v", ha)
+	}
+
+	return nil
+}
+
+func (ha HashAlgorithm) Bytes() []byte {
+	return []byte(ha)
+}
+
+func (ha HashAlgorithm) String() string {
+	return string(ha)
+}
+
+// IsValidHashAlgorithms checks the hash algorithms accepted by a collection.
+func IsValidHashAlgorithms(algorithms []HashAlgorithm) error {
+	if l := len(algorithms); l > MaxHashAlgorithms {
+		return util.ErrInvalid.Errorf("hash algorithms over max, %d > %d", l, MaxHashAlgorithms)
+	}
+
+	founds := map[HashAlgorithm]struct{}{}
+	for _, ha := range algorithms {
+		if err := ha.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[ha]; found {
+			return util.ErrInvalid.Errorf("duplicate hash algorithm, %v", ha)
+		}
+		founds[ha] = struct{}{}
+	}
+
+	return nil
+}
+
+func hashAlgorithmsBytes(algorithms []HashAlgorithm) []byte {
+	bs := make([][]byte, len(algorithms))
+	for i, ha := range algorithms {
+		bs[i] = ha.Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func hashAlgorithmByCode(code uint64) (HashAlgorithm, bool) {
+	for ha, spec := range hashAlgorithms {
+		if spec.code == code {
+			return ha, true
+		}
+	}
+
+	return "", false
+}
+
+var cidBase32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// ContentHash is a typed content hash, the algorithm and the digest. Its
+// string form is the base58btc multihash; CIDv0 and CIDv1 in base32 are
+// also parsed.
+type ContentHash struct {
+	algorithm HashAlgorithm
+	digest    []byte
+}
+
+func NewContentHash(algorithm HashAlgorithm, digest []byte) ContentHash {
+	return ContentHash{algorithm: algorithm, digest: digest}
+}
+
+// ParseContentHash parses the multihash or CID string.
+func ParseContentHash(s string) (ContentHash, error) {
+	var b []byte
+
+	switch {
+	case strings.HasPrefix(s, "b"):
+		i, err := cidBase32.DecodeString(strings.ToUpper(s[1:]))
+		if err != nil {
+			return ContentHash{}, util.ErrInvalid.Errorf("wrong cid, %v: %v", s, err)
+		}
+
+		version, n := binary.Uvarint(i)
+		if n < 1 || version != 1 {
+			return ContentHash{}, util.ErrInvalid.Errorf("unsupported cid version, %v", s)
+		}
+		i = i[n:]
+
+		if _, n = binary.Uvarint(i); n < 1 {
+			return ContentHash{}, util.ErrInvalid.Errorf("wrong cid codec, %v", s)
+		}
+		b = i[n:]
+	default:
+		b = base58.Decode(s)
+	}
+
+	if len(b) < 1 {
+		return ContentHash{}, util.ErrInvalid.Errorf("wrong multihash, %q", s)
+	}
+
+	return decodeMultihash(b)
+}
+
+func decodeMultihash(b []byte) (ContentHash, error) {
+	code, n := binary.Uvarint(b)
+	if n < 1 {
+		return ContentHash{}, util.ErrInvalid.Errorf("wrong multihash code")
+	}
+	b = b[n:]
+
+	ha, found := hashAlgorithmByCode(code)
+	if !found {
+		return ContentHash{}, util.ErrInvalid.Errorf("unknown multihash code, 0x%x", code)
+	}
+
+	size, n := binary.Uvarint(b)
+	if n < 1 {
+		return ContentHash{}, util.ErrInvalid.Errorf("wrong multihash length")
+	}
+	b = b[n:]
+
+	if size != uint64(len(b)) {
+		return ContentHash{}, util.ErrInvalid.Errorf("multihash length mismatch, %d != %d", size, len(b))
+	}
+
+	ch := NewContentHash(ha, b)
+
+	return ch, ch.IsValid(nil)
+}
+
+func (ch ContentHash) IsValid([]byte) error {
+	if err := ch.algorithm.IsValid(nil); err != nil {
+		return err
+	}
+
+	if l, size := len(ch.digest), hashAlgorithms[ch.algorithm].size; l != size {
+		return util.ErrInvalid.Errorf("wrong digest length for %v, %d != %d", ch.algorithm, l, size)
+	}
+
+	return nil
+}
+
+func (ch ContentHash) Algorithm() HashAlgorithm {
+	return ch.algorithm
+}
+
+func (ch ContentHash) Digest() []byte {
+	return ch.digest
+}
+
+// Multihash returns the multihash bytes of the content hash.
+func (ch ContentHash) Multihash() []byte {
+	b := binary.AppendUvarint(nil, hashAlgorithms[ch.algorithm].code)
+	b = binary.AppendUvarint(b, uint64(len(ch.digest)))
+
+	return append(b, ch.digest...)
+}
+
+func (ch ContentHash) String() string {
+	return base58.Encode(ch.Multihash())
+}
+
+func (ch ContentHash) NFTHash() NFTHash {
+	return NFTHash(ch.String())
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+)
+
+func TestParseContentHash(t *testing.T) {
+	digest := sha256.Sum256([]byte("content"))
+	ch := NewContentHash(HashAlgorithmSHA2256, digest[:])
+
+	t.Run("multihash", func(t *testing.T) {
+		parsed, err := ParseContentHash(ch.String())
+		switch {
+		case err != nil:
+			t.Fatal(err)
+		case parsed.Algorithm() != HashAlgorithmSHA2256 || !bytes.Equal(parsed.Digest(), digest[:]):
+			t.Fatalf("expected %v, not %v", ch, parsed)
+		}
+	})
+
+	t.Run("nft hash", func(t *testing.T) {
+		parsed, err := ch.NFTHash().ContentHash()
+		switch {
+		case err != nil:
+			t.Fatal(err)
+		case parsed.String() != ch.String():
+			t.Fatalf("expected %v, not %v", ch, parsed)
+		}
+	})
+
+	for _, c := range []struct {
+		name string
+		s    string
+	}{
+		{"empty", ""},
+		{"digest length", NewContentHash(HashAlgorithmSHA2256, digest[:31]).String()},
+		{"unknown algorithm", NewContentHash(HashAlgorithm("md5"), digest[:16]).String()},
+		{"wrong cid", "b!!!"},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := ParseContentHash(c.s); err == nil {
+				t.Fatalf("%q parsed", c.s)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+type ContentInfoJSONMarshaler struct {
+	hint.BaseHinter
+	Chunks      uint64    `json:"chunks"`
+	Size        uint64    `json:"size"`
+	Hash        util.Hash `json:"hash"`
+	ContentType string    `json:"content_type"`
+}
+
+func (c ContentInfo) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ContentInfoJSONMarshaler{
+		BaseHinter:  c.BaseHinter,
+		Chunks:      c.chunks,
+		Size:        c.size,
+		Hash:        c.hash,
+		ContentType: c.contentType,
+	})
+}
+
+type ContentInfoJSONUnmarshaler struct {
+	Hint        hint.Hint `json:"_hint"`
+	Chunks      uint64    `json:"chunks"`
+	Size        uint64    `json:"size"`
+	Hash        string    `json:"hash"`
+	ContentType string    `json:"content_type"`
+}
+
+func (c *ContentInfo) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of ContentInfo")
+
+	var u ContentInfoJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	c.unpack(u.Hint, u.Chunks, u.Size, u.Hash, u.ContentType)
+
+	return nil
+}
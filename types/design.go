@@ -33,8 +33,6 @@ func (pp PaymentParameter) Uint() uint {
 	return uint(pp)
 }
 
-var MaxURILength = 1000
-
 type URI string
 
 func (uri URI) IsValid([]byte) error {
@@ -42,10 +40,6 @@ func (uri URI) IsValid([]byte) error {
 		return err
 	}
 
-	if l := len(uri); l > MaxURILength {
-		return util.ErrInvalid.Errorf("uri length over max, %d > %d", l, MaxURILength)
-	}
-
 	if uri != "" && strings.TrimSpace(string(uri)) == "" {
 		return util.ErrInvalid.Errorf("empty uri")
 	}
@@ -68,16 +62,20 @@ type Design struct {
 	contract base.Address
 	creator  base.Address
 	active   bool
+	paused   bool
 	count    uint64
 	policy   BasePolicy
 }
 
-func NewDesign(contract base.Address, creator base.Address, active bool, count uint64, policy BasePolicy) Design {
+func NewDesign(
+	contract base.Address, creator base.Address, active, paused bool, count uint64, policy BasePolicy,
+) Design {
 	return Design{
 		BaseHinter: hint.NewBaseHinter(DesignHint),
 		contract:   contract,
 		creator:    creator,
 		active:     active,
+		paused:     paused,
 		count:      count,
 		policy:     policy,
 	}
@@ -112,12 +110,20 @@ func (de Design) Bytes() []byte {
 		ab[0] = 0
 	}
 
+	// paused flag is appended only when set, so designs stored before the
+	// flag existed keep their hash.
+	var pb []byte
+	if de.paused {
+		pb = []byte{1}
+	}
+
 	return util.ConcatBytesSlice(
 		de.contract.Bytes(),
 		de.creator.Bytes(),
 		ab,
 		util.Uint64ToBytes(de.count),
 		de.policy.Bytes(),
+		pb,
 	)
 }
 
@@ -141,6 +147,10 @@ func (de Design) Active() bool {
 	return de.active
 }
 
+func (de Design) Paused() bool {
+	return de.paused
+}
+
 func (de Design) Count() uint64 {
 	return de.count
 }
@@ -177,6 +187,10 @@ func (de Design) Equal(cd Design) bool {
 		return false
 	}
 
+	if de.paused != cd.paused {
+		return false
+	}
+
 	if de.count != cd.count {
 		return false
 	}
@@ -14,6 +14,7 @@ func (de Design) MarshalBSON() ([]byte, error) {
 			"contract":         de.contract,
 			"creator":          de.creator,
 			"active":           de.active,
+			"paused":           de.paused,
 			"collection_count": de.count,
 			"policy":           de.policy,
 		})
@@ -24,6 +25,7 @@ type DesignBSONUnmarshaler struct {
 	Contract string   `bson:"contract"`
 	Creator  string   `bson:"creator"`
 	Active   bool     `bson:"active"`
+	Paused   bool     `bson:"paused"`
 	Count    uint64   `bson:"collection_count"`
 	Policy   bson.Raw `bson:"policy"`
 }
@@ -41,5 +43,5 @@ func (de *Design) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return de.unpack(enc, ht, u.Contract, u.Creator, u.Active, u.Count, u.Policy)
+	return de.unpack(enc, ht, u.Contract, u.Creator, u.Active, u.Paused, u.Count, u.Policy)
 }
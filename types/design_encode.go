@@ -11,12 +11,13 @@ func (de *Design) unpack(
 	enc encoder.Encoder,
 	ht hint.Hint,
 	pAdr, crAdr string,
-	active bool,
+	active, paused bool,
 	count uint64,
 	bPcy []byte,
 ) error {
 	de.BaseHinter = hint.NewBaseHinter(ht)
 	de.active = active
+	de.paused = paused
 	de.count = count
 
 	contract, err := base.DecodeAddress(pAdr, enc)
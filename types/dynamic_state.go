@@ -0,0 +1,96 @@
+package types
+
+import (
+	"regexp"
+
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+var (
+	MaxDynamicVariantLength = 64
+	ReValidDynamicVariant   = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_\-.]*$`)
+)
+
+var DynamicStateHint = hint.MustNewHint("mitum-nft-dynamic-state-v0.0.1")
+
+// DynamicState is the small per-nft state updated by the oracles of the
+// collection. Counter is increased on every update and height is the block
+// height of the last update, so clients can detect changes cheaply.
+type DynamicState struct {
+	hint.BaseHinter
+	level   uint64
+	score   int64
+	variant string
+	counter uint64
+	height  base.Height
+}
+
+func NewDynamicState(level uint64, score int64, variant string, counter uint64, height base.Height) DynamicState {
+	return DynamicState{
+		BaseHinter: hint.NewBaseHinter(DynamicStateHint),
+		level:      level,
+		score:      score,
+		variant:    variant,
+		counter:    counter,
+		height:     height,
+	}
+}
+
+func (d DynamicState) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, d.BaseHinter, d.height); err != nil {
+		return err
+	}
+
+	return IsValidDynamicVariant(d.variant)
+}
+
+func (d DynamicState) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		util.Uint64ToBytes(d.level),
+		util.Int64ToBytes(d.score),
+		[]byte(d.variant),
+		util.Uint64ToBytes(d.counter),
+		d.height.Bytes(),
+	)
+}
+
+func (d DynamicState) Level() uint64 {
+	return d.level
+}
+
+func (d DynamicState) Score() int64 {
+	return d.score
+}
+
+// Variant returns the uri variant selected for the nft; empty means the
+// default uri.
+func (d DynamicState) Variant() string {
+	return d.variant
+}
+
+func (d DynamicState) Counter() uint64 {
+	return d.counter
+}
+
+func (d DynamicState) Height() base.Height {
+	return d.height
+}
+
+// IsValidDynamicVariant checks the uri variant. The empty variant is valid.
+func IsValidDynamicVariant(variant string) error {
+	if variant == "" {
+		return nil
+	}
+
+	if l := len(variant); l > MaxDynamicVariantLength {
+		return util.ErrInvalid.Errorf("dynamic variant length over max, %d > %d", l, MaxDynamicVariantLength)
+	}
+
+	if !ReValidDynamicVariant.MatchString(variant) {
+		return util.ErrInvalid.Errorf("wrong dynamic variant, %q", variant)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func (d DynamicState) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"_hint":   d.Hint().String(),
+		"level":   d.level,
+		"score":   d.score,
+		"variant": d.variant,
+		"counter": d.counter,
+		"height":  d.height,
+	})
+}
+
+type DynamicStateBSONUnmarshaler struct {
+	Hint    string `bson:"_hint"`
+	Level   uint64 `bson:"level"`
+	Score   int64  `bson:"score"`
+	Variant string `bson:"variant"`
+	Counter uint64 `bson:"counter"`
+	Height  int64  `bson:"height"`
+}
+
+func (d *DynamicState) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of DynamicState")
+
+	var u DynamicStateBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	d.unpack(ht, u.Level, u.Score, u.Variant, u.Counter, u.Height)
+
+	return nil
+}
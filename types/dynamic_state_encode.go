@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+func (d *DynamicState) unpack(
+	ht hint.Hint,
+	level uint64,
+	score int64,
+	variant string,
+	counter uint64,
+	height int64,
+) {
+	d.BaseHinter = hint.NewBaseHinter(ht)
+	d.level = level
+	d.score = score
+	d.variant = variant
+	d.counter = counter
+	d.height = base.Height(height)
+}
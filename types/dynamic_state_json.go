@@ -0,0 +1,50 @@
+package types
+
+import (
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+type DynamicStateJSONMarshaler struct {
+	hint.BaseHinter
+	Level   uint64      `json:"level"`
+	Score   int64       `json:"score"`
+	Variant string      `json:"variant"`
+	Counter uint64      `json:"counter"`
+	Height  base.Height `json:"height"`
+}
+
+func (d DynamicState) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(DynamicStateJSONMarshaler{
+		BaseHinter: d.BaseHinter,
+		Level:      d.level,
+		Score:      d.score,
+		Variant:    d.variant,
+		Counter:    d.counter,
+		Height:     d.height,
+	})
+}
+
+type DynamicStateJSONUnmarshaler struct {
+	Hint    hint.Hint `json:"_hint"`
+	Level   uint64    `json:"level"`
+	Score   int64     `json:"score"`
+	Variant string    `json:"variant"`
+	Counter uint64    `json:"counter"`
+	Height  int64     `json:"height"`
+}
+
+func (d *DynamicState) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of DynamicState")
+
+	var u DynamicStateJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	d.unpack(u.Hint, u.Level, u.Score, u.Variant, u.Counter, u.Height)
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+)
+
+// MaxMembershipPeriod is the longest membership period in blocks.
+var MaxMembershipPeriod uint64 = 100_000_000
+
+// MaxRenewPeriods is the most periods paid by a renewal.
+var MaxRenewPeriods uint64 = 1000
+
+// MembershipRule flags a collection as membership. The nfts of a membership
+// collection expire period blocks after mint and anyone can renew them by
+// paying amount of currency per period to the treasury.
+type MembershipRule struct {
+	currency ctypes.CurrencyID
+	amount   common.Big
+	period   uint64
+	treasury base.Address
+}
+
+func NewMembershipRule(
+	currency ctypes.CurrencyID, amount common.Big, period uint64, treasury base.Address,
+) MembershipRule {
+	return MembershipRule{currency: currency, amount: amount, period: period, treasury: treasury}
+}
+
+func (r MembershipRule) IsValid([]byte) error {
+	if r.IsEmpty() {
+		return nil
+	}
+
+	if err := util.CheckIsValiders(nil, false, r.currency, r.treasury); err != nil {
+		return err
+	}
+
+	if !r.amount.OverZero() {
+		return util.ErrInvalid.Errorf("membership amount under zero, %v", r.amount)
+	}
+
+	if r.period < 1 || r.period > MaxMembershipPeriod {
+		return util.ErrInvalid.Errorf(
+			"membership period out of range, %d, 1 <= period <= %d", r.period, MaxMembershipPeriod)
+	}
+
+	return nil
+}
+
+func (r MembershipRule) Bytes() []byte {
+	if r.IsEmpty() {
+		return nil
+	}
+
+	var tb []byte
+	if r.treasury != nil {
+		tb = r.treasury.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		r.currency.Bytes(),
+		[]byte(r.amount.String()),
+		util.Uint64ToBytes(r.period),
+		tb,
+	)
+}
+
+func (r MembershipRule) Currency() ctypes.CurrencyID {
+	return r.currency
+}
+
+// Amount returns the price of a period.
+func (r MembershipRule) Amount() common.Big {
+	return r.amount
+}
+
+// AmountString returns the amount in decimal, or empty for an empty rule.
+func (r MembershipRule) AmountString() string {
+	if r.IsEmpty() {
+		return ""
+	}
+
+	return r.amount.String()
+}
+
+// Period returns the number of blocks a payment extends the membership.
+func (r MembershipRule) Period() uint64 {
+	return r.period
+}
+
+// Treasury returns the account receiving the renewal payments.
+func (r MembershipRule) Treasury() base.Address {
+	return r.treasury
+}
+
+func (r MembershipRule) IsEmpty() bool {
+	return r.currency == "" && r.amount.IsZero() && r.period < 1 && r.treasury == nil
+}
+
+// IsMembership reports whether the collection is a membership collection.
+func (r MembershipRule) IsMembership() bool {
+	return !r.IsEmpty()
+}
+
+// Price returns the amount to pay for the periods; periods are bounded by
+// MaxRenewPeriods.
+func (r MembershipRule) Price(periods uint64) common.Big {
+	return r.amount.MulInt64(int64(periods))
+}
+
+// Extend returns the expiry height after paying the periods at height. An
+// expired membership is extended from height.
+func (r MembershipRule) Extend(expiresAt, height base.Height, periods uint64) base.Height {
+	from := expiresAt
+	if from < height {
+		from = height
+	}
+
+	return from + base.Height(r.period*periods)
+}
+
+func (r MembershipRule) Equal(b MembershipRule) bool {
+	if r.IsEmpty() || b.IsEmpty() {
+		return r.IsEmpty() == b.IsEmpty()
+	}
+
+	return r.currency == b.currency && r.amount.Equal(b.amount) && r.period == b.period &&
+		r.treasury != nil && r.treasury.Equal(b.treasury)
+}
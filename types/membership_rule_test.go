@@ -0,0 +1,35 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+func TestMembershipRuleExtend(t *testing.T) {
+	rule := NewMembershipRule("MCC", common.NewBig(10), 100, newTestAddress(t))
+
+	cases := []struct {
+		name      string
+		expiresAt base.Height
+		height    base.Height
+		periods   uint64
+		expected  base.Height
+	}{
+		{"not expired", 150, 120, 1, 250},
+		{"expired", 50, 120, 2, 320},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if h := rule.Extend(c.expiresAt, c.height, c.periods); h != c.expected {
+				t.Fatalf("expected %v, not %v", c.expected, h)
+			}
+		})
+	}
+
+	if p := rule.Price(3); !p.Equal(common.NewBig(30)) {
+		t.Fatalf("expected price 30, not %v", p)
+	}
+}
@@ -8,16 +8,11 @@ import (
 	"github.com/imfact-labs/mitum2/util/hint"
 )
 
-var MaxNFTHashLength = 1024
 var MaxNFTIndex uint64 = 10000
 
 type NFTHash string
 
 func (hs NFTHash) IsValid([]byte) error {
-	if l := len(hs); l > MaxNFTHashLength {
-		return util.ErrInvalid.Errorf("nft hash length over max, %d > %d", l, MaxNFTHashLength)
-	}
-
 	if hs != "" && strings.TrimSpace(string(hs)) == "" {
 		return util.ErrInvalid.Errorf("empty nft hash")
 	}
@@ -33,7 +28,18 @@ func (hs NFTHash) String() string {
 	return string(hs)
 }
 
-var NFTHint = hint.MustNewHint("mitum-nft-nft-v0.0.1")
+// ContentHash parses the hash as multihash or CID. Hashes in the legacy
+// free-form string fail to parse.
+func (hs NFTHash) ContentHash() (ContentHash, error) {
+	return ParseContentHash(string(hs))
+}
+
+var (
+	NFTHint = hint.MustNewHint("mitum-nft-nft-v0.0.2")
+	// NFTV1Hint is the hint of the nfts minted before attributes were added.
+	// They are still decoded as NFT.
+	NFTV1Hint = hint.MustNewHint("mitum-nft-nft-v0.0.1")
+)
 
 var MaxCreators = 10
 
@@ -46,6 +52,11 @@ type NFT struct {
 	uri      URI
 	approved base.Address
 	creators Signers
+	attrs    Attributes
+	expires  base.Height
+	series   uint64
+	royalty  PaymentParameter
+	receiver base.Address
 }
 
 func NewNFT(
@@ -69,6 +80,48 @@ func NewNFT(
 	}
 }
 
+func (n NFT) WithAttributes(attributes Attributes) NFT {
+	n.attrs = attributes
+
+	return n
+}
+
+// WithExpiry returns the nft with the height its membership expires at; zero
+// means the nft does not expire.
+func (n NFT) WithExpiry(expiresAt base.Height) NFT {
+	n.expires = expiresAt
+
+	return n
+}
+
+func (n NFT) WithSeries(series uint64) NFT {
+	n.series = series
+
+	return n
+}
+
+// WithRoyalty returns the nft with the royalty overriding the collection
+// royalty and the account receiving it instead of the creators.
+func (n NFT) WithRoyalty(royalty PaymentParameter, receiver base.Address) NFT {
+	n.royalty = royalty
+	n.receiver = receiver
+
+	return n
+}
+
+// WithOptionalFields returns the nft with the attributes, expiry, series and
+// royalty of o. It keeps them when an nft is rewritten with a new owner,
+// approved account or creators.
+func (n NFT) WithOptionalFields(o NFT) NFT {
+	n.attrs = o.attrs
+	n.expires = o.expires
+	n.series = o.series
+	n.royalty = o.royalty
+	n.receiver = o.receiver
+
+	return n
+}
+
 func (n NFT) IsValid([]byte) error {
 	if err := util.CheckIsValiders(nil, false,
 		n.owner,
@@ -76,12 +129,25 @@ func (n NFT) IsValid([]byte) error {
 		n.uri,
 		n.approved,
 		n.creators,
+		n.attrs,
+		n.royalty,
 	); err != nil {
 		return err
 	}
 
-	if n.uri == "" {
-		return util.ErrInvalid.Errorf("empty uri")
+	if n.Hint().Equal(NFTV1Hint) &&
+		(len(n.attrs) > 0 || n.expires != 0 || n.series != 0 || n.royalty != 0 || n.receiver != nil) {
+		return util.ErrInvalid.Errorf("attributes, expiry, series and royalty not supported by %v", n.Hint())
+	}
+
+	if n.receiver != nil {
+		if err := n.receiver.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if n.expires < 0 {
+		return util.ErrInvalid.Errorf("wrong expiry height, %v", n.expires)
 	}
 
 	return nil
@@ -96,6 +162,26 @@ func (n NFT) Bytes() []byte {
 		ba[0] = 0
 	}
 
+	var eb []byte
+	if n.expires != 0 {
+		eb = n.expires.Bytes()
+	}
+
+	var sb []byte
+	if n.series != 0 {
+		sb = util.Uint64ToBytes(n.series)
+	}
+
+	var rb []byte
+	if n.royalty != 0 {
+		rb = n.royalty.Bytes()
+	}
+
+	var rrb []byte
+	if n.receiver != nil {
+		rrb = n.receiver.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		util.Uint64ToBytes(n.id),
 		ba,
@@ -104,6 +190,8 @@ func (n NFT) Bytes() []byte {
 		[]byte(n.uri.String()),
 		n.approved.Bytes(),
 		n.creators.Bytes(),
+		n.attrs.Bytes(),
+		OptionalBytes(eb, sb, rb, rrb),
 	)
 }
 
@@ -123,10 +211,20 @@ func (n NFT) NFTHash() NFTHash {
 	return n.hash
 }
 
+// URI returns the stored uri of the nft. It is empty when the uri is resolved
+// from the collection's base uri; see CollectionPolicy.TokenURI.
 func (n NFT) URI() URI {
 	return n.uri
 }
 
+// WithURI returns a copy of the nft with the given uri. It is used to present
+// the resolved uri and does not change the state.
+func (n NFT) WithURI(uri URI) NFT {
+	n.uri = uri
+
+	return n
+}
+
 func (n NFT) Approved() base.Address {
 	return n.approved
 }
@@ -135,6 +233,49 @@ func (n NFT) Creators() Signers {
 	return n.creators
 }
 
+// Attributes returns the on-chain traits of the nft.
+func (n NFT) Attributes() Attributes {
+	return n.attrs
+}
+
+// ExpiresAt returns the height the membership of the nft expires at. It is
+// zero for the nfts of other collections, which never expire.
+func (n NFT) ExpiresAt() base.Height {
+	return n.expires
+}
+
+// Series returns the id of the series the nft was minted into; zero means the
+// nft belongs to no series.
+func (n NFT) Series() uint64 {
+	return n.series
+}
+
+// Royalty returns the royalty overriding the collection royalty; zero means
+// the nft follows the royalty of its series or collection.
+func (n NFT) Royalty() PaymentParameter {
+	return n.royalty
+}
+
+// RoyaltyReceiver returns the account receiving the royalties of the nft. When
+// it is nil, royalties are shared by the creators.
+func (n NFT) RoyaltyReceiver() base.Address {
+	return n.receiver
+}
+
+// IsValidMember reports whether the nft is an active membership at height.
+// Expired nfts are still owned but are not valid members.
+func (n NFT) IsValidMember(height base.Height) bool {
+	return n.active && (n.expires == 0 || height < n.expires)
+}
+
+// WithActive returns a copy of the nft with the given active flag. It is used
+// to present expired memberships as inactive and does not change the state.
+func (n NFT) WithActive(active bool) NFT {
+	n.active = active
+
+	return n
+}
+
 func (n NFT) Addresses() []base.Address {
 	var as []base.Address
 	copy(as, n.Creators().Addresses())
@@ -146,8 +287,6 @@ func (n NFT) Addresses() []base.Address {
 			as = append(as, n.approved)
 		}
 	}
-	as = append(as)
-
 	for i, a := range as {
 		if n.owner != a {
 			break
@@ -156,8 +295,6 @@ func (n NFT) Addresses() []base.Address {
 			as = append(as, n.owner)
 		}
 	}
-	as = append(as)
-
 	return as
 }
 
@@ -190,6 +327,26 @@ func (n NFT) Equal(cn NFT) bool {
 		return false
 	}
 
+	if !n.Attributes().Equal(cn.Attributes()) {
+		return false
+	}
+
+	if n.ExpiresAt() != cn.ExpiresAt() {
+		return false
+	}
+
+	if n.Series() != cn.Series() || n.Royalty() != cn.Royalty() {
+		return false
+	}
+
+	switch {
+	case n.receiver == nil && cn.receiver == nil:
+	case n.receiver == nil || cn.receiver == nil:
+		return false
+	case !n.receiver.Equal(cn.receiver):
+		return false
+	}
+
 	return n.ID() == cn.ID()
 }
 
@@ -9,7 +9,7 @@ import (
 )
 
 func (n NFT) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(bson.M{
+	m := bson.M{
 		"_hint":    n.Hint().String(),
 		"nft_idx":  n.id,
 		"active":   n.active,
@@ -18,18 +18,45 @@ func (n NFT) MarshalBSON() ([]byte, error) {
 		"uri":      n.uri,
 		"approved": n.approved,
 		"creators": n.creators,
-	})
+	}
+
+	if len(n.attrs) > 0 {
+		m["attributes"] = n.attrs
+	}
+
+	if n.expires != 0 {
+		m["expires_at"] = n.expires
+	}
+
+	if n.series != 0 {
+		m["series_id"] = n.series
+	}
+
+	if n.royalty != 0 {
+		m["royalty"] = n.royalty
+	}
+
+	if n.receiver != nil {
+		m["royalty_receiver"] = n.receiver
+	}
+
+	return bsonenc.Marshal(m)
 }
 
 type NFTBSONUnmarshaler struct {
-	Hint     string   `bson:"_hint"`
-	ID       uint64   `bson:"nft_idx"`
-	Active   bool     `bson:"active"`
-	Owner    string   `bson:"owner"`
-	Hash     string   `bson:"hash"`
-	URI      string   `bson:"uri"`
-	Approved string   `bson:"approved"`
-	Creators bson.Raw `bson:"creators"`
+	Hint     string     `bson:"_hint"`
+	ID       uint64     `bson:"nft_idx"`
+	Active   bool       `bson:"active"`
+	Owner    string     `bson:"owner"`
+	Hash     string     `bson:"hash"`
+	URI      string     `bson:"uri"`
+	Approved string     `bson:"approved"`
+	Creators bson.Raw   `bson:"creators"`
+	Attrs    Attributes `bson:"attributes,omitempty"`
+	Expires  int64      `bson:"expires_at,omitempty"`
+	Series   uint64     `bson:"series_id,omitempty"`
+	Royalty  uint       `bson:"royalty,omitempty"`
+	Receiver string     `bson:"royalty_receiver,omitempty"`
 }
 
 func (n *NFT) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -45,5 +72,5 @@ func (n *NFT) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return n.unpack(enc, ht, u.ID, u.Active, u.Owner, u.Hash, u.URI, u.Approved, u.Creators)
+	return n.unpack(enc, ht, u.ID, u.Active, u.Owner, u.Hash, u.URI, u.Approved, u.Creators, u.Attrs, u.Expires, u.Series, u.Royalty, u.Receiver)
 }
@@ -17,11 +17,20 @@ func (n *NFT) unpack(
 	uri string,
 	ap string,
 	bcrs []byte,
+	attrs Attributes,
+	expires int64,
+	series uint64,
+	ry uint,
+	rr string,
 ) error {
 	n.BaseHinter = hint.NewBaseHinter(ht)
 	n.active = ac
 	n.hash = NFTHash(hs)
 	n.uri = URI(uri)
+	n.attrs = attrs
+	n.expires = base.Height(expires)
+	n.series = series
+	n.royalty = PaymentParameter(ry)
 
 	owner, err := base.DecodeAddress(ow, enc)
 	if err != nil {
@@ -34,6 +43,12 @@ func (n *NFT) unpack(
 		return err
 	}
 	n.approved = approved
+
+	receiver, err := base.DecodeAddress(rr, enc)
+	if err != nil {
+		return err
+	}
+	n.receiver = receiver
 	n.id = id
 
 	if hinter, err := enc.Decode(bcrs); err != nil {
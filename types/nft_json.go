@@ -11,13 +11,18 @@ import (
 
 type NFTJSONMarshaler struct {
 	hint.BaseHinter
-	ID       uint64       `json:"nft_idx"`
-	Active   bool         `json:"active"`
-	Owner    base.Address `json:"owner"`
-	Hash     NFTHash      `json:"hash"`
-	URI      URI          `json:"uri"`
-	Approved base.Address `json:"approved"`
-	Creators Signers      `json:"creators"`
+	ID       uint64           `json:"nft_idx"`
+	Active   bool             `json:"active"`
+	Owner    base.Address     `json:"owner"`
+	Hash     NFTHash          `json:"hash"`
+	URI      URI              `json:"uri"`
+	Approved base.Address     `json:"approved"`
+	Creators Signers          `json:"creators"`
+	Attrs    Attributes       `json:"attributes,omitempty"`
+	Expires  base.Height      `json:"expires_at,omitempty"`
+	Series   uint64           `json:"series_id,omitempty"`
+	Royalty  PaymentParameter `json:"royalty,omitempty"`
+	Receiver base.Address     `json:"royalty_receiver,omitempty"`
 }
 
 func (n NFT) MarshalJSON() ([]byte, error) {
@@ -30,6 +35,11 @@ func (n NFT) MarshalJSON() ([]byte, error) {
 		URI:        n.uri,
 		Approved:   n.approved,
 		Creators:   n.creators,
+		Attrs:      n.attrs,
+		Expires:    n.expires,
+		Series:     n.series,
+		Royalty:    n.royalty,
+		Receiver:   n.receiver,
 	})
 }
 
@@ -42,6 +52,11 @@ type NFTJSONUnmarshaler struct {
 	URI      string          `json:"uri"`
 	Approved string          `json:"approved"`
 	Creators json.RawMessage `json:"creators"`
+	Attrs    Attributes      `json:"attributes"`
+	Expires  int64           `json:"expires_at"`
+	Series   uint64          `json:"series_id"`
+	Royalty  uint            `json:"royalty"`
+	Receiver string          `json:"royalty_receiver"`
 }
 
 func (n *NFT) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -52,5 +67,5 @@ func (n *NFT) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return n.unpack(enc, u.Hint, u.ID, u.Active, u.Owner, u.Hash, u.URI, u.Approved, u.Creators)
+	return n.unpack(enc, u.Hint, u.ID, u.Active, u.Owner, u.Hash, u.URI, u.Approved, u.Creators, u.Attrs, u.Expires, u.Series, u.Royalty, u.Receiver)
 }
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	ctypes "github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+)
+
+func newTestAddress(t *testing.T) base.Address {
+	t.Helper()
+
+	a, err := ctypes.NewAddressFromString("0x4526f3D0EdC63D9EaeCD94D56551e0f061CFCa47fca")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return a
+}
+
+func newTestAddresses(t *testing.T, n int) []base.Address {
+	t.Helper()
+
+	as := make([]base.Address, n)
+	for i := range as {
+		k, err := ctypes.NewBaseAccountKey(base.NewMPrivatekey().Publickey(), 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		keys, err := ctypes.NewBaseAccountKeys([]ctypes.AccountKey{k}, 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if as[i], err = ctypes.NewAddressFromKeys(keys); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return as
+}
+
+func newTestNFT(t *testing.T) NFT {
+	t.Helper()
+
+	a := newTestAddress(t)
+
+	return NewNFT(1, true, a, NFTHash("hash"), URI("https://example.com/1"), a, NewSigners(nil))
+}
+
+func TestNFTBytesWithoutOptionalFields(t *testing.T) {
+	n := newTestNFT(t)
+
+	legacy := util.ConcatBytesSlice(
+		util.Uint64ToBytes(n.id),
+		[]byte{1},
+		n.owner.Bytes(),
+		n.hash.Bytes(),
+		[]byte(n.uri.String()),
+		n.approved.Bytes(),
+		n.creators.Bytes(),
+	)
+
+	if !bytes.Equal(n.Bytes(), legacy) {
+		t.Fatal("bytes of nft without optional fields changed")
+	}
+}
+
+func TestNFTBytesExpiry(t *testing.T) {
+	n := newTestNFT(t)
+
+	if bytes.Equal(n.Bytes(), n.WithExpiry(5).Bytes()) {
+		t.Fatal("same bytes with and without expiry")
+	}
+
+	if bytes.Equal(n.WithExpiry(5).Bytes(), n.WithExpiry(6).Bytes()) {
+		t.Fatal("same bytes of different expiries")
+	}
+}
+
+func TestNFTBytesSeries(t *testing.T) {
+	n := newTestNFT(t)
+
+	if bytes.Equal(n.WithExpiry(5).Bytes(), n.WithSeries(5).Bytes()) {
+		t.Fatal("same bytes of expiry and series")
+	}
+}
+
+func TestNFTBytesRoyalty(t *testing.T) {
+	n := newTestNFT(t)
+	a := newTestAddress(t)
+
+	cases := [][]byte{
+		n.WithSeries(5).Bytes(),
+		n.WithRoyalty(5, nil).Bytes(),
+		n.WithRoyalty(0, a).Bytes(),
+		n.WithRoyalty(5, a).Bytes(),
+	}
+
+	for i := range cases {
+		for j := range cases {
+			if i != j && bytes.Equal(cases[i], cases[j]) {
+				t.Fatalf("same bytes of nfts %d and %d", i, j)
+			}
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+)
+
+var MaxOracles = 10
+
+// OracleRule designates the oracles allowed to update the dynamic state of
+// the nfts of a collection. Interval is the minimum number of blocks between
+// two updates of the same nft; zero means no limit.
+type OracleRule struct {
+	oracles  []base.Address
+	interval uint64
+}
+
+func NewOracleRule(oracles []base.Address, interval uint64) OracleRule {
+	return OracleRule{oracles: oracles, interval: interval}
+}
+
+func (r OracleRule) IsValid([]byte) error {
+	if l := len(r.oracles); l > MaxOracles {
+		return util.ErrInvalid.Errorf("oracles over max, %d > %d", l, MaxOracles)
+	}
+
+	if len(r.oracles) < 1 && r.interval > 0 {
+		return util.ErrInvalid.Errorf("dynamic update interval without oracles")
+	}
+
+	founds := map[string]struct{}{}
+	for _, oracle := range r.oracles {
+		if err := oracle.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[oracle.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate oracle, %v", oracle)
+		}
+		founds[oracle.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (r OracleRule) Bytes() []byte {
+	if r.IsEmpty() {
+		return nil
+	}
+
+	bs := make([][]byte, len(r.oracles)+1)
+	for i, oracle := range r.oracles {
+		bs[i] = oracle.Bytes()
+	}
+
+	bs[len(r.oracles)] = util.Uint64ToBytes(r.interval)
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (r OracleRule) Oracles() []base.Address {
+	return r.oracles
+}
+
+func (r OracleRule) Interval() uint64 {
+	return r.interval
+}
+
+func (r OracleRule) IsEmpty() bool {
+	return len(r.oracles) < 1 && r.interval < 1
+}
+
+func (r OracleRule) IsOracle(a base.Address) bool {
+	for _, oracle := range r.oracles {
+		if oracle.Equal(a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CanUpdate reports whether the dynamic state last updated at last may be
+// updated at height.
+func (r OracleRule) CanUpdate(last, height base.Height) bool {
+	return height >= last && uint64(height-last) >= r.interval
+}
+
+func (r OracleRule) Equal(b OracleRule) bool {
+	if len(r.oracles) != len(b.oracles) || r.interval != b.interval {
+		return false
+	}
+
+	for i := range r.oracles {
+		if !r.oracles[i].Equal(b.oracles[i]) {
+			return false
+		}
+	}
+
+	return true
+}
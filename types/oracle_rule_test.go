@@ -0,0 +1,29 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/mitum2/base"
+)
+
+func TestOracleRule(t *testing.T) {
+	as := newTestAddresses(t, 2)
+	rule := NewOracleRule([]base.Address{as[0]}, 10)
+
+	if !rule.IsOracle(as[0]) || rule.IsOracle(as[1]) {
+		t.Fatal("wrong oracle")
+	}
+
+	for _, c := range []struct {
+		last, height base.Height
+		expected     bool
+	}{
+		{100, 109, false},
+		{100, 110, true},
+		{100, 99, false},
+	} {
+		if rule.CanUpdate(c.last, c.height) != c.expected {
+			t.Fatalf("update at %v after %v, expected %v", c.height, c.last, c.expected)
+		}
+	}
+}
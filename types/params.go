@@ -0,0 +1,244 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var ParamsHint = hint.MustNewHint("mitum-nft-params-v0.0.1")
+
+// Params are the chain-level limits of the nft operations and the default uri
+// rule of the collections which do not declare their own. They are set in
+// the genesis block and updated by the suffrage; the facts only check their
+// structure and the processors check them against the current params.
+type Params struct {
+	hint.BaseHinter
+	maxMintItems     uint64
+	maxTransferItems uint64
+	maxWhitelist     uint64
+	maxAllApproved   uint64
+	maxSigners       uint64
+	maxURILength     uint64
+	maxNFTHashLength uint64
+	maxApproveItems  uint64
+	maxAddSignItems  uint64
+	uriRule          URIRule
+}
+
+func NewParams(
+	maxMintItems, maxTransferItems, maxWhitelist, maxAllApproved, maxSigners, maxURILength, maxNFTHashLength,
+	maxApproveItems, maxAddSignItems uint64,
+) Params {
+	return Params{
+		BaseHinter:       hint.NewBaseHinter(ParamsHint),
+		maxMintItems:     maxMintItems,
+		maxTransferItems: maxTransferItems,
+		maxWhitelist:     maxWhitelist,
+		maxAllApproved:   maxAllApproved,
+		maxSigners:       maxSigners,
+		maxURILength:     maxURILength,
+		maxNFTHashLength: maxNFTHashLength,
+		maxApproveItems:  maxApproveItems,
+		maxAddSignItems:  maxAddSignItems,
+	}
+}
+
+// WithURIRule returns the params with the default uri rule.
+func (p Params) WithURIRule(rule URIRule) Params {
+	p.uriRule = rule
+
+	return p
+}
+
+// DefaultParams are the params of the networks without the params state.
+func DefaultParams() Params {
+	return NewParams(100, 100, 20, 10, 10, 1000, 1024, 100, 100)
+}
+
+func (p Params) IsValid([]byte) error {
+	if err := p.BaseHinter.IsValid(ParamsHint.Type().Bytes()); err != nil {
+		return err
+	}
+
+	names := []string{
+		"max mint items", "max transfer items", "max whitelist", "max all approved",
+		"max signers", "max uri length", "max nft hash length", "max approve items", "max add signature items",
+	}
+	for i, v := range []uint64{
+		p.maxMintItems, p.maxTransferItems, p.maxWhitelist, p.maxAllApproved,
+		p.maxSigners, p.maxURILength, p.maxNFTHashLength, p.maxApproveItems, p.maxAddSignItems,
+	} {
+		if v < 1 {
+			return common.ErrValOOR.Wrap(errors.Errorf("%s under one", names[i]))
+		}
+	}
+
+	if p.maxURILength <= uint64(MaxTokenURIIDLength) {
+		return common.ErrValOOR.Wrap(errors.Errorf(
+			"max uri length not over max token uri id length, %d <= %d", p.maxURILength, MaxTokenURIIDLength))
+	}
+
+	return p.uriRule.IsValid(nil)
+}
+
+func (p Params) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		util.Uint64ToBytes(p.maxMintItems),
+		util.Uint64ToBytes(p.maxTransferItems),
+		util.Uint64ToBytes(p.maxWhitelist),
+		util.Uint64ToBytes(p.maxAllApproved),
+		util.Uint64ToBytes(p.maxSigners),
+		util.Uint64ToBytes(p.maxURILength),
+		util.Uint64ToBytes(p.maxNFTHashLength),
+		util.Uint64ToBytes(p.maxApproveItems),
+		util.Uint64ToBytes(p.maxAddSignItems),
+		OptionalBytes(p.uriRule.Bytes()),
+	)
+}
+
+func (p Params) MaxMintItems() uint64 {
+	return p.maxMintItems
+}
+
+func (p Params) MaxTransferItems() uint64 {
+	return p.maxTransferItems
+}
+
+func (p Params) MaxWhitelist() uint64 {
+	return p.maxWhitelist
+}
+
+func (p Params) MaxAllApproved() uint64 {
+	return p.maxAllApproved
+}
+
+func (p Params) MaxSigners() uint64 {
+	return p.maxSigners
+}
+
+func (p Params) MaxURILength() uint64 {
+	return p.maxURILength
+}
+
+func (p Params) MaxNFTHashLength() uint64 {
+	return p.maxNFTHashLength
+}
+
+func (p Params) MaxApproveItems() uint64 {
+	return p.maxApproveItems
+}
+
+func (p Params) MaxAddSignatureItems() uint64 {
+	return p.maxAddSignItems
+}
+
+// URIRule returns the default uri rule of the collections.
+func (p Params) URIRule() URIRule {
+	return p.uriRule
+}
+
+func (p Params) CheckMintItems(l int) error {
+	if uint64(l) > p.maxMintItems {
+		return common.ErrArrayLen.Wrap(errors.Errorf("items over allowed, %d > %d", l, p.maxMintItems))
+	}
+
+	return nil
+}
+
+func (p Params) CheckTransferItems(l int) error {
+	if uint64(l) > p.maxTransferItems {
+		return common.ErrArrayLen.Wrap(errors.Errorf("items over allowed, %d > %d", l, p.maxTransferItems))
+	}
+
+	return nil
+}
+
+func (p Params) CheckApproveItems(l int) error {
+	if uint64(l) > p.maxApproveItems {
+		return common.ErrArrayLen.Wrap(errors.Errorf("items over allowed, %d > %d", l, p.maxApproveItems))
+	}
+
+	return nil
+}
+
+func (p Params) CheckAddSignatureItems(l int) error {
+	if uint64(l) > p.maxAddSignItems {
+		return common.ErrArrayLen.Wrap(errors.Errorf("items over allowed, %d > %d", l, p.maxAddSignItems))
+	}
+
+	return nil
+}
+
+// CheckWhitelist checks the number of the minters of a collection or a
+// series.
+func (p Params) CheckWhitelist(l int) error {
+	if uint64(l) > p.maxWhitelist {
+		return common.ErrArrayLen.Wrap(errors.Errorf("whitelist over allowed, %d > %d", l, p.maxWhitelist))
+	}
+
+	return nil
+}
+
+func (p Params) CheckSigners(sgns Signers) error {
+	if l := len(sgns.Signers()); uint64(l) > p.maxSigners {
+		return common.ErrValOOR.Wrap(errors.Errorf("signers over allowed, %d > %d", l, p.maxSigners))
+	}
+
+	return nil
+}
+
+func (p Params) CheckURIs(uris ...URI) error {
+	for _, uri := range uris {
+		if l := len(uri); uint64(l) > p.maxURILength {
+			return util.ErrInvalid.Errorf("uri length over max, %d > %d", l, p.maxURILength)
+		}
+	}
+
+	return nil
+}
+
+// CheckBaseURI checks the length of the token uri resolved from the base uri
+// and uri suffix of a collection.
+func (p Params) CheckBaseURI(baseURI, suffix URI) error {
+	if l := len(baseURI) + MaxTokenURIIDLength + len(suffix); baseURI != "" && uint64(l) > p.maxURILength {
+		return util.ErrInvalid.Errorf("token uri length over max, %d > %d", l, p.maxURILength)
+	}
+
+	return nil
+}
+
+func (p Params) CheckNFTHash(hs NFTHash) error {
+	if l := len(hs); uint64(l) > p.maxNFTHashLength {
+		return util.ErrInvalid.Errorf("nft hash length over max, %d > %d", l, p.maxNFTHashLength)
+	}
+
+	return nil
+}
+
+// CheckNFT checks the uri, nft hash and creators of a nft.
+func (p Params) CheckNFT(n NFT) error {
+	if err := p.CheckURIs(n.URI()); err != nil {
+		return err
+	}
+
+	if err := p.CheckNFTHash(n.NFTHash()); err != nil {
+		return err
+	}
+
+	return p.CheckSigners(n.Creators())
+}
+
+// CheckPolicy checks the whitelist and uris of a collection policy.
+func (p Params) CheckPolicy(policy CollectionPolicy) error {
+	if err := p.CheckWhitelist(len(policy.Whitelist())); err != nil {
+		return err
+	}
+
+	if err := p.CheckURIs(append(policy.URIs(), policy.URISuffix())...); err != nil {
+		return err
+	}
+
+	return p.CheckBaseURI(policy.BaseURI(), policy.URISuffix())
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func (p Params) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"_hint":                   p.Hint().String(),
+		"max_mint_items":          p.maxMintItems,
+		"max_transfer_items":      p.maxTransferItems,
+		"max_whitelist":           p.maxWhitelist,
+		"max_all_approved":        p.maxAllApproved,
+		"max_signers":             p.maxSigners,
+		"max_uri_length":          p.maxURILength,
+		"max_nft_hash_length":     p.maxNFTHashLength,
+		"max_approve_items":       p.maxApproveItems,
+		"max_add_signature_items": p.maxAddSignItems,
+	}
+
+	if schemes := p.uriRule.Schemes(); len(schemes) > 0 {
+		m["uri_schemes"] = schemes
+	}
+
+	if hosts := p.uriRule.Hosts(); len(hosts) > 0 {
+		m["uri_hosts"] = hosts
+	}
+
+	return bsonenc.Marshal(m)
+}
+
+type ParamsBSONUnmarshaler struct {
+	Hint             string   `bson:"_hint"`
+	MaxMintItems     uint64   `bson:"max_mint_items"`
+	MaxTransferItems uint64   `bson:"max_transfer_items"`
+	MaxWhitelist     uint64   `bson:"max_whitelist"`
+	MaxAllApproved   uint64   `bson:"max_all_approved"`
+	MaxSigners       uint64   `bson:"max_signers"`
+	MaxURILength     uint64   `bson:"max_uri_length"`
+	MaxNFTHashLength uint64   `bson:"max_nft_hash_length"`
+	MaxApproveItems  uint64   `bson:"max_approve_items"`
+	MaxAddSignItems  uint64   `bson:"max_add_signature_items"`
+	URISchemes       []string `bson:"uri_schemes,omitempty"`
+	URIHosts         []string `bson:"uri_hosts,omitempty"`
+}
+
+func (p *Params) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of Params")
+
+	var u ParamsBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	p.unpack(ht,
+		u.MaxMintItems, u.MaxTransferItems, u.MaxWhitelist, u.MaxAllApproved,
+		u.MaxSigners, u.MaxURILength, u.MaxNFTHashLength, u.MaxApproveItems, u.MaxAddSignItems,
+		u.URISchemes, u.URIHosts,
+	)
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+func (p *Params) unpack(
+	ht hint.Hint,
+	maxMintItems, maxTransferItems, maxWhitelist, maxAllApproved, maxSigners, maxURILength, maxNFTHashLength,
+	maxApproveItems, maxAddSignItems uint64,
+	uriSchemes, uriHosts []string,
+) {
+	p.BaseHinter = hint.NewBaseHinter(ht)
+	p.maxMintItems = maxMintItems
+	p.maxTransferItems = maxTransferItems
+	p.maxWhitelist = maxWhitelist
+	p.maxAllApproved = maxAllApproved
+	p.maxSigners = maxSigners
+	p.maxURILength = maxURILength
+	p.maxNFTHashLength = maxNFTHashLength
+	p.maxApproveItems = maxApproveItems
+	p.maxAddSignItems = maxAddSignItems
+	p.uriRule = NewURIRule(uriSchemes, uriHosts)
+
+	// params stored before the approve and add-signature limits keep the
+	// defaults of them.
+	if p.maxApproveItems < 1 {
+		p.maxApproveItems = DefaultParams().maxApproveItems
+	}
+
+	if p.maxAddSignItems < 1 {
+		p.maxAddSignItems = DefaultParams().maxAddSignItems
+	}
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+type ParamsJSONMarshaler struct {
+	hint.BaseHinter
+	MaxMintItems     uint64   `json:"max_mint_items"`
+	MaxTransferItems uint64   `json:"max_transfer_items"`
+	MaxWhitelist     uint64   `json:"max_whitelist"`
+	MaxAllApproved   uint64   `json:"max_all_approved"`
+	MaxSigners       uint64   `json:"max_signers"`
+	MaxURILength     uint64   `json:"max_uri_length"`
+	MaxNFTHashLength uint64   `json:"max_nft_hash_length"`
+	MaxApproveItems  uint64   `json:"max_approve_items"`
+	MaxAddSignItems  uint64   `json:"max_add_signature_items"`
+	URISchemes       []string `json:"uri_schemes,omitempty"`
+	URIHosts         []string `json:"uri_hosts,omitempty"`
+}
+
+func (p Params) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ParamsJSONMarshaler{
+		BaseHinter:       p.BaseHinter,
+		MaxMintItems:     p.maxMintItems,
+		MaxTransferItems: p.maxTransferItems,
+		MaxWhitelist:     p.maxWhitelist,
+		MaxAllApproved:   p.maxAllApproved,
+		MaxSigners:       p.maxSigners,
+		MaxURILength:     p.maxURILength,
+		MaxNFTHashLength: p.maxNFTHashLength,
+		MaxApproveItems:  p.maxApproveItems,
+		MaxAddSignItems:  p.maxAddSignItems,
+		URISchemes:       p.uriRule.Schemes(),
+		URIHosts:         p.uriRule.Hosts(),
+	})
+}
+
+type ParamsJSONUnmarshaler struct {
+	Hint             hint.Hint `json:"_hint"`
+	MaxMintItems     uint64    `json:"max_mint_items"`
+	MaxTransferItems uint64    `json:"max_transfer_items"`
+	MaxWhitelist     uint64    `json:"max_whitelist"`
+	MaxAllApproved   uint64    `json:"max_all_approved"`
+	MaxSigners       uint64    `json:"max_signers"`
+	MaxURILength     uint64    `json:"max_uri_length"`
+	MaxNFTHashLength uint64    `json:"max_nft_hash_length"`
+	MaxApproveItems  uint64    `json:"max_approve_items"`
+	MaxAddSignItems  uint64    `json:"max_add_signature_items"`
+	URISchemes       []string  `json:"uri_schemes"`
+	URIHosts         []string  `json:"uri_hosts"`
+}
+
+func (p *Params) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of Params")
+
+	var u ParamsJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	p.unpack(u.Hint,
+		u.MaxMintItems, u.MaxTransferItems, u.MaxWhitelist, u.MaxAllApproved,
+		u.MaxSigners, u.MaxURILength, u.MaxNFTHashLength, u.MaxApproveItems, u.MaxAddSignItems,
+		u.URISchemes, u.URIHosts,
+	)
+
+	return nil
+}
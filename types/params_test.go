@@ -0,0 +1,70 @@
+package types
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestParamsCheckNFT(t *testing.T) {
+	n := newTestNFT(t)
+
+	if err := DefaultParams().CheckNFT(n); err != nil {
+		t.Fatalf("nft within default params: %v", err)
+	}
+
+	cases := []struct {
+		name   string
+		params Params
+	}{
+		{"uri", NewParams(100, 100, 20, 10, 10, uint64(len(n.URI())-1), 1024, 100, 100)},
+		{"nft hash", NewParams(100, 100, 20, 10, 10, 1000, uint64(len(n.NFTHash())-1), 100, 100)},
+		{"signers", NewParams(100, 100, 20, 10, 0, 1000, 1024, 100, 100)},
+	}
+
+	n = NewNFT(n.ID(), n.Active(), n.Owner(), n.NFTHash(), n.URI(), n.Approved(),
+		NewSigners([]Signer{NewSigner(n.Owner(), 100, false)}))
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if err := c.params.CheckNFT(n); err == nil || !strings.Contains(err.Error(), "over") {
+				t.Fatalf("nft over params: %v", err)
+			}
+		})
+	}
+}
+
+func TestParamsDecodeWithoutItemLimits(t *testing.T) {
+	var p Params
+	p.unpack(ParamsHint, 100, 100, 20, 10, 10, 1000, 1024, 0, 0, nil, nil)
+
+	if err := p.IsValid(nil); err != nil {
+		t.Fatalf("params stored without approve and add signature limits: %v", err)
+	}
+
+	d := DefaultParams()
+	if p.MaxApproveItems() != d.MaxApproveItems() || p.MaxAddSignatureItems() != d.MaxAddSignatureItems() {
+		t.Fatal("params stored without approve and add signature limits not in default")
+	}
+}
+
+func TestParamsURIRule(t *testing.T) {
+	p := DefaultParams()
+	rule := NewURIRule([]string{"ipfs"}, nil)
+
+	if !bytes.Equal(p.Bytes(), p.WithURIRule(URIRule{}).Bytes()) {
+		t.Fatal("bytes of params without uri rule changed")
+	}
+
+	if bytes.Equal(p.Bytes(), p.WithURIRule(rule).Bytes()) {
+		t.Fatal("same bytes with and without uri rule")
+	}
+
+	if err := p.WithURIRule(rule).IsValid(nil); err != nil {
+		t.Fatalf("params with uri rule: %v", err)
+	}
+
+	if err := p.WithURIRule(NewURIRule([]string{"IPFS"}, nil)).IsValid(nil); err == nil {
+		t.Fatal("params with wrong uri rule")
+	}
+}
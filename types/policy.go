@@ -4,16 +4,18 @@ import (
 	"bytes"
 	"regexp"
 	"sort"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/imfact-labs/currency-model/common"
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
 	"github.com/imfact-labs/mitum2/util/hint"
 	"github.com/pkg/errors"
+	"golang.org/x/text/unicode/norm"
 )
 
-var MaxWhitelist = 20
-
 var (
 	MinLengthCollectionName = 3
 	MaxLengthCollectionName = 30
@@ -22,8 +24,24 @@ var (
 
 type CollectionName string
 
+// NormalizeCollectionName returns the name in Unicode normalization form C.
+// Collection names must be normalized to be valid.
+func NormalizeCollectionName(s string) CollectionName {
+	return CollectionName(norm.NFC.String(s))
+}
+
+// IsValid checks the name counting Unicode characters. The name starts with
+// a letter or a digit followed by letters, marks, digits or spaces.
 func (cn CollectionName) IsValid([]byte) error {
-	l := len(cn)
+	if !utf8.ValidString(string(cn)) {
+		return util.ErrInvalid.Errorf("collection name is not valid utf-8, %q", cn)
+	}
+
+	if !norm.NFC.IsNormalString(string(cn)) {
+		return util.ErrInvalid.Errorf("collection name is not nfc normalized, %v", cn)
+	}
+
+	l := utf8.RuneCountInString(string(cn))
 
 	if l < MinLengthCollectionName {
 		return util.ErrInvalid.Errorf(
@@ -35,6 +53,25 @@ func (cn CollectionName) IsValid([]byte) error {
 			"collection name length over max, %d > %d", l, MaxLengthCollectionName)
 	}
 
+	for i, r := range string(cn) {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r):
+		case i > 0 && (unicode.IsMark(r) || r == ' '):
+		default:
+			return util.ErrInvalid.Errorf("wrong collection name, %v", cn)
+		}
+	}
+
+	return nil
+}
+
+// IsValidASCII checks the name of the policies before
+// CollectionPolicyHint; only ASCII letters, digits and spaces are allowed.
+func (cn CollectionName) IsValidASCII() error {
+	if err := cn.IsValid(nil); err != nil {
+		return err
+	}
+
 	if !ReValidCollectionName.Match([]byte(cn)) {
 		return util.ErrInvalid.Errorf("wrong collection name, %v", cn)
 	}
@@ -50,7 +87,135 @@ func (cn CollectionName) String() string {
 	return string(cn)
 }
 
-var CollectionPolicyHint = hint.MustNewHint("mitum-nft-collection-policy-v0.0.1")
+var (
+	MinLengthCollectionSymbol = 2
+	MaxLengthCollectionSymbol = 10
+	ReValidCollectionSymbol   = regexp.MustCompile(`^[A-Z0-9]+$`)
+)
+
+// CollectionSymbol is the ticker-style short name of a collection. It is
+// optional; the empty symbol is valid.
+type CollectionSymbol string
+
+func (cs CollectionSymbol) IsValid([]byte) error {
+	if cs == "" {
+		return nil
+	}
+
+	l := len(cs)
+
+	if l < MinLengthCollectionSymbol {
+		return util.ErrInvalid.Errorf(
+			"collection symbol length under min, %d < %d", l, MinLengthCollectionSymbol)
+	}
+
+	if l > MaxLengthCollectionSymbol {
+		return util.ErrInvalid.Errorf(
+			"collection symbol length over max, %d > %d", l, MaxLengthCollectionSymbol)
+	}
+
+	if !ReValidCollectionSymbol.Match([]byte(cs)) {
+		return util.ErrInvalid.Errorf("wrong collection symbol, %v", cs)
+	}
+
+	return nil
+}
+
+func (cs CollectionSymbol) Bytes() []byte {
+	return []byte(cs)
+}
+
+func (cs CollectionSymbol) String() string {
+	return string(cs)
+}
+
+var MaxLengthCollectionDescription = 1000
+
+type CollectionDescription string
+
+func (cd CollectionDescription) IsValid([]byte) error {
+	if !utf8.ValidString(string(cd)) {
+		return util.ErrInvalid.Errorf("collection description is not valid utf-8")
+	}
+
+	if l := utf8.RuneCountInString(string(cd)); l > MaxLengthCollectionDescription {
+		return util.ErrInvalid.Errorf(
+			"collection description length over max, %d > %d", l, MaxLengthCollectionDescription)
+	}
+
+	return nil
+}
+
+func (cd CollectionDescription) Bytes() []byte {
+	return []byte(cd)
+}
+
+func (cd CollectionDescription) String() string {
+	return string(cd)
+}
+
+var (
+	MintModeSequential = MintMode("sequential")
+	MintModeRandom     = MintMode("random")
+	MintModeExplicit   = MintMode("explicit")
+)
+
+// MintMode decides how a collection assigns nft idxes to minted nfts. The
+// empty mode is treated as MintModeSequential. In MintModeExplicit every
+// MintItem carries its own nft idx.
+type MintMode string
+
+func (mode MintMode) IsValid([]byte) error {
+	switch mode {
+	case "", MintModeSequential, MintModeRandom, MintModeExplicit:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("wrong mint mode, %v", mode)
+	}
+}
+
+func (mode MintMode) Bytes() []byte {
+	return []byte(mode)
+}
+
+func (mode MintMode) String() string {
+	return string(mode)
+}
+
+func (mode MintMode) IsRandom() bool {
+	return mode == MintModeRandom
+}
+
+func (mode MintMode) IsExplicit() bool {
+	return mode == MintModeExplicit
+}
+
+// MaxTokenURIIDLength is the length of the longest decimal nft idx placed
+// between the base uri and the uri suffix of a collection.
+var MaxTokenURIIDLength = 20
+
+// IsValidBaseURI checks the base uri and uri suffix of a collection. The
+// suffix is only allowed with a base uri; the length of the resolved token
+// uri is checked by Params.CheckBaseURI.
+func IsValidBaseURI(baseURI, suffix URI) error {
+	if err := util.CheckIsValiders(nil, false, baseURI, suffix); err != nil {
+		return err
+	}
+
+	if baseURI == "" && suffix != "" {
+		return util.ErrInvalid.Errorf("uri suffix without base uri")
+	}
+
+	return nil
+}
+
+var (
+	CollectionPolicyHint = hint.MustNewHint("mitum-nft-collection-policy-v0.0.2")
+	// CollectionPolicyV1Hint is the hint of the policies registered before
+	// collection metadata was added. They are still decoded as
+	// CollectionPolicy.
+	CollectionPolicyV1Hint = hint.MustNewHint("mitum-nft-collection-policy-v0.0.1")
+)
 
 type CollectionPolicy struct {
 	hint.BaseHinter
@@ -58,6 +223,24 @@ type CollectionPolicy struct {
 	royalty   PaymentParameter
 	uri       URI
 	whitelist []base.Address
+	pauser    base.Address
+	clawback  bool
+	mintMode  MintMode
+	maxSupply uint64
+	baseURI   URI
+	uriSuffix URI
+	symbol    CollectionSymbol
+	desc      CollectionDescription
+	external  URI
+	contract  URI
+	hashAlgs  []HashAlgorithm
+	uriRule   URIRule
+	updater   base.Address
+	oracle    OracleRule
+	member    MembershipRule
+	maxRoyal  PaymentParameter
+	admin     AdminRule
+	minDelay  uint64
 }
 
 func NewCollectionPolicy(name CollectionName, royalty PaymentParameter, uri URI, whitelist []base.Address) CollectionPolicy {
@@ -70,18 +253,144 @@ func NewCollectionPolicy(name CollectionName, royalty PaymentParameter, uri URI,
 	}
 }
 
+// WithPauser returns the policy with the account allowed to pause the
+// transfers besides the collection owner.
+func (policy CollectionPolicy) WithPauser(pauser base.Address) CollectionPolicy {
+	policy.pauser = pauser
+
+	return policy
+}
+
+func (policy CollectionPolicy) WithClawback(enabled bool) CollectionPolicy {
+	policy.clawback = enabled
+
+	return policy
+}
+
+func (policy CollectionPolicy) WithMintMode(mode MintMode, maxSupply uint64) CollectionPolicy {
+	policy.mintMode = mode
+	policy.maxSupply = maxSupply
+
+	return policy
+}
+
+func (policy CollectionPolicy) WithBaseURI(baseURI, suffix URI) CollectionPolicy {
+	policy.baseURI = baseURI
+	policy.uriSuffix = suffix
+
+	return policy
+}
+
+func (policy CollectionPolicy) WithMetadata(
+	symbol CollectionSymbol, description CollectionDescription, externalURL, contractURI URI,
+) CollectionPolicy {
+	policy.symbol = symbol
+	policy.desc = description
+	policy.external = externalURL
+	policy.contract = contractURI
+
+	return policy
+}
+
+func (policy CollectionPolicy) WithHashAlgorithms(algorithms []HashAlgorithm) CollectionPolicy {
+	policy.hashAlgs = algorithms
+
+	return policy
+}
+
+func (policy CollectionPolicy) WithURIRule(rule URIRule) CollectionPolicy {
+	policy.uriRule = rule
+
+	return policy
+}
+
+func (policy CollectionPolicy) WithAttributeUpdater(updater base.Address) CollectionPolicy {
+	policy.updater = updater
+
+	return policy
+}
+
+func (policy CollectionPolicy) WithOracleRule(rule OracleRule) CollectionPolicy {
+	policy.oracle = rule
+
+	return policy
+}
+
+func (policy CollectionPolicy) WithMembershipRule(rule MembershipRule) CollectionPolicy {
+	policy.member = rule
+
+	return policy
+}
+
+func (policy CollectionPolicy) WithMaxRoyalty(maxRoyalty PaymentParameter) CollectionPolicy {
+	policy.maxRoyal = maxRoyalty
+
+	return policy
+}
+
+func (policy CollectionPolicy) WithAdminRule(rule AdminRule) CollectionPolicy {
+	policy.admin = rule
+
+	return policy
+}
+
+func (policy CollectionPolicy) WithMinUpdateDelay(delay uint64) CollectionPolicy {
+	policy.minDelay = delay
+
+	return policy
+}
+
 func (policy CollectionPolicy) IsValid([]byte) error {
 	if err := util.CheckIsValiders(nil, false,
 		policy.BaseHinter,
 		policy.name,
 		policy.royalty,
 		policy.uri,
+		policy.mintMode,
+		policy.symbol,
+		policy.desc,
+		policy.external,
+		policy.contract,
+		policy.maxRoyal,
 	); err != nil {
 		return err
 	}
 
-	if l := len(policy.whitelist); l > MaxWhitelist {
-		return common.ErrArrayLen.Wrap(errors.Errorf("whitelist over allowed, %d > %d", l, MaxWhitelist))
+	if policy.Hint().Equal(CollectionPolicyV1Hint) {
+		if err := policy.name.IsValidASCII(); err != nil {
+			return err
+		}
+
+		if policy.symbol != "" || policy.desc != "" || policy.external != "" || policy.contract != "" ||
+			len(policy.hashAlgs) > 0 || !policy.uriRule.IsEmpty() || policy.updater != nil ||
+			!policy.oracle.IsEmpty() || !policy.member.IsEmpty() || policy.maxRoyal > 0 ||
+			!policy.admin.IsEmpty() || policy.minDelay > 0 {
+			return util.ErrInvalid.Errorf("collection metadata not supported by %v", policy.Hint())
+		}
+	}
+
+	if err := IsValidBaseURI(policy.baseURI, policy.uriSuffix); err != nil {
+		return err
+	}
+
+	if err := IsValidHashAlgorithms(policy.hashAlgs); err != nil {
+		return err
+	}
+
+	if err := policy.uriRule.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := policy.uriRule.CheckURIs(policy.URIs()...); err != nil {
+		return err
+	}
+
+	if policy.maxSupply > MaxCount {
+		return common.ErrValOOR.Wrap(errors.Errorf("max supply over max count, %d > %d", policy.maxSupply, MaxCount))
+	}
+
+	if policy.mintMode.IsRandom() && policy.maxSupply < 1 {
+		return util.ErrInvalid.Errorf("max supply required for %v mint mode", policy.mintMode)
 	}
 
 	founds := map[string]struct{}{}
@@ -95,6 +404,35 @@ func (policy CollectionPolicy) IsValid([]byte) error {
 		founds[white.String()] = struct{}{}
 	}
 
+	if policy.pauser != nil {
+		if err := policy.pauser.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if policy.updater != nil {
+		if err := policy.updater.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if err := policy.oracle.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := policy.member.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := policy.admin.IsValid(nil); err != nil {
+		return err
+	}
+
+	if policy.maxRoyal > 0 && policy.royalty > policy.maxRoyal {
+		return common.ErrValOOR.Wrap(
+			errors.Errorf("royalty over max royalty, %d > %d", policy.royalty, policy.maxRoyal))
+	}
+
 	return nil
 }
 
@@ -104,11 +442,61 @@ func (policy CollectionPolicy) Bytes() []byte {
 		as[i] = white.Bytes()
 	}
 
+	var pb []byte
+	if policy.pauser != nil {
+		pb = policy.pauser.Bytes()
+	}
+
+	var cb []byte
+	if policy.clawback {
+		cb = []byte{1}
+	}
+
+	var sb []byte
+	if policy.maxSupply > 0 {
+		sb = util.Uint64ToBytes(policy.maxSupply)
+	}
+
+	var ub []byte
+	if policy.updater != nil {
+		ub = policy.updater.Bytes()
+	}
+
+	var mb []byte
+	if policy.maxRoyal > 0 {
+		mb = policy.maxRoyal.Bytes()
+	}
+
+	var db []byte
+	if policy.minDelay > 0 {
+		db = util.Uint64ToBytes(policy.minDelay)
+	}
+
 	return util.ConcatBytesSlice(
 		policy.name.Bytes(),
 		policy.royalty.Bytes(),
 		policy.uri.Bytes(),
 		util.ConcatBytesSlice(as...),
+		OptionalBytes(
+			pb,
+			cb,
+			policy.mintMode.Bytes(),
+			sb,
+			policy.baseURI.Bytes(),
+			policy.uriSuffix.Bytes(),
+			policy.symbol.Bytes(),
+			policy.desc.Bytes(),
+			policy.external.Bytes(),
+			policy.contract.Bytes(),
+			hashAlgorithmsBytes(policy.hashAlgs),
+			policy.uriRule.Bytes(),
+			ub,
+			policy.oracle.Bytes(),
+			policy.member.Bytes(),
+			mb,
+			policy.admin.Bytes(),
+			db,
+		),
 	)
 }
 
@@ -128,8 +516,178 @@ func (policy CollectionPolicy) Whitelist() []base.Address {
 	return policy.whitelist
 }
 
+// Pauser returns the account allowed to pause and unpause transfers besides
+// the collection owner. It is nil when no pauser is designated.
+func (policy CollectionPolicy) Pauser() base.Address {
+	return policy.pauser
+}
+
+// ClawbackEnabled reports whether the collection owner may force
+// transfers of any nft in the collection. It is fixed at registration.
+func (policy CollectionPolicy) ClawbackEnabled() bool {
+	return policy.clawback
+}
+
+// MintMode returns how nft idxes are assigned at mint. It is fixed at
+// registration, so sequential and explicit idxes never mix in a collection.
+func (policy CollectionPolicy) MintMode() MintMode {
+	if policy.mintMode == "" {
+		return MintModeSequential
+	}
+
+	return policy.mintMode
+}
+
+// MaxSupply returns the maximum number of nfts in the collection; zero means
+// no limit other than MaxCount.
+func (policy CollectionPolicy) MaxSupply() uint64 {
+	return policy.maxSupply
+}
+
+// BaseURI returns the uri prefix of nfts minted without their own uri.
+func (policy CollectionPolicy) BaseURI() URI {
+	return policy.baseURI
+}
+
+// URISuffix returns the uri appended after the nft idx of nfts minted
+// without their own uri.
+func (policy CollectionPolicy) URISuffix() URI {
+	return policy.uriSuffix
+}
+
+// TokenURI returns the effective uri of the nft idx. The nft's own uri is
+// used when it is not empty, otherwise the uri is built from the base uri,
+// the idx and the uri suffix.
+func (policy CollectionPolicy) TokenURI(id uint64, uri URI) URI {
+	if uri != "" || policy.baseURI == "" {
+		return uri
+	}
+
+	return URI(policy.baseURI.String() + strconv.FormatUint(id, 10) + policy.uriSuffix.String())
+}
+
+func (policy CollectionPolicy) Symbol() CollectionSymbol {
+	return policy.symbol
+}
+
+func (policy CollectionPolicy) Description() CollectionDescription {
+	return policy.desc
+}
+
+// ExternalURL returns the link to the external page of the collection.
+func (policy CollectionPolicy) ExternalURL() URI {
+	return policy.external
+}
+
+// ContractURI returns the uri of the contract-level metadata of the
+// collection.
+func (policy CollectionPolicy) ContractURI() URI {
+	return policy.contract
+}
+
+// HashAlgorithms returns the content hash algorithms accepted for nft
+// hashes; any hash is accepted when it is empty.
+func (policy CollectionPolicy) HashAlgorithms() []HashAlgorithm {
+	return policy.hashAlgs
+}
+
+// IsValidNFTHash checks the nft hash against the accepted hash algorithms.
+// When algorithms are restricted, the hash must be a multihash or CID of one
+// of them.
+func (policy CollectionPolicy) IsValidNFTHash(hs NFTHash) error {
+	if len(policy.hashAlgs) < 1 {
+		return nil
+	}
+
+	ch, err := hs.ContentHash()
+	if err != nil {
+		return err
+	}
+
+	for _, ha := range policy.hashAlgs {
+		if ch.Algorithm() == ha {
+			return nil
+		}
+	}
+
+	return util.ErrInvalid.Errorf("hash algorithm %v not accepted", ch.Algorithm())
+}
+
+func (policy CollectionPolicy) URIRule() URIRule {
+	return policy.uriRule
+}
+
+// EffectiveURIRule returns the uri rule of the collection, or defaultRule
+// when the collection does not declare one.
+func (policy CollectionPolicy) EffectiveURIRule(defaultRule URIRule) URIRule {
+	if policy.uriRule.IsEmpty() {
+		return defaultRule
+	}
+
+	return policy.uriRule
+}
+
+// URIs returns the collection-level uris checked by the uri rule.
+func (policy CollectionPolicy) URIs() []URI {
+	return []URI{policy.uri, policy.baseURI, policy.external, policy.contract}
+}
+
+// AttributeUpdater returns the account allowed to update nft attributes
+// besides the collection owner. It is nil when no updater is designated.
+func (policy CollectionPolicy) AttributeUpdater() base.Address {
+	return policy.updater
+}
+
+// OracleRule returns the oracles allowed to update the dynamic state of the
+// nfts and the minimum block interval between updates of a nft.
+func (policy CollectionPolicy) OracleRule() OracleRule {
+	return policy.oracle
+}
+
+// MembershipRule returns the renewal price and period of the nfts of a
+// membership collection. It is empty for other collections.
+func (policy CollectionPolicy) MembershipRule() MembershipRule {
+	return policy.member
+}
+
+// AdminRule returns the admins approving the config changes of the
+// collection; it is empty when the collection owner changes the config alone.
+func (policy CollectionPolicy) AdminRule() AdminRule {
+	return policy.admin
+}
+
+// MaxRoyalty is the upper bound of the royalty overridden by each nft. Zero
+// means nfts can not override the collection royalty.
+func (policy CollectionPolicy) MaxRoyalty() PaymentParameter {
+	return policy.maxRoyal
+}
+
+// MinUpdateDelay is the minimum number of blocks between scheduling a policy
+// update and the height it takes effect. Zero allows immediate updates.
+func (policy CollectionPolicy) MinUpdateDelay() uint64 {
+	return policy.minDelay
+}
+
 func (policy CollectionPolicy) Addresses() ([]base.Address, error) {
-	return policy.whitelist, nil
+	as := make([]base.Address, len(policy.whitelist))
+	copy(as, policy.whitelist)
+
+	if policy.pauser != nil {
+		as = append(as, policy.pauser)
+	}
+
+	if policy.updater != nil {
+		as = append(as, policy.updater)
+	}
+
+	as = append(as, policy.oracle.Oracles()...)
+	as = append(as, policy.admin.Admins()...)
+
+	if treasury := policy.member.Treasury(); treasury != nil {
+		as = append(as, treasury)
+	}
+
+	return as, nil
 }
 
 func (policy CollectionPolicy) Equal(c BasePolicy) bool {
@@ -142,7 +700,7 @@ func (policy CollectionPolicy) Equal(c BasePolicy) bool {
 		return false
 	}
 
-	if policy.royalty != cPolicy.royalty {
+	if policy.royalty != cPolicy.royalty || policy.maxRoyal != cPolicy.maxRoyal || policy.minDelay != cPolicy.minDelay {
 		return false
 	}
 
@@ -150,6 +708,48 @@ func (policy CollectionPolicy) Equal(c BasePolicy) bool {
 		return false
 	}
 
+	if policy.clawback != cPolicy.clawback {
+		return false
+	}
+
+	if policy.MintMode() != cPolicy.MintMode() || policy.maxSupply != cPolicy.maxSupply {
+		return false
+	}
+
+	if policy.baseURI != cPolicy.baseURI || policy.uriSuffix != cPolicy.uriSuffix {
+		return false
+	}
+
+	if policy.symbol != cPolicy.symbol || policy.desc != cPolicy.desc ||
+		policy.external != cPolicy.external || policy.contract != cPolicy.contract {
+		return false
+	}
+
+	if !bytes.Equal(hashAlgorithmsBytes(policy.hashAlgs), hashAlgorithmsBytes(cPolicy.hashAlgs)) {
+		return false
+	}
+
+	if !policy.uriRule.Equal(cPolicy.uriRule) || !policy.oracle.Equal(cPolicy.oracle) ||
+		!policy.member.Equal(cPolicy.member) || !policy.admin.Equal(cPolicy.admin) {
+		return false
+	}
+
+	switch {
+	case policy.pauser == nil && cPolicy.pauser == nil:
+	case policy.pauser == nil || cPolicy.pauser == nil:
+		return false
+	case !policy.pauser.Equal(cPolicy.pauser):
+		return false
+	}
+
+	switch {
+	case policy.updater == nil && cPolicy.updater == nil:
+	case policy.updater == nil || cPolicy.updater == nil:
+		return false
+	case !policy.updater.Equal(cPolicy.updater):
+		return false
+	}
+
 	if len(policy.whitelist) != len(cPolicy.whitelist) {
 		return false
 	}
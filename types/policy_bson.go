@@ -8,13 +8,96 @@ import (
 )
 
 func (policy CollectionPolicy) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(bson.M{
+	m := bson.M{
 		"_hint":            policy.Hint().String(),
 		"name":             policy.name,
 		"royalty":          policy.royalty,
 		"uri":              policy.uri,
 		"minter_whitelist": policy.whitelist,
-	})
+		"clawback_enabled": policy.clawback,
+	}
+
+	if policy.pauser != nil {
+		m["pauser"] = policy.pauser
+	}
+
+	if policy.mintMode != "" {
+		m["mint_mode"] = policy.mintMode
+	}
+
+	if policy.maxSupply > 0 {
+		m["max_supply"] = policy.maxSupply
+	}
+
+	if policy.baseURI != "" {
+		m["base_uri"] = policy.baseURI
+	}
+
+	if policy.uriSuffix != "" {
+		m["uri_suffix"] = policy.uriSuffix
+	}
+
+	if policy.symbol != "" {
+		m["symbol"] = policy.symbol
+	}
+
+	if policy.desc != "" {
+		m["description"] = policy.desc
+	}
+
+	if policy.external != "" {
+		m["external_url"] = policy.external
+	}
+
+	if policy.contract != "" {
+		m["contract_uri"] = policy.contract
+	}
+
+	if len(policy.hashAlgs) > 0 {
+		m["hash_algorithms"] = policy.hashAlgs
+	}
+
+	if schemes := policy.uriRule.Schemes(); len(schemes) > 0 {
+		m["uri_schemes"] = schemes
+	}
+
+	if hosts := policy.uriRule.Hosts(); len(hosts) > 0 {
+		m["uri_hosts"] = hosts
+	}
+
+	if policy.updater != nil {
+		m["attribute_updater"] = policy.updater
+	}
+
+	if oracles := policy.oracle.Oracles(); len(oracles) > 0 {
+		m["oracles"] = oracles
+	}
+
+	if interval := policy.oracle.Interval(); interval > 0 {
+		m["dynamic_update_interval"] = interval
+	}
+
+	if policy.member.IsMembership() {
+		m["membership_currency"] = policy.member.Currency()
+		m["membership_amount"] = policy.member.Amount().String()
+		m["membership_period"] = policy.member.Period()
+		m["treasury"] = policy.member.Treasury()
+	}
+
+	if policy.maxRoyal > 0 {
+		m["max_royalty"] = policy.maxRoyal
+	}
+
+	if admins := policy.admin.Admins(); len(admins) > 0 {
+		m["admins"] = admins
+		m["admin_threshold"] = policy.admin.Threshold()
+	}
+
+	if policy.minDelay > 0 {
+		m["min_update_delay"] = policy.minDelay
+	}
+
+	return bsonenc.Marshal(m)
 }
 
 type PolicyBSONUnmarshaler struct {
@@ -23,6 +106,30 @@ type PolicyBSONUnmarshaler struct {
 	Royalty uint     `bson:"royalty"`
 	URI     string   `bson:"uri"`
 	Whites  []string `bson:"minter_whitelist"`
+	Pauser  string   `bson:"pauser,omitempty"`
+	Claw    bool     `bson:"clawback_enabled"`
+	Mode    string   `bson:"mint_mode,omitempty"`
+	Supply  uint64   `bson:"max_supply,omitempty"`
+	BaseURI string   `bson:"base_uri,omitempty"`
+	Suffix  string   `bson:"uri_suffix,omitempty"`
+	Symbol  string   `bson:"symbol,omitempty"`
+	Desc    string   `bson:"description,omitempty"`
+	Ext     string   `bson:"external_url,omitempty"`
+	CURI    string   `bson:"contract_uri,omitempty"`
+	HashAlg []string `bson:"hash_algorithms,omitempty"`
+	Schemes []string `bson:"uri_schemes,omitempty"`
+	Hosts   []string `bson:"uri_hosts,omitempty"`
+	Updater string   `bson:"attribute_updater,omitempty"`
+	Oracles []string `bson:"oracles,omitempty"`
+	Dynamic uint64   `bson:"dynamic_update_interval,omitempty"`
+	MCID    string   `bson:"membership_currency,omitempty"`
+	MAmount string   `bson:"membership_amount,omitempty"`
+	MPeriod uint64   `bson:"membership_period,omitempty"`
+	Treas   string   `bson:"treasury,omitempty"`
+	MaxRoy  uint     `bson:"max_royalty,omitempty"`
+	Admins  []string `bson:"admins,omitempty"`
+	AdminTh uint     `bson:"admin_threshold,omitempty"`
+	Delay   uint64   `bson:"min_update_delay,omitempty"`
 }
 
 func (policy *CollectionPolicy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -38,5 +145,8 @@ func (policy *CollectionPolicy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error
 		return e.Wrap(err)
 	}
 
-	return policy.unpack(enc, ht, u.Name, u.Royalty, u.URI, u.Whites)
+	return policy.unpack(enc, ht, u.Name, u.Royalty, u.URI, u.Whites, u.Pauser, u.Claw, u.Mode, u.Supply, u.BaseURI, u.Suffix,
+		u.Symbol, u.Desc, u.Ext, u.CURI, u.HashAlg, u.Schemes, u.Hosts, u.Updater,
+		u.Oracles, u.Dynamic, u.MCID, u.MAmount, u.MPeriod, u.Treas, u.MaxRoy,
+		u.Admins, u.AdminTh, u.Delay)
 }
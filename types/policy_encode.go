@@ -1,6 +1,8 @@
 package types
 
 import (
+	"github.com/imfact-labs/currency-model/common"
+	ctypes "github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util/encoder"
 	"github.com/imfact-labs/mitum2/util/hint"
@@ -13,11 +15,48 @@ func (policy *CollectionPolicy) unpack(
 	ry uint,
 	uri string,
 	bws []string,
+	ps string,
+	claw bool,
+	mm string,
+	supply uint64,
+	bu, sfx string,
+	sym, desc, ext, curi string,
+	has []string,
+	schemes, hosts []string,
+	up string,
+	ors []string,
+	interval uint64,
+	mcid, mam string,
+	period uint64,
+	treas string,
+	mry uint,
+	ads []string,
+	threshold uint,
+	delay uint64,
 ) error {
 	policy.BaseHinter = hint.NewBaseHinter(ht)
 	policy.name = CollectionName(nm)
 	policy.royalty = PaymentParameter(ry)
+	policy.maxRoyal = PaymentParameter(mry)
+	policy.minDelay = delay
 	policy.uri = URI(uri)
+	policy.clawback = claw
+	policy.mintMode = MintMode(mm)
+	policy.maxSupply = supply
+	policy.baseURI = URI(bu)
+	policy.uriSuffix = URI(sfx)
+	policy.symbol = CollectionSymbol(sym)
+	policy.desc = CollectionDescription(desc)
+	policy.external = URI(ext)
+	policy.contract = URI(curi)
+	policy.uriRule = NewURIRule(schemes, hosts)
+
+	if len(has) > 0 {
+		policy.hashAlgs = make([]HashAlgorithm, len(has))
+		for i, ha := range has {
+			policy.hashAlgs[i] = HashAlgorithm(ha)
+		}
+	}
 
 	whitelist := make([]base.Address, len(bws))
 	for i, bw := range bws {
@@ -29,5 +68,52 @@ func (policy *CollectionPolicy) unpack(
 	}
 	policy.whitelist = whitelist
 
+	pauser, err := base.DecodeAddress(ps, enc)
+	if err != nil {
+		return err
+	}
+	policy.pauser = pauser
+
+	updater, err := base.DecodeAddress(up, enc)
+	if err != nil {
+		return err
+	}
+	policy.updater = updater
+
+	var oracles []base.Address
+	for _, o := range ors {
+		oracle, err := base.DecodeAddress(o, enc)
+		if err != nil {
+			return err
+		}
+		oracles = append(oracles, oracle)
+	}
+	policy.oracle = NewOracleRule(oracles, interval)
+
+	amount := common.ZeroBig
+	if mam != "" {
+		a, err := common.NewBigFromString(mam)
+		if err != nil {
+			return err
+		}
+		amount = a
+	}
+
+	treasury, err := base.DecodeAddress(treas, enc)
+	if err != nil {
+		return err
+	}
+	policy.member = NewMembershipRule(ctypes.CurrencyID(mcid), amount, period, treasury)
+
+	var admins []base.Address
+	for _, ad := range ads {
+		admin, err := base.DecodeAddress(ad, enc)
+		if err != nil {
+			return err
+		}
+		admins = append(admins, admin)
+	}
+	policy.admin = NewAdminRule(admins, threshold)
+
 	return nil
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	ctypes "github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
 	"github.com/imfact-labs/mitum2/util/encoder"
@@ -9,28 +10,105 @@ import (
 
 type CollectionPolicyJSONMarshaler struct {
 	hint.BaseHinter
-	Name      CollectionName   `json:"name"`
-	Royalty   PaymentParameter `json:"royalty"`
-	URI       URI              `json:"uri"`
-	Whitelist []base.Address   `json:"minter_whitelist"`
+	Name             CollectionName        `json:"name"`
+	Royalty          PaymentParameter      `json:"royalty"`
+	URI              URI                   `json:"uri"`
+	Whitelist        []base.Address        `json:"minter_whitelist"`
+	Pauser           base.Address          `json:"pauser,omitempty"`
+	Clawback         bool                  `json:"clawback_enabled"`
+	MintMode         MintMode              `json:"mint_mode,omitempty"`
+	MaxSupply        uint64                `json:"max_supply,omitempty"`
+	BaseURI          URI                   `json:"base_uri,omitempty"`
+	URISuffix        URI                   `json:"uri_suffix,omitempty"`
+	Symbol           CollectionSymbol      `json:"symbol,omitempty"`
+	Description      CollectionDescription `json:"description,omitempty"`
+	ExternalURL      URI                   `json:"external_url,omitempty"`
+	ContractURI      URI                   `json:"contract_uri,omitempty"`
+	HashAlgorithms   []HashAlgorithm       `json:"hash_algorithms,omitempty"`
+	URISchemes       []string              `json:"uri_schemes,omitempty"`
+	URIHosts         []string              `json:"uri_hosts,omitempty"`
+	AttributeUpdater base.Address          `json:"attribute_updater,omitempty"`
+	Oracles          []base.Address        `json:"oracles,omitempty"`
+	DynamicInterval  uint64                `json:"dynamic_update_interval,omitempty"`
+	MemberCurrency   ctypes.CurrencyID     `json:"membership_currency,omitempty"`
+	MemberAmount     string                `json:"membership_amount,omitempty"`
+	MemberPeriod     uint64                `json:"membership_period,omitempty"`
+	Treasury         base.Address          `json:"treasury,omitempty"`
+	MaxRoyalty       PaymentParameter      `json:"max_royalty,omitempty"`
+	Admins           []base.Address        `json:"admins,omitempty"`
+	AdminThreshold   uint                  `json:"admin_threshold,omitempty"`
+	MinUpdateDelay   uint64                `json:"min_update_delay,omitempty"`
 }
 
 func (policy CollectionPolicy) MarshalJSON() ([]byte, error) {
+	var amount string
+	if policy.member.IsMembership() {
+		amount = policy.member.Amount().String()
+	}
+
 	return util.MarshalJSON(CollectionPolicyJSONMarshaler{
-		BaseHinter: policy.BaseHinter,
-		Name:       policy.name,
-		Royalty:    policy.royalty,
-		URI:        policy.uri,
-		Whitelist:  policy.whitelist,
+		BaseHinter:       policy.BaseHinter,
+		Name:             policy.name,
+		Royalty:          policy.royalty,
+		URI:              policy.uri,
+		Whitelist:        policy.whitelist,
+		Pauser:           policy.pauser,
+		Clawback:         policy.clawback,
+		MintMode:         policy.mintMode,
+		MaxSupply:        policy.maxSupply,
+		BaseURI:          policy.baseURI,
+		URISuffix:        policy.uriSuffix,
+		Symbol:           policy.symbol,
+		Description:      policy.desc,
+		ExternalURL:      policy.external,
+		ContractURI:      policy.contract,
+		HashAlgorithms:   policy.hashAlgs,
+		URISchemes:       policy.uriRule.Schemes(),
+		URIHosts:         policy.uriRule.Hosts(),
+		AttributeUpdater: policy.updater,
+		Oracles:          policy.oracle.Oracles(),
+		DynamicInterval:  policy.oracle.Interval(),
+		MemberCurrency:   policy.member.Currency(),
+		MemberAmount:     amount,
+		MemberPeriod:     policy.member.Period(),
+		Treasury:         policy.member.Treasury(),
+		MaxRoyalty:       policy.maxRoyal,
+		Admins:           policy.admin.Admins(),
+		AdminThreshold:   policy.admin.Threshold(),
+		MinUpdateDelay:   policy.minDelay,
 	})
 }
 
 type CollectionPolicyJSONUnmarshaler struct {
-	Hint      hint.Hint `json:"_hint"`
-	Name      string    `json:"name"`
-	Royalty   uint      `json:"royalty"`
-	URI       string    `json:"uri"`
-	Whitelist []string  `json:"minter_whitelist"`
+	Hint             hint.Hint `json:"_hint"`
+	Name             string    `json:"name"`
+	Royalty          uint      `json:"royalty"`
+	URI              string    `json:"uri"`
+	Whitelist        []string  `json:"minter_whitelist"`
+	Pauser           string    `json:"pauser"`
+	Clawback         bool      `json:"clawback_enabled"`
+	MintMode         string    `json:"mint_mode"`
+	MaxSupply        uint64    `json:"max_supply"`
+	BaseURI          string    `json:"base_uri"`
+	URISuffix        string    `json:"uri_suffix"`
+	Symbol           string    `json:"symbol"`
+	Description      string    `json:"description"`
+	ExternalURL      string    `json:"external_url"`
+	ContractURI      string    `json:"contract_uri"`
+	HashAlgorithms   []string  `json:"hash_algorithms"`
+	URISchemes       []string  `json:"uri_schemes"`
+	URIHosts         []string  `json:"uri_hosts"`
+	AttributeUpdater string    `json:"attribute_updater"`
+	Oracles          []string  `json:"oracles"`
+	DynamicInterval  uint64    `json:"dynamic_update_interval"`
+	MemberCurrency   string    `json:"membership_currency"`
+	MemberAmount     string    `json:"membership_amount"`
+	MemberPeriod     uint64    `json:"membership_period"`
+	Treasury         string    `json:"treasury"`
+	MaxRoyalty       uint      `json:"max_royalty"`
+	Admins           []string  `json:"admins"`
+	AdminThreshold   uint      `json:"admin_threshold"`
+	MinUpdateDelay   uint64    `json:"min_update_delay"`
 }
 
 func (policy *CollectionPolicy) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -41,5 +119,9 @@ func (policy *CollectionPolicy) DecodeJSON(b []byte, enc encoder.Encoder) error
 		return e.Wrap(err)
 	}
 
-	return policy.unpack(enc, u.Hint, u.Name, u.Royalty, u.URI, u.Whitelist)
+	return policy.unpack(enc, u.Hint, u.Name, u.Royalty, u.URI, u.Whitelist, u.Pauser, u.Clawback, u.MintMode, u.MaxSupply, u.BaseURI, u.URISuffix,
+		u.Symbol, u.Description, u.ExternalURL, u.ContractURI, u.HashAlgorithms,
+		u.URISchemes, u.URIHosts, u.AttributeUpdater,
+		u.Oracles, u.DynamicInterval, u.MemberCurrency, u.MemberAmount, u.MemberPeriod, u.Treasury, u.MaxRoyalty,
+		u.Admins, u.AdminThreshold, u.MinUpdateDelay)
 }
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+)
+
+func newTestCollectionPolicy(t *testing.T) CollectionPolicy {
+	t.Helper()
+
+	return NewCollectionPolicy(
+		CollectionName("collection"), PaymentParameter(10), URI("https://example.com"),
+		[]base.Address{newTestAddress(t)},
+	)
+}
+
+func TestCollectionPolicyBytesWithoutOptionalFields(t *testing.T) {
+	policy := newTestCollectionPolicy(t)
+
+	legacy := util.ConcatBytesSlice(
+		policy.name.Bytes(),
+		policy.royalty.Bytes(),
+		policy.uri.Bytes(),
+		policy.whitelist[0].Bytes(),
+	)
+
+	if !bytes.Equal(policy.Bytes(), legacy) {
+		t.Fatal("bytes of collection policy without optional fields changed")
+	}
+}
+
+func TestCollectionPolicyBytesOptionalFields(t *testing.T) {
+	policy := newTestCollectionPolicy(t)
+
+	policies := map[string]CollectionPolicy{
+		"max supply":  policy.WithMintMode(MintModeSequential, 5),
+		"max royalty": policy.WithMaxRoyalty(5),
+		"delay":       policy.WithMinUpdateDelay(5),
+	}
+
+	founds := map[string]string{}
+	for name, p := range policies {
+		k := string(p.Bytes())
+		if found, ok := founds[k]; ok {
+			t.Fatalf("same bytes of collection policy with %s and %s", found, name)
+		}
+
+		founds[k] = name
+	}
+}
@@ -0,0 +1,209 @@
+package types
+
+import (
+	"strconv"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var RevealHint = hint.MustNewHint("mitum-nft-reveal-v0.0.1")
+
+// MinRevealSaltLength is the minimum length of the salt of a reveal
+// commitment, so the final uris can not be found from the commitment.
+var MinRevealSaltLength = 16
+
+// Reveal keeps the commit-reveal metadata of a collection. Until it is
+// revealed, tokens below supply resolve to the pre-reveal uri; after that
+// they resolve to the uri derived from the revealed base uri and seed. The
+// uri root and salt opening the commitment are kept after reveal; the uri
+// root must be the root of the uris of the revealed base uri and seed.
+type Reveal struct {
+	hint.BaseHinter
+	preRevealURI URI
+	commitment   util.Hash
+	supply       uint64
+	baseURI      URI
+	seed         uint64
+	revealed     bool
+	uriRoot      util.Hash
+	salt         []byte
+}
+
+func NewReveal(
+	preRevealURI URI, commitment util.Hash, supply uint64, baseURI URI, seed uint64, revealed bool,
+) Reveal {
+	return Reveal{
+		BaseHinter:   hint.NewBaseHinter(RevealHint),
+		preRevealURI: preRevealURI,
+		commitment:   commitment,
+		supply:       supply,
+		baseURI:      baseURI,
+		seed:         seed,
+		revealed:     revealed,
+	}
+}
+
+// WithOpening returns the reveal with the uri root and salt of the
+// commitment.
+func (r Reveal) WithOpening(uriRoot util.Hash, salt []byte) Reveal {
+	r.uriRoot = uriRoot
+	r.salt = salt
+
+	return r
+}
+
+func (r Reveal) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		r.BaseHinter,
+		r.preRevealURI,
+		r.commitment,
+		r.baseURI,
+	); err != nil {
+		return err
+	}
+
+	if r.preRevealURI == "" {
+		return util.ErrInvalid.Errorf("empty pre-reveal uri")
+	}
+
+	if r.supply < 1 || r.supply > MaxCount {
+		return common.ErrValOOR.Wrap(errors.Errorf("reveal supply out of range, %d, 1 <= supply <= %d", r.supply, MaxCount))
+	}
+
+	if r.revealed && r.baseURI == "" {
+		return util.ErrInvalid.Errorf("empty revealed base uri")
+	}
+
+	if r.uriRoot != nil {
+		if err := r.uriRoot.IsValid(nil); err != nil {
+			return err
+		}
+
+		if r.revealed && !RevealURIRoot(r.baseURI, r.seed, r.supply).Equal(r.uriRoot) {
+			return util.ErrInvalid.Errorf("uri root does not match revealed base uri and seed")
+		}
+
+		if !RevealCommitment(r.uriRoot, r.salt).Equal(r.commitment) {
+			return util.ErrInvalid.Errorf("uri root and salt do not match commitment")
+		}
+	}
+
+	return nil
+}
+
+func (r Reveal) Bytes() []byte {
+	rb := make([]byte, 1)
+	if r.revealed {
+		rb[0] = 1
+	}
+
+	var root []byte
+	if r.uriRoot != nil {
+		root = r.uriRoot.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		r.preRevealURI.Bytes(),
+		r.commitment.Bytes(),
+		util.Uint64ToBytes(r.supply),
+		r.baseURI.Bytes(),
+		util.Uint64ToBytes(r.seed),
+		rb,
+		OptionalBytes(root, r.salt),
+	)
+}
+
+func (r Reveal) PreRevealURI() URI {
+	return r.preRevealURI
+}
+
+func (r Reveal) Commitment() util.Hash {
+	return r.commitment
+}
+
+func (r Reveal) Supply() uint64 {
+	return r.supply
+}
+
+func (r Reveal) BaseURI() URI {
+	return r.baseURI
+}
+
+func (r Reveal) Seed() uint64 {
+	return r.seed
+}
+
+func (r Reveal) Revealed() bool {
+	return r.revealed
+}
+
+func (r Reveal) URIRoot() util.Hash {
+	return r.uriRoot
+}
+
+func (r Reveal) Salt() []byte {
+	return r.salt
+}
+
+// TokenURI returns the uri of the token id under the commit-reveal scheme.
+// It returns false for ids outside of the committed supply.
+func (r Reveal) TokenURI(id uint64) (URI, bool) {
+	if id >= r.supply {
+		return "", false
+	}
+
+	if !r.revealed {
+		return r.preRevealURI, true
+	}
+
+	return RevealedURI(r.baseURI, r.seed, r.supply, id), true
+}
+
+// RevealedURI derives the final uri of token id; the seed rotates the
+// ordered list of uris so the id to uri mapping is unknown until reveal.
+func RevealedURI(baseURI URI, seed, supply, id uint64) URI {
+	return URI(baseURI.String() + strconv.FormatUint((id+seed%supply)%supply, 10))
+}
+
+// RevealURIRoot is the merkle root of the ordered final uris of ids 0 to
+// supply-1. A leaf is the sha256 hash of 0x00 and the uri, a node is the
+// sha256 hash of 0x01 and its children, and a node without a sibling is
+// carried to the upper level.
+func RevealURIRoot(baseURI URI, seed, supply uint64) util.Hash {
+	if supply < 1 {
+		return valuehash.NewSHA256([]byte{0})
+	}
+
+	level := make([][]byte, supply)
+	for id := range level {
+		level[id] = valuehash.NewSHA256(
+			append([]byte{0}, RevealedURI(baseURI, seed, supply, uint64(id)).Bytes()...)).Bytes()
+	}
+
+	for len(level) > 1 {
+		next := level[:0]
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+
+				continue
+			}
+
+			next = append(next, valuehash.NewSHA256(util.ConcatBytesSlice([]byte{1}, level[i], level[i+1])).Bytes())
+		}
+
+		level = next
+	}
+
+	return valuehash.NewBytes(level[0])
+}
+
+// RevealCommitment is the sha256 hash of the uri root of the final uris
+// followed by the salt.
+func RevealCommitment(uriRoot util.Hash, salt []byte) util.Hash {
+	return valuehash.NewSHA256(util.ConcatBytesSlice(uriRoot.Bytes(), salt))
+}
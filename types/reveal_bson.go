@@ -0,0 +1,57 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func (r Reveal) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"_hint":          r.Hint().String(),
+		"pre_reveal_uri": r.preRevealURI,
+		"commitment":     r.commitment.String(),
+		"supply":         r.supply,
+		"base_uri":       r.baseURI,
+		"seed":           r.seed,
+		"revealed":       r.revealed,
+	}
+
+	if r.uriRoot != nil {
+		m["uri_root"] = r.uriRoot.String()
+		m["salt"] = r.salt
+	}
+
+	return bsonenc.Marshal(m)
+}
+
+type RevealBSONUnmarshaler struct {
+	Hint         string `bson:"_hint"`
+	PreRevealURI string `bson:"pre_reveal_uri"`
+	Commitment   string `bson:"commitment"`
+	Supply       uint64 `bson:"supply"`
+	BaseURI      string `bson:"base_uri"`
+	Seed         uint64 `bson:"seed"`
+	Revealed     bool   `bson:"revealed"`
+	URIRoot      string `bson:"uri_root,omitempty"`
+	Salt         []byte `bson:"salt,omitempty"`
+}
+
+func (r *Reveal) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of Reveal")
+
+	var u RevealBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	r.unpack(ht, u.PreRevealURI, u.Commitment, u.Supply, u.BaseURI, u.Seed, u.Revealed, u.URIRoot, u.Salt)
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+)
+
+func (r *Reveal) unpack(
+	ht hint.Hint,
+	pru, cm string,
+	supply uint64,
+	bu string,
+	seed uint64,
+	revealed bool,
+	root string,
+	salt []byte,
+) {
+	r.BaseHinter = hint.NewBaseHinter(ht)
+	r.preRevealURI = URI(pru)
+	r.commitment = valuehash.NewBytesFromString(cm)
+	r.supply = supply
+	r.baseURI = URI(bu)
+	r.seed = seed
+	r.revealed = revealed
+
+	if len(root) > 0 {
+		r.uriRoot = valuehash.NewBytesFromString(root)
+		r.salt = salt
+	}
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+type RevealJSONMarshaler struct {
+	hint.BaseHinter
+	PreRevealURI URI       `json:"pre_reveal_uri"`
+	Commitment   util.Hash `json:"commitment"`
+	Supply       uint64    `json:"supply"`
+	BaseURI      URI       `json:"base_uri"`
+	Seed         uint64    `json:"seed"`
+	Revealed     bool      `json:"revealed"`
+	URIRoot      util.Hash `json:"uri_root,omitempty"`
+	Salt         []byte    `json:"salt,omitempty"`
+}
+
+func (r Reveal) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevealJSONMarshaler{
+		BaseHinter:   r.BaseHinter,
+		PreRevealURI: r.preRevealURI,
+		Commitment:   r.commitment,
+		Supply:       r.supply,
+		BaseURI:      r.baseURI,
+		Seed:         r.seed,
+		Revealed:     r.revealed,
+		URIRoot:      r.uriRoot,
+		Salt:         r.salt,
+	})
+}
+
+type RevealJSONUnmarshaler struct {
+	Hint         hint.Hint `json:"_hint"`
+	PreRevealURI string    `json:"pre_reveal_uri"`
+	Commitment   string    `json:"commitment"`
+	Supply       uint64    `json:"supply"`
+	BaseURI      string    `json:"base_uri"`
+	Seed         uint64    `json:"seed"`
+	Revealed     bool      `json:"revealed"`
+	URIRoot      string    `json:"uri_root"`
+	Salt         []byte    `json:"salt"`
+}
+
+func (r *Reveal) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of Reveal")
+
+	var u RevealJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	r.unpack(u.Hint, u.PreRevealURI, u.Commitment, u.Supply, u.BaseURI, u.Seed, u.Revealed, u.URIRoot, u.Salt)
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+)
+
+var testRevealSalt = bytes.Repeat([]byte{0x5a}, MinRevealSaltLength)
+
+func TestRevealURIRoot(t *testing.T) {
+	root := RevealURIRoot("https://example.com/", 3, 5)
+
+	if !root.Equal(RevealURIRoot("https://example.com/", 3, 5)) {
+		t.Fatal("different uri roots of same uris")
+	}
+
+	for _, c := range []struct {
+		name    string
+		baseURI URI
+		seed    uint64
+		supply  uint64
+	}{
+		{"base uri", "https://example.org/", 3, 5},
+		{"seed", "https://example.com/", 4, 5},
+		{"supply", "https://example.com/", 3, 6},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			if root.Equal(RevealURIRoot(c.baseURI, c.seed, c.supply)) {
+				t.Fatal("same uri root of different uris")
+			}
+		})
+	}
+}
+
+func TestRevealCommitment(t *testing.T) {
+	root := RevealURIRoot("https://example.com/", 3, 5)
+	commitment := RevealCommitment(root, testRevealSalt)
+
+	if commitment.Equal(RevealCommitment(root, bytes.Repeat([]byte{0x5b}, MinRevealSaltLength))) {
+		t.Fatal("same commitment with different salts")
+	}
+
+	r := NewReveal("https://example.com/hidden", commitment, 5, "https://example.com/", 3, true)
+	if err := r.WithOpening(root, testRevealSalt).IsValid(nil); err != nil {
+		t.Fatalf("reveal with opening: %v", err)
+	}
+
+	if err := r.WithOpening(root, testRevealSalt[1:]).IsValid(nil); err == nil {
+		t.Fatal("reveal with wrong salt")
+	}
+
+	wrong := NewReveal("https://example.com/hidden", commitment, 5, "https://example.org/", 3, true)
+	if err := wrong.WithOpening(root, testRevealSalt).IsValid(nil); err == nil {
+		t.Fatal("reveal with base uri of other uri root")
+	}
+
+	if !bytes.Equal(r.Bytes(), r.WithOpening(nil, nil).Bytes()) {
+		t.Fatal("bytes of reveal without opening changed")
+	}
+
+	if bytes.Equal(r.Bytes(), r.WithOpening(root, testRevealSalt).Bytes()) {
+		t.Fatal("same bytes with and without opening")
+	}
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+// RoyaltyShare is the amount of a sale price paid to a royalty receiver.
+type RoyaltyShare struct {
+	Receiver base.Address
+	Amount   common.Big
+}
+
+// TokenRoyalty resolves the royalty of the nft. The royalty of the nft
+// overrides the given royalty of its series or collection, bounded by the
+// current max royalty of the collection.
+func (policy CollectionPolicy) TokenRoyalty(n NFT, royalty PaymentParameter) PaymentParameter {
+	switch {
+	case n.royalty < 1 || policy.maxRoyal < 1:
+		return royalty
+	case n.royalty > policy.maxRoyal:
+		return policy.maxRoyal
+	default:
+		return n.royalty
+	}
+}
+
+// RoyaltyShares splits the royalty of price among the receivers of the nft.
+// The royalty receiver of the nft takes all of it; otherwise the creators take
+// it in proportion to their shares, and the remainder of the division goes to
+// the first of them. Without a receiver or creators with shares, fallback
+// takes all of it.
+func RoyaltyShares(n NFT, royalty PaymentParameter, price common.Big, fallback base.Address) []RoyaltyShare {
+	amount := price.MulInt64(int64(royalty)).Div(common.NewBig(100))
+
+	if n.receiver != nil {
+		return []RoyaltyShare{{Receiver: n.receiver, Amount: amount}}
+	}
+
+	var total uint
+	var signers []Signer
+	for _, signer := range n.creators.Signers() {
+		if signer.Share() < 1 {
+			continue
+		}
+
+		total += signer.Share()
+		signers = append(signers, signer)
+	}
+
+	if total < 1 {
+		return []RoyaltyShare{{Receiver: fallback, Amount: amount}}
+	}
+
+	shares := make([]RoyaltyShare, len(signers))
+	paid := common.ZeroBig
+	for i, signer := range signers {
+		a := amount.MulInt64(int64(signer.Share())).Div(common.NewBig(int64(total)))
+		shares[i] = RoyaltyShare{Receiver: signer.Address(), Amount: a}
+		paid = paid.Add(a)
+	}
+	shares[0].Amount = shares[0].Amount.Add(amount.Sub(paid))
+
+	return shares
+}
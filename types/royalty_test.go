@@ -0,0 +1,69 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+)
+
+func TestTokenRoyalty(t *testing.T) {
+	n := newTestNFT(t)
+	policy := NewCollectionPolicy("collection", 5, "", nil).WithMaxRoyalty(10)
+
+	cases := []struct {
+		name     string
+		policy   CollectionPolicy
+		royalty  PaymentParameter
+		expected PaymentParameter
+	}{
+		{"no override", policy, 0, 5},
+		{"override", policy, 7, 7},
+		{"over max royalty", policy, 20, 10},
+		{"no max royalty", NewCollectionPolicy("collection", 5, "", nil), 7, 5},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if r := c.policy.TokenRoyalty(n.WithRoyalty(c.royalty, nil), 5); r != c.expected {
+				t.Fatalf("expected %v, not %v", c.expected, r)
+			}
+		})
+	}
+}
+
+func TestRoyaltyShares(t *testing.T) {
+	as := newTestAddresses(t, 4)
+	price := common.NewBig(1001)
+
+	check := func(t *testing.T, shares []RoyaltyShare, expected map[string]int64) {
+		t.Helper()
+
+		if len(shares) != len(expected) {
+			t.Fatalf("expected %v, not %v", expected, shares)
+		}
+
+		for _, s := range shares {
+			if a, found := expected[s.Receiver.String()]; !found || !s.Amount.Equal(common.NewBig(a)) {
+				t.Fatalf("expected %v, not %v of %v", expected, s.Amount, s.Receiver)
+			}
+		}
+	}
+
+	creators := NewSigners([]Signer{NewSigner(as[0], 1, false), NewSigner(as[1], 2, false)})
+	n := NewNFT(1, true, as[3], "hash", "", as[3], creators)
+
+	t.Run("receiver", func(t *testing.T) {
+		check(t, RoyaltyShares(n.WithRoyalty(10, as[2]), 10, price, as[3]),
+			map[string]int64{as[2].String(): 100})
+	})
+
+	t.Run("creators", func(t *testing.T) {
+		check(t, RoyaltyShares(n, 10, price, as[3]),
+			map[string]int64{as[0].String(): 34, as[1].String(): 66})
+	})
+
+	t.Run("fallback", func(t *testing.T) {
+		check(t, RoyaltyShares(NewNFT(1, true, as[3], "hash", "", as[3], NewSigners(nil)), 10, price, as[3]),
+			map[string]int64{as[3].String(): 100})
+	})
+}
@@ -0,0 +1,157 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var SeriesHint = hint.MustNewHint("mitum-nft-series-v0.0.1")
+
+// Series is a sub-collection of a collection. The nfts of a series share its
+// name and uri and are limited by its max supply; zero max supply means no
+// limit other than the collection. A non-zero royalty overrides the royalty
+// of the collection. The minters can mint into the series in addition to the
+// collection minters. Count is the number of nfts minted into the series.
+type Series struct {
+	hint.BaseHinter
+	id        uint64
+	name      CollectionName
+	uri       URI
+	maxSupply uint64
+	royalty   PaymentParameter
+	minters   []base.Address
+	count     uint64
+}
+
+func NewSeries(
+	id uint64,
+	name CollectionName,
+	uri URI,
+	maxSupply uint64,
+	royalty PaymentParameter,
+	minters []base.Address,
+	count uint64,
+) Series {
+	return Series{
+		BaseHinter: hint.NewBaseHinter(SeriesHint),
+		id:         id,
+		name:       name,
+		uri:        uri,
+		maxSupply:  maxSupply,
+		royalty:    royalty,
+		minters:    minters,
+		count:      count,
+	}
+}
+
+func (s Series) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		s.BaseHinter,
+		s.name,
+		s.uri,
+		s.royalty,
+	); err != nil {
+		return err
+	}
+
+	if s.id < 1 {
+		return common.ErrValOOR.Wrap(errors.Errorf("series id under one, %d", s.id))
+	}
+
+	if s.maxSupply > MaxCount {
+		return common.ErrValOOR.Wrap(errors.Errorf("series max supply over max count, %d > %d", s.maxSupply, MaxCount))
+	}
+
+	if s.maxSupply > 0 && s.count > s.maxSupply {
+		return common.ErrValOOR.Wrap(errors.Errorf("series count over max supply, %d > %d", s.count, s.maxSupply))
+	}
+
+	founds := map[string]struct{}{}
+	for _, minter := range s.minters {
+		if err := minter.IsValid(nil); err != nil {
+			return err
+		}
+		if _, found := founds[minter.String()]; found {
+			return common.ErrDupVal.Wrap(errors.Errorf("duplicate series minter found, %v", minter))
+		}
+		founds[minter.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (s Series) Bytes() []byte {
+	ms := make([][]byte, len(s.minters))
+	for i, minter := range s.minters {
+		ms[i] = minter.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		util.Uint64ToBytes(s.id),
+		s.name.Bytes(),
+		s.uri.Bytes(),
+		util.Uint64ToBytes(s.maxSupply),
+		s.royalty.Bytes(),
+		util.ConcatBytesSlice(ms...),
+		util.Uint64ToBytes(s.count),
+	)
+}
+
+func (s Series) ID() uint64 {
+	return s.id
+}
+
+func (s Series) Name() CollectionName {
+	return s.name
+}
+
+func (s Series) URI() URI {
+	return s.uri
+}
+
+func (s Series) MaxSupply() uint64 {
+	return s.maxSupply
+}
+
+func (s Series) Royalty() PaymentParameter {
+	return s.royalty
+}
+
+func (s Series) Minters() []base.Address {
+	return s.minters
+}
+
+func (s Series) Count() uint64 {
+	return s.count
+}
+
+// IsMinter reports whether the account is one of the series minters.
+func (s Series) IsMinter(a base.Address) bool {
+	for _, minter := range s.minters {
+		if minter.Equal(a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// EffectiveRoyalty returns the series royalty, or the collection royalty
+// when the series does not override it.
+func (s Series) EffectiveRoyalty(collection PaymentParameter) PaymentParameter {
+	if s.royalty > 0 {
+		return s.royalty
+	}
+
+	return collection
+}
+
+// WithCount returns the series with count nfts minted.
+func (s Series) WithCount(count uint64) Series {
+	s.count = count
+
+	return s
+}
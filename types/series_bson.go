@@ -0,0 +1,52 @@
+package types
+
+import (
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func (s Series) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"_hint":      s.Hint().String(),
+		"series_id":  s.id,
+		"name":       s.name,
+		"uri":        s.uri,
+		"max_supply": s.maxSupply,
+		"royalty":    s.royalty,
+		"minters":    s.minters,
+		"count":      s.count,
+	})
+}
+
+type SeriesBSONUnmarshaler struct {
+	Hint      string   `bson:"_hint"`
+	ID        uint64   `bson:"series_id"`
+	Name      string   `bson:"name"`
+	URI       string   `bson:"uri"`
+	MaxSupply uint64   `bson:"max_supply"`
+	Royalty   uint     `bson:"royalty"`
+	Minters   []string `bson:"minters"`
+	Count     uint64   `bson:"count"`
+}
+
+func (s *Series) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of Series")
+
+	var u SeriesBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := s.unpack(enc, ht, u.ID, u.Name, u.URI, u.MaxSupply, u.Royalty, u.Minters, u.Count); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
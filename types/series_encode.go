@@ -0,0 +1,38 @@
+package types
+
+import (
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+func (s *Series) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	id uint64,
+	nm, uri string,
+	supply uint64,
+	ry uint,
+	bms []string,
+	count uint64,
+) error {
+	s.BaseHinter = hint.NewBaseHinter(ht)
+	s.id = id
+	s.name = CollectionName(nm)
+	s.uri = URI(uri)
+	s.maxSupply = supply
+	s.royalty = PaymentParameter(ry)
+	s.count = count
+
+	minters := make([]base.Address, len(bms))
+	for i, bm := range bms {
+		minter, err := base.DecodeAddress(bm, enc)
+		if err != nil {
+			return err
+		}
+		minters[i] = minter
+	}
+	s.minters = minters
+
+	return nil
+}
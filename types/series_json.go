@@ -0,0 +1,58 @@
+package types
+
+import (
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+type SeriesJSONMarshaler struct {
+	hint.BaseHinter
+	ID        uint64           `json:"series_id"`
+	Name      CollectionName   `json:"name"`
+	URI       URI              `json:"uri"`
+	MaxSupply uint64           `json:"max_supply"`
+	Royalty   PaymentParameter `json:"royalty"`
+	Minters   []base.Address   `json:"minters"`
+	Count     uint64           `json:"count"`
+}
+
+func (s Series) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SeriesJSONMarshaler{
+		BaseHinter: s.BaseHinter,
+		ID:         s.id,
+		Name:       s.name,
+		URI:        s.uri,
+		MaxSupply:  s.maxSupply,
+		Royalty:    s.royalty,
+		Minters:    s.minters,
+		Count:      s.count,
+	})
+}
+
+type SeriesJSONUnmarshaler struct {
+	Hint      hint.Hint `json:"_hint"`
+	ID        uint64    `json:"series_id"`
+	Name      string    `json:"name"`
+	URI       string    `json:"uri"`
+	MaxSupply uint64    `json:"max_supply"`
+	Royalty   uint      `json:"royalty"`
+	Minters   []string  `json:"minters"`
+	Count     uint64    `json:"count"`
+}
+
+func (s *Series) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of Series")
+
+	var u SeriesJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := s.unpack(enc, u.Hint, u.ID, u.Name, u.URI, u.MaxSupply, u.Royalty, u.Minters, u.Count); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
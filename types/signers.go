@@ -11,10 +11,7 @@ import (
 	"github.com/pkg/errors"
 )
 
-var (
-	MaxTotalShare uint = 100
-	MaxSigners         = 10
-)
+var MaxTotalShare uint = 100
 
 var SignersHint = hint.MustNewHint("mitum-nft-signers-v0.0.1")
 
@@ -35,10 +32,6 @@ func (sgns Signers) IsValid([]byte) error {
 		return err
 	}
 
-	if l := len(sgns.signers); l > MaxSigners {
-		return common.ErrValOOR.Wrap(errors.Errorf("signers over allowed, %d > %d", l, MaxSigners))
-	}
-
 	var total uint = 0
 	founds := map[string]struct{}{}
 	for _, signer := range sgns.signers {
@@ -0,0 +1,164 @@
+package types
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/imfact-labs/mitum2/util"
+)
+
+var (
+	MaxURISchemes    = 10
+	MaxURIHosts      = 20
+	ReValidURIScheme = regexp.MustCompile(`^[a-z][a-z0-9+.\-]*$`)
+)
+
+// URIRule restricts the uris of a collection. Schemes allows only the listed
+// uri schemes and hosts allows only the listed hosts for http and https
+// uris. An empty list allows any.
+type URIRule struct {
+	schemes []string
+	hosts   []string
+}
+
+func NewURIRule(schemes, hosts []string) URIRule {
+	return URIRule{schemes: schemes, hosts: hosts}
+}
+
+func (r URIRule) IsValid([]byte) error {
+	if l := len(r.schemes); l > MaxURISchemes {
+		return util.ErrInvalid.Errorf("uri schemes over max, %d > %d", l, MaxURISchemes)
+	}
+
+	if l := len(r.hosts); l > MaxURIHosts {
+		return util.ErrInvalid.Errorf("uri hosts over max, %d > %d", l, MaxURIHosts)
+	}
+
+	founds := map[string]struct{}{}
+	for _, scheme := range r.schemes {
+		if !ReValidURIScheme.MatchString(scheme) {
+			return util.ErrInvalid.Errorf("wrong uri scheme, %q", scheme)
+		}
+
+		if _, found := founds[scheme]; found {
+			return util.ErrInvalid.Errorf("duplicate uri scheme, %v", scheme)
+		}
+		founds[scheme] = struct{}{}
+	}
+
+	founds = map[string]struct{}{}
+	for _, host := range r.hosts {
+		if host == "" || host != strings.ToLower(strings.TrimSpace(host)) || strings.ContainsAny(host, "/?#@") {
+			return util.ErrInvalid.Errorf("wrong uri host, %q", host)
+		}
+
+		if _, found := founds[host]; found {
+			return util.ErrInvalid.Errorf("duplicate uri host, %v", host)
+		}
+		founds[host] = struct{}{}
+	}
+
+	return nil
+}
+
+// Bytes is empty for the empty rule. Otherwise the schemes and hosts are
+// each counted and length-prefixed, so different rules never give the same
+// bytes.
+func (r URIRule) Bytes() []byte {
+	if r.IsEmpty() {
+		return nil
+	}
+
+	bs := make([][]byte, 0, 2+(len(r.schemes)+len(r.hosts))*2)
+
+	bs = append(bs, util.Uint64ToBytes(uint64(len(r.schemes))))
+	for _, scheme := range r.schemes {
+		bs = append(bs, util.Uint64ToBytes(uint64(len(scheme))), []byte(scheme))
+	}
+
+	bs = append(bs, util.Uint64ToBytes(uint64(len(r.hosts))))
+	for _, host := range r.hosts {
+		bs = append(bs, util.Uint64ToBytes(uint64(len(host))), []byte(host))
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (r URIRule) Schemes() []string {
+	return r.schemes
+}
+
+func (r URIRule) Hosts() []string {
+	return r.hosts
+}
+
+func (r URIRule) IsEmpty() bool {
+	return len(r.schemes) < 1 && len(r.hosts) < 1
+}
+
+func (r URIRule) Equal(b URIRule) bool {
+	if len(r.schemes) != len(b.schemes) || len(r.hosts) != len(b.hosts) {
+		return false
+	}
+
+	for i := range r.schemes {
+		if r.schemes[i] != b.schemes[i] {
+			return false
+		}
+	}
+
+	for i := range r.hosts {
+		if r.hosts[i] != b.hosts[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Check checks the uri against the rule. The empty uri is allowed.
+func (r URIRule) Check(uri URI) error {
+	if uri == "" || r.IsEmpty() {
+		return nil
+	}
+
+	u, err := url.Parse(uri.String())
+	if err != nil {
+		return util.ErrInvalid.Errorf("wrong uri, %v: %v", uri, err)
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+
+	if len(r.schemes) > 0 && !containsString(r.schemes, scheme) {
+		return util.ErrInvalid.Errorf("uri scheme %q not allowed, %v", scheme, uri)
+	}
+
+	if len(r.hosts) > 0 && (scheme == "http" || scheme == "https") &&
+		!containsString(r.hosts, strings.ToLower(u.Hostname())) {
+		return util.ErrInvalid.Errorf("uri host %q not allowed, %v", u.Hostname(), uri)
+	}
+
+	return nil
+}
+
+// CheckURIs checks the uris against the rule.
+func (r URIRule) CheckURIs(uris ...URI) error {
+	for _, uri := range uris {
+		if err := r.Check(uri); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func containsString(l []string, s string) bool {
+	for _, i := range l {
+		if i == s {
+			return true
+		}
+	}
+
+	return false
+}
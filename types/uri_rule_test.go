@@ -0,0 +1,55 @@
+package types
+
+import "testing"
+
+func TestURIRuleCheck(t *testing.T) {
+	rule := NewURIRule([]string{"ipfs", "https"}, []string{"example.com"})
+
+	cases := []struct {
+		uri   URI
+		valid bool
+	}{
+		{"", true},
+		{"ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi", true},
+		{"https://example.com/1", true},
+		{"HTTPS://EXAMPLE.COM/1", true},
+		{"https://example.org/1", false},
+		{"http://example.com/1", false},
+		{"ar://tx", false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.uri.String(), func(t *testing.T) {
+			if err := rule.Check(c.uri); (err == nil) != c.valid {
+				t.Fatalf("expected valid %v, not %v", c.valid, err)
+			}
+		})
+	}
+
+	if err := (URIRule{}).Check("ftp://example.org/1"); err != nil {
+		t.Fatalf("empty rule: %v", err)
+	}
+}
+
+func TestURIRuleBytes(t *testing.T) {
+	if b := (URIRule{}).Bytes(); len(b) > 0 {
+		t.Fatalf("bytes of empty rule, %x", b)
+	}
+
+	rules := map[string]URIRule{
+		"schemes":       NewURIRule([]string{"ab", "c"}, nil),
+		"split schemes": NewURIRule([]string{"a", "bc"}, nil),
+		"hosts":         NewURIRule(nil, []string{"ab", "c"}),
+		"scheme host":   NewURIRule([]string{"ab"}, []string{"c"}),
+	}
+
+	founds := map[string]string{}
+	for name, rule := range rules {
+		k := string(rule.Bytes())
+		if found, ok := founds[k]; ok {
+			t.Fatalf("same bytes of %s and %s", found, name)
+		}
+
+		founds[k] = name
+	}
+}
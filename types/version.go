@@ -0,0 +1,78 @@
+package types
+
+import (
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+// Versioned is implemented by the values whose older hint versions are still
+// decoded. The older versions are decoded into the same type with their own
+// hint, so they are encoded back as they were stored; they are upgraded when
+// they are read from the states.
+type Versioned interface {
+	hint.Hinter
+	IsLatest() bool
+}
+
+// IsLatest reports whether the nft is in the latest version.
+func (n NFT) IsLatest() bool {
+	return n.Hint().Equal(NFTHint)
+}
+
+// Upgrade returns the nft in the latest version. The fields added after
+// NFTV1Hint are empty in the older nfts, so only the hint is changed.
+func (n NFT) Upgrade() NFT {
+	if n.IsLatest() {
+		return n
+	}
+
+	n.BaseHinter = hint.NewBaseHinter(NFTHint)
+
+	return n
+}
+
+// IsLatest reports whether the policy is in the latest version.
+func (policy CollectionPolicy) IsLatest() bool {
+	return policy.Hint().Equal(CollectionPolicyHint)
+}
+
+// Upgrade returns the policy in the latest version. The metadata added after
+// CollectionPolicyV1Hint is empty in the older policies, so only the hint is
+// changed.
+func (policy CollectionPolicy) Upgrade() CollectionPolicy {
+	if policy.IsLatest() {
+		return policy
+	}
+
+	policy.BaseHinter = hint.NewBaseHinter(CollectionPolicyHint)
+
+	return policy
+}
+
+// IsLatest reports whether the design and its policy are in the latest
+// version.
+func (de Design) IsLatest() bool {
+	if !de.Hint().Equal(DesignHint) {
+		return false
+	}
+
+	if p, ok := de.policy.(CollectionPolicy); ok {
+		return p.IsLatest()
+	}
+
+	return true
+}
+
+// Upgrade returns the design with its policy in the latest version.
+func (de Design) Upgrade() Design {
+	if de.IsLatest() {
+		return de
+	}
+
+	de.BaseHinter = hint.NewBaseHinter(DesignHint)
+
+	if p, ok := de.policy.(CollectionPolicy); ok {
+		de.policy = p.Upgrade()
+	}
+
+	return de
+}